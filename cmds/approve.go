@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type ApproveCommand struct {
+	baseCommand
+	OperationFlags
+	Sender  AddressFlag        `arg:"" name:"sender" help:"owner address" required:"true"`
+	Spender AddressFlag        `arg:"" name:"spender" help:"spender address" required:"true"`
+	Amount  CurrencyAmountFlag `arg:"" name:"currency-amount" help:"allowance (ex: \"<currency>,<amount>\")" required:"true"`
+	sender  base.Address
+	spender base.Address
+}
+
+func NewApproveCommand() ApproveCommand {
+	cmd := NewbaseCommand()
+	return ApproveCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ApproveCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	spender, err := cmd.Spender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid spender format, %q", cmd.Spender.String())
+	}
+	cmd.spender = spender
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := mitumcurrency.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewApproveFact([]byte(cmd.Token), cmd.sender, cmd.spender, am)
+
+	op, err := currency.NewApprove(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve operation")
+	}
+
+	return op, nil
+}
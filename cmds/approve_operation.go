@@ -0,0 +1,118 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ApproveOperationCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag `arg:"" name:"sender" help:"sender address; multi-key account" required:"true"`
+	Pending   string      `arg:"" name:"pending" help:"pending operation id, fact hash of propose-operation" required:"true"`
+	Operation *os.File    `arg:"" name:"operation" help:"proposed operation json to approve" required:"true"`
+	sender    base.Address
+	pending   util.Hash
+	operation base.Operation
+}
+
+func NewApproveOperationCommand() ApproveOperationCommand {
+	cmd := NewbaseCommand()
+	return ApproveOperationCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ApproveOperationCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	defer func() {
+		_ = cmd.Operation.Close()
+	}()
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	pending := valuehash.NewBytesFromString(cmd.Pending)
+	if err := pending.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid pending operation id, %q", cmd.Pending)
+	}
+	cmd.pending = pending
+
+	body, err := io.ReadAll(cmd.Operation)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	i, err := enc.Decode(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode operation")
+	}
+
+	op, ok := i.(base.Operation)
+	if !ok {
+		return errors.Errorf("expected base.Operation, not %T", i)
+	}
+	cmd.operation = op
+
+	return nil
+}
+
+func (cmd *ApproveOperationCommand) createOperation() (base.Operation, error) {
+	// NOTE approval is the sign over the fact hash of proposed operation with
+	// the network id of pending operation
+	approval, err := base.NewBaseSignFromFact(
+		cmd.Privatekey, currency.ApprovalNetworkID(cmd.NetworkID.NetworkID(), cmd.pending), cmd.operation.Fact())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign proposed operation")
+	}
+
+	fact := currency.NewApproveOperationFact(
+		[]byte(cmd.Token), cmd.sender, cmd.pending, cmd.operation.Fact().Hash(), []base.Sign{approval})
+
+	op, err := currency.NewApproveOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve-operation operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create approve-operation operation")
+	}
+
+	return op, nil
+}
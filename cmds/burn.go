@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type BurnCommand struct {
+	baseCommand
+	OperationFlags
+	Sender  AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Amounts []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	sender  base.Address
+}
+
+func NewBurnCommand() BurnCommand {
+	cmd := NewbaseCommand()
+	return BurnCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *BurnCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *BurnCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Amounts) < 1 {
+		return errors.Errorf("empty currency-amount, must be given at least one")
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	return nil
+}
+
+func (cmd *BurnCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Amounts))
+	for i := range cmd.Amounts {
+		a := cmd.Amounts[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	fact := currency.NewBurnsFact([]byte(cmd.Token), cmd.sender, ams)
+
+	op, err := currency.NewBurns(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create burns operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create burns operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,76 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type CancelKeyRotationCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address; account of pending key rotation" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+}
+
+func NewCancelKeyRotationCommand() CancelKeyRotationCommand {
+	cmd := NewbaseCommand()
+	return CancelKeyRotationCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *CancelKeyRotationCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CancelKeyRotationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	return nil
+}
+
+func (cmd *CancelKeyRotationCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewCancelKeyRotationFact([]byte(cmd.Token), cmd.sender, cmd.Currency.CID)
+
+	op, err := currency.NewCancelKeyRotation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cancel-key-rotation operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cancel-key-rotation operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,76 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type ClaimVestedCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id of locked amounts" required:"true"`
+	sender   base.Address
+}
+
+func NewClaimVestedCommand() ClaimVestedCommand {
+	cmd := NewbaseCommand()
+	return ClaimVestedCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ClaimVestedCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ClaimVestedCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	return nil
+}
+
+func (cmd *ClaimVestedCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewClaimVestedFact([]byte(cmd.Token), cmd.sender, cmd.Currency.CID)
+
+	op, err := currency.NewClaimVested(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-vested operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create claim-vested operation")
+	}
+
+	return op, nil
+}
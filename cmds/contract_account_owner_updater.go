@@ -0,0 +1,87 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type ContractAccountOwnerUpdaterCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
+	Owner    AddressFlag    `arg:"" name:"owner" help:"new owner address" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+	contract base.Address
+	owner    base.Address
+}
+
+func NewContractAccountOwnerUpdaterCommand() ContractAccountOwnerUpdaterCommand {
+	cmd := NewbaseCommand()
+	return ContractAccountOwnerUpdaterCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ContractAccountOwnerUpdaterCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ContractAccountOwnerUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if contract, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract format, %q", cmd.Contract.String())
+	} else if owner, err := cmd.Owner.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid owner format, %q", cmd.Owner.String())
+	} else {
+		cmd.sender = sender
+		cmd.contract = contract
+		cmd.owner = owner
+	}
+
+	return nil
+}
+
+func (cmd *ContractAccountOwnerUpdaterCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewContractAccountOwnerUpdaterFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.owner, cmd.Currency.CID)
+
+	op, err := currency.NewContractAccountOwnerUpdater(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-owner-update operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-owner-update operation")
+	}
+
+	return op, nil
+}
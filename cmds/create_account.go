@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 )
@@ -17,8 +18,11 @@ type CreateAccountCommand struct {
 	Keys        []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
 	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	AddressType string               `help:"address type for new account select mitum or ether" default:"mitum"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee by exchange rate"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address which pays fee instead of sender"`
 	sender      base.Address
 	keys        currency.BaseAccountKeys
+	feePayer    base.Address
 }
 
 func NewCreateAccountCommand() CreateAccountCommand {
@@ -72,6 +76,18 @@ func (cmd *CreateAccountCommand) parseFlags() error {
 	}
 	cmd.sender = a
 
+	if len(cmd.FeePayer.String()) > 0 {
+		if len(cmd.FeeCurrency.CID) > 0 {
+			return errors.Errorf("--fee-currency and --fee-payer can not be used together")
+		}
+
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %q", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	if len(cmd.Keys) < 1 {
 		return errors.Errorf("--key must be given at least one")
 	}
@@ -124,6 +140,36 @@ func (cmd *CreateAccountCommand) createOperation() (base.Operation, error) { //
 	}
 	items = append(items, item)
 
+	if cmd.feePayer != nil {
+		fact := extensioncurrency.NewSponsoredCreateAccountsFact([]byte(cmd.Token), cmd.sender, items, cmd.feePayer, cmd.FeeCurrency.CID)
+
+		op, err := extensioncurrency.NewSponsoredCreateAccounts(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-create-accounts operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-create-accounts operation")
+		}
+
+		return op, nil
+	}
+
+	if len(cmd.FeeCurrency.CID) > 0 {
+		fact := extensioncurrency.NewFeeExchangeCreateAccountsFact([]byte(cmd.Token), cmd.sender, items, cmd.FeeCurrency.CID)
+
+		op, err := extensioncurrency.NewFeeExchangeCreateAccounts(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-create-accounts operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-create-accounts operation")
+		}
+
+		return op, nil
+	}
+
 	fact := currency.NewCreateAccountsFact([]byte(cmd.Token), cmd.sender, items)
 
 	op, err := currency.NewCreateAccounts(fact)
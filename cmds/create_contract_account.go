@@ -18,6 +18,7 @@ type CreateContractAccountCommand struct {
 	Keys        []KeyFlag            `name:"key" help:"key for new account (ex: \"<public key>,<weight>\")" sep:"@"`
 	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
 	AddressType string               `help:"address type for new account select mitum or ether" default:"mitum"`
+	Salt        string               `help:"salt to derive address of new account from sender address"`
 	sender      base.Address
 	keys        mitumcurrency.BaseAccountKeys
 }
@@ -108,7 +109,7 @@ func (cmd *CreateContractAccountCommand) createOperation() (base.Operation, erro
 		addrType = mitumcurrency.EthAddressHint.Type()
 	}
 
-	item := currency.NewCreateContractAccountsItemMultiAmounts(cmd.keys, ams, addrType)
+	item := currency.NewCreateContractAccountsItemMultiAmounts(cmd.keys, ams, addrType, cmd.Salt)
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,105 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type CreateEscrowCommand struct {
+	baseCommand
+	OperationFlags
+	Sender  AddressFlag          `arg:"" name:"sender" help:"sender address as payer" required:"true"`
+	Payee   AddressFlag          `arg:"" name:"payee" help:"payee address" required:"true"`
+	Arbiter AddressFlag          `arg:"" name:"arbiter" help:"arbiter address" required:"true"`
+	Expiry  uint64               `arg:"" name:"expiry" help:"block height after which payer can resolve escrow" required:"true"`
+	Amounts []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	sender  base.Address
+	payee   base.Address
+	arbiter base.Address
+}
+
+func NewCreateEscrowCommand() CreateEscrowCommand {
+	cmd := NewbaseCommand()
+	return CreateEscrowCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *CreateEscrowCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CreateEscrowCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Amounts) < 1 {
+		return errors.Errorf("empty currency-amount, must be given at least one")
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if payee, err := cmd.Payee.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid payee format, %q", cmd.Payee.String())
+	} else if arbiter, err := cmd.Arbiter.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid arbiter format, %q", cmd.Arbiter.String())
+	} else {
+		cmd.sender = sender
+		cmd.payee = payee
+		cmd.arbiter = arbiter
+	}
+
+	return nil
+}
+
+func (cmd *CreateEscrowCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Amounts))
+	for i := range cmd.Amounts {
+		a := cmd.Amounts[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	fact := currency.NewCreateEscrowFact(
+		[]byte(cmd.Token), cmd.sender, cmd.payee, cmd.arbiter, ams, base.Height(cmd.Expiry))
+
+	op, err := currency.NewCreateEscrow(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create create-escrow operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create create-escrow operation")
+	}
+
+	return op, nil
+}
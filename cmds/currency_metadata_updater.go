@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type CurrencyMetadataUpdaterCommand struct {
+	baseCommand
+	OperationFlags
+	Currency              CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	CurrencyMetadataFlags `prefix:"metadata-" help:"currency metadata; empty metadata removes it"`
+	Node                  AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	node                  base.Address
+	md                    currency.CurrencyMetadata
+}
+
+func NewCurrencyMetadataUpdaterCommand() CurrencyMetadataUpdaterCommand {
+	cmd := NewbaseCommand()
+	return CurrencyMetadataUpdaterCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *CurrencyMetadataUpdaterCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create currency-metadata-updater operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid currency-metadata-updater operation")
+	} else {
+		cmd.log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CurrencyMetadataUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	md, err := cmd.CurrencyMetadataFlags.metadata()
+	if err != nil {
+		return err
+	}
+	cmd.md = md
+
+	cmd.log.Debug().Interface("currency-metadata", cmd.md).Msg("currency metadata loaded")
+
+	return nil
+}
+
+func (cmd *CurrencyMetadataUpdaterCommand) createOperation() (currency.CurrencyMetadataUpdater, error) {
+	fact := currency.NewCurrencyMetadataUpdaterFact([]byte(cmd.Token), cmd.Currency.CID, cmd.md)
+
+	op, err := currency.NewCurrencyMetadataUpdater(fact)
+	if err != nil {
+		return currency.CurrencyMetadataUpdater{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.CurrencyMetadataUpdater{}, errors.Wrap(err, "failed to create currency-metadata-updater operation")
+	}
+
+	return op, nil
+}
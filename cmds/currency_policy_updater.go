@@ -12,14 +12,16 @@ import (
 type CurrencyPolicyUpdaterCommand struct {
 	baseCommand
 	OperationFlags
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	Node                    AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
-	node                    base.Address
-	po                      currency.CurrencyPolicy
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered, split}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	CurrencySplitFeeerFlags  `prefix:"feeer-split-" help:"split feeer"`
+	Node                     AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	node                     base.Address
+	po                       currency.CurrencyPolicy
 }
 
 func NewCurrencyPolicyUpdaterCommand() CurrencyPolicyUpdaterCommand {
@@ -68,6 +70,8 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	} else if err := cmd.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := cmd.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	a, err := cmd.Node.Encode(enc)
@@ -84,6 +88,24 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		feeer = cmd.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = cmd.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerTiered:
+		feeer = cmd.CurrencyTieredFeeerFlags.feeer
+	case currency.FeeerSplit:
+		var sf currency.Feeer
+		switch cmd.CurrencySplitFeeerFlags.Feeer {
+		case currency.FeeerFixed:
+			sf = cmd.CurrencyFixedFeeerFlags.feeer
+		case currency.FeeerRatio:
+			sf = cmd.CurrencyRatioFeeerFlags.feeer
+		case currency.FeeerTiered:
+			sf = cmd.CurrencyTieredFeeerFlags.feeer
+		}
+
+		i, err := cmd.CurrencySplitFeeerFlags.splitFeeer(sf)
+		if err != nil {
+			return err
+		}
+		feeer = i
 	default:
 		return errors.Errorf("unknown feeer type, %q", t)
 	}
@@ -94,7 +116,20 @@ func (cmd *CurrencyPolicyUpdaterCommand) parseFlags() error {
 		return err
 	}
 
-	cmd.po = currency.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	minters, err := cmd.CurrencyPolicyFlags.minters()
+	if err != nil {
+		return err
+	}
+
+	operationFeeers, err := cmd.CurrencyPolicyFlags.operationFeeers(feeer)
+	if err != nil {
+		return err
+	}
+
+	cmd.po = currency.NewCurrencyPolicy(cmd.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer).
+		SetMinters(minters).
+		SetOperationFeeers(operationFeeers).
+		SetPaused(cmd.CurrencyPolicyFlags.Paused)
 	if err := cmd.po.IsValid(nil); err != nil {
 		return err
 	}
@@ -62,23 +62,133 @@ func (fl *CurrencyRatioFeeerFlags) IsValid([]byte) error {
 	return fl.feeer.IsValid(nil)
 }
 
+type CurrencyTieredFeeerFlags struct {
+	Receiver          AddressFlag   `name:"receiver" help:"fee receiver account address"`
+	Tiers             []FeeTierFlag `name:"tier" help:"fee tier, ratio is optional (ex: \"<min>,<amount>,<ratio>\")"`
+	ExchangeMinAmount BigFlag       `name:"exchange-min-amount" help:"exchange min amount"`
+	feeer             currency.Feeer
+}
+
+func (fl *CurrencyTieredFeeerFlags) IsValid([]byte) error {
+	if len(fl.Receiver.String()) < 1 {
+		return nil
+	}
+
+	var receiver base.Address
+	if a, err := fl.Receiver.Encode(enc); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver format, %q: %w", fl.Receiver.String(), err)
+	} else if err := a.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver address, %q: %w", fl.Receiver.String(), err)
+	} else {
+		receiver = a
+	}
+
+	tiers := make([]currency.FeeTier, len(fl.Tiers))
+	for i := range fl.Tiers {
+		tiers[i] = currency.NewFeeTier(fl.Tiers[i].Min, fl.Tiers[i].Amount, fl.Tiers[i].Ratio)
+	}
+
+	fl.feeer = currency.NewTieredFeeer(receiver, tiers, fl.ExchangeMinAmount.Big)
+	return fl.feeer.IsValid(nil)
+}
+
+type CurrencySplitFeeerFlags struct {
+	Feeer     string            `name:"feeer" help:"feeer type to be split, {fixed, ratio, tiered}"`
+	Receivers []FeeReceiverFlag `name:"receiver" help:"fee receiver and weight, first one should be the receiver of feeer (ex: \"<address>,<weight>\")"` // nolint lll
+}
+
+func (fl *CurrencySplitFeeerFlags) splitFeeer(feeer currency.Feeer) (currency.Feeer, error) {
+	if feeer == nil {
+		return nil, util.ErrInvalid.Errorf("empty feeer flags of split feeer, %q", fl.Feeer)
+	}
+
+	receivers := make([]currency.FeeReceiver, len(fl.Receivers))
+	for i := range fl.Receivers {
+		a, err := fl.Receivers[i].Encode(enc)
+		if err != nil {
+			return nil, util.ErrInvalid.Errorf("invalid receiver format, %q: %w", fl.Receivers[i].AddressFlag.String(), err)
+		}
+
+		receivers[i] = currency.NewFeeReceiver(a, fl.Receivers[i].Weight)
+	}
+
+	sf := currency.NewSplitFeeer(feeer, receivers)
+
+	return sf, sf.IsValid(nil)
+}
+
 type CurrencyPolicyFlags struct {
-	NewAccountMinBalance BigFlag `name:"new-account-min-balance" help:"minimum balance for new account"` // nolint lll
+	NewAccountMinBalance BigFlag              `name:"new-account-min-balance" help:"minimum balance for new account"`                                                                              // nolint lll
+	Minters              []MinterFlag         `name:"minter" help:"minter of currency, quota is optional (ex: \"<address>,<quota>\")"`                                                             // nolint lll
+	OperationFeeers      []OperationFeeerFlag `name:"operation-feeer" help:"fixed fee amount for operation fact hint type, received by the receiver of feeer (ex: \"<fact hint type>,<amount>\")"` // nolint lll
+	Paused               bool                 `name:"paused" help:"pause transfers, account creations and withdraws of currency"`                                                                  // nolint lll
 }
 
 func (*CurrencyPolicyFlags) IsValid([]byte) error {
 	return nil
 }
 
+func (fl *CurrencyPolicyFlags) minters() ([]currency.CurrencyMinter, error) {
+	minters := make([]currency.CurrencyMinter, len(fl.Minters))
+	for i := range fl.Minters {
+		a, err := fl.Minters[i].Encode(enc)
+		if err != nil {
+			return nil, util.ErrInvalid.Errorf("invalid minter format, %q: %w", fl.Minters[i].AddressFlag.String(), err)
+		}
+
+		minters[i] = currency.NewCurrencyMinter(a, fl.Minters[i].Quota)
+	}
+
+	return minters, nil
+}
+
+func (fl *CurrencyPolicyFlags) operationFeeers(feeer currency.Feeer) ([]currency.OperationFeeer, error) {
+	if len(fl.OperationFeeers) < 1 {
+		return nil, nil
+	}
+
+	receiver := feeer.Receiver()
+	if receiver == nil {
+		return nil, util.ErrInvalid.Errorf("operation feeer needs the receiver of feeer")
+	}
+
+	feeers := make([]currency.OperationFeeer, len(fl.OperationFeeers))
+	for i := range fl.OperationFeeers {
+		feeers[i] = currency.NewOperationFeeer(
+			fl.OperationFeeers[i].Fact,
+			currency.NewFixedFeeer(receiver, fl.OperationFeeers[i].Amount, feeer.ExchangeMin()),
+		)
+	}
+
+	return feeers, nil
+}
+
+type CurrencyMetadataFlags struct {
+	Decimals uint   `name:"decimals" help:"decimal places of currency"`
+	Name     string `name:"name" help:"human readable name of currency"`
+	Symbol   string `name:"symbol" help:"symbol of currency"`
+	URL      string `name:"url" help:"informational url of currency"`
+}
+
+func (fl *CurrencyMetadataFlags) metadata() (currency.CurrencyMetadata, error) {
+	md := currency.NewCurrencyMetadata(fl.Decimals, fl.Name, fl.Symbol, fl.URL)
+
+	return md, md.IsValid(nil)
+}
+
 type CurrencyDesignFlags struct {
-	Currency                CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	GenesisAmount           BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
-	GenesisAccount          AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
-	CurrencyPolicyFlags     `prefix:"policy-" help:"currency policy" required:"true"`
-	FeeerString             string `name:"feeer" help:"feeer type, {nil, fixed, ratio}" required:"true"`
-	CurrencyFixedFeeerFlags `prefix:"feeer-fixed-" help:"fixed feeer"`
-	CurrencyRatioFeeerFlags `prefix:"feeer-ratio-" help:"ratio feeer"`
-	currencyDesign          currency.CurrencyDesign
+	Currency                 CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	GenesisAmount            BigFlag        `arg:"" name:"genesis-amount" help:"genesis amount" required:"true"`
+	GenesisAccount           AddressFlag    `arg:"" name:"genesis-account" help:"genesis-account address for genesis balance" required:"true"` // nolint lll
+	MaxSupply                BigFlag        `name:"max-supply" help:"maximum supply of currency; no limit if not given"`
+	CurrencyPolicyFlags      `prefix:"policy-" help:"currency policy" required:"true"`
+	FeeerString              string `name:"feeer" help:"feeer type, {nil, fixed, ratio, tiered, split}" required:"true"`
+	CurrencyFixedFeeerFlags  `prefix:"feeer-fixed-" help:"fixed feeer"`
+	CurrencyRatioFeeerFlags  `prefix:"feeer-ratio-" help:"ratio feeer"`
+	CurrencyTieredFeeerFlags `prefix:"feeer-tiered-" help:"tiered feeer"`
+	CurrencySplitFeeerFlags  `prefix:"feeer-split-" help:"split feeer"`
+	CurrencyMetadataFlags    `prefix:"metadata-" help:"currency metadata"`
+	currencyDesign           currency.CurrencyDesign
 }
 
 func (fl *CurrencyDesignFlags) IsValid([]byte) error {
@@ -88,6 +198,8 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	} else if err := fl.CurrencyRatioFeeerFlags.IsValid(nil); err != nil {
 		return err
+	} else if err := fl.CurrencyTieredFeeerFlags.IsValid(nil); err != nil {
+		return err
 	}
 
 	var feeer currency.Feeer
@@ -98,6 +210,24 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		feeer = fl.CurrencyFixedFeeerFlags.feeer
 	case currency.FeeerRatio:
 		feeer = fl.CurrencyRatioFeeerFlags.feeer
+	case currency.FeeerTiered:
+		feeer = fl.CurrencyTieredFeeerFlags.feeer
+	case currency.FeeerSplit:
+		var sf currency.Feeer
+		switch fl.CurrencySplitFeeerFlags.Feeer {
+		case currency.FeeerFixed:
+			sf = fl.CurrencyFixedFeeerFlags.feeer
+		case currency.FeeerRatio:
+			sf = fl.CurrencyRatioFeeerFlags.feeer
+		case currency.FeeerTiered:
+			sf = fl.CurrencyTieredFeeerFlags.feeer
+		}
+
+		i, err := fl.CurrencySplitFeeerFlags.splitFeeer(sf)
+		if err != nil {
+			return err
+		}
+		feeer = i
 	default:
 		return util.ErrInvalid.Errorf("unknown feeer type, %q", t)
 	}
@@ -108,7 +238,20 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 		return err
 	}
 
-	po := currency.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer)
+	minters, err := fl.CurrencyPolicyFlags.minters()
+	if err != nil {
+		return err
+	}
+
+	operationFeeers, err := fl.CurrencyPolicyFlags.operationFeeers(feeer)
+	if err != nil {
+		return err
+	}
+
+	po := currency.NewCurrencyPolicy(fl.CurrencyPolicyFlags.NewAccountMinBalance.Big, feeer).
+		SetMinters(minters).
+		SetOperationFeeers(operationFeeers).
+		SetPaused(fl.CurrencyPolicyFlags.Paused)
 	if err := po.IsValid(nil); err != nil {
 		return err
 	}
@@ -128,6 +271,16 @@ func (fl *CurrencyDesignFlags) IsValid([]byte) error {
 	}
 
 	fl.currencyDesign = currency.NewCurrencyDesign(am, genesisAccount, po)
+	if fl.MaxSupply.OverZero() {
+		fl.currencyDesign = fl.currencyDesign.SetMaxSupply(fl.MaxSupply.Big)
+	}
+
+	md, err := fl.CurrencyMetadataFlags.metadata()
+	if err != nil {
+		return err
+	}
+	fl.currencyDesign = fl.currencyDesign.SetMetadata(md)
+
 	return fl.currencyDesign.IsValid(nil)
 }
 
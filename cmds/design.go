@@ -14,6 +14,7 @@ import (
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
 )
 
@@ -172,6 +173,14 @@ func (no *FeeerDesign) IsValid([]byte) error {
 		if err := no.checkRatio(no.Extras); err != nil {
 			return err
 		}
+	case extensioncurrency.FeeerTiered:
+		if err := no.checkTiered(no.Extras); err != nil {
+			return err
+		}
+	case extensioncurrency.FeeerSplit:
+		if err := no.checkSplit(no.Extras); err != nil {
+			return err
+		}
 	default:
 		return errors.Errorf("unknown type of feeer, %v", t)
 	}
@@ -221,6 +230,146 @@ func (no FeeerDesign) checkRatio(c map[string]interface{}) error {
 	return nil
 }
 
+func (no FeeerDesign) checkTiered(c map[string]interface{}) error {
+	a, found := c["tiers"]
+	if !found {
+		return errors.Errorf("tiered needs `tiers`")
+	}
+
+	l, ok := a.([]interface{})
+	if !ok {
+		return errors.Errorf("invalid tiers value type, %T of tiered; should be list", a)
+	}
+
+	tiers := make([]extensioncurrency.FeeTier, len(l))
+	for i := range l {
+		m, ok := l[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("invalid tier value type, %T of tiered", l[i])
+		}
+
+		min := currency.ZeroBig
+		if a, found := m["min"]; found {
+			n, err := currency.NewBigFromInterface(a)
+			if err != nil {
+				return errors.Wrapf(err, "invalid min value, %v of tier", a)
+			}
+			min = n
+		}
+
+		amount := currency.ZeroBig
+		if a, found := m["amount"]; found {
+			n, err := currency.NewBigFromInterface(a)
+			if err != nil {
+				return errors.Wrapf(err, "invalid amount value, %v of tier", a)
+			}
+			amount = n
+		}
+
+		var ratio float64
+		if a, found := m["ratio"]; found {
+			f, ok := a.(float64)
+			if !ok {
+				return errors.Errorf("invalid ratio value type, %T of tier; should be float64", a)
+			}
+			ratio = f
+		}
+
+		tiers[i] = extensioncurrency.NewFeeTier(min, amount, ratio)
+		if err := tiers[i].IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	no.Extras["tiered_tiers"] = tiers
+
+	return nil
+}
+
+// checkSplit checks the feeer to be split, `split`, with the same extras and
+// the receivers; the receiver without `address` is genesis account.
+func (no FeeerDesign) checkSplit(c map[string]interface{}) error {
+	switch t, _ := c["split"].(string); t {
+	case currency.FeeerFixed:
+		if err := no.checkFixed(c); err != nil {
+			return err
+		}
+	case currency.FeeerRatio:
+		if err := no.checkRatio(c); err != nil {
+			return err
+		}
+	case extensioncurrency.FeeerTiered:
+		if err := no.checkTiered(c); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("invalid feeer type of split, %v", c["split"])
+	}
+
+	a, found := c["receivers"]
+	if !found {
+		return errors.Errorf("split needs `receivers`")
+	}
+
+	l, ok := a.([]interface{})
+	if !ok {
+		return errors.Errorf("invalid receivers value type, %T of split; should be list", a)
+	}
+
+	je := encs.Find(jsonenc.JSONEncoderHint)
+
+	var sum uint64
+	receivers := make([]extensioncurrency.FeeReceiver, len(l))
+	for i := range l {
+		m, ok := l[i].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("invalid receiver value type, %T of split", l[i])
+		}
+
+		var receiver base.Address
+		if a, found := m["address"]; found {
+			s, ok := a.(string)
+			if !ok {
+				return errors.Errorf("invalid address value type, %T of receiver; should be string", a)
+			}
+
+			ad, err := base.DecodeAddress(s, je)
+			if err != nil {
+				return errors.Wrapf(err, "invalid address value, %v of receiver", a)
+			}
+			receiver = ad
+		}
+
+		var weight uint64
+		switch w := m["weight"].(type) {
+		case int:
+			if w < 0 {
+				return errors.Errorf("invalid weight value, %v of receiver", w)
+			}
+			weight = uint64(w)
+		case uint64:
+			weight = w
+		default:
+			return errors.Errorf("invalid weight value type, %T of receiver; should be integer", m["weight"])
+		}
+
+		if weight < 1 {
+			return errors.Errorf("zero weight of receiver, %v", m["address"])
+		}
+
+		sum += weight
+		receivers[i] = extensioncurrency.NewFeeReceiver(receiver, weight)
+	}
+
+	if sum != extensioncurrency.FeeReceiverWeightSum {
+		return errors.Errorf("sum of receiver weights of split, %d should be %d", sum, extensioncurrency.FeeReceiverWeightSum)
+	}
+
+	no.Extras["split_receivers"] = receivers
+
+	return nil
+}
+
 type DigestDesign struct {
 	NetworkYAML  *LocalNetwork        `yaml:"network,omitempty"`
 	CacheYAML    *string              `yaml:"cache,omitempty"`
@@ -0,0 +1,94 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type ExchangeRateUpdaterCommand struct {
+	baseCommand
+	OperationFlags
+	From        CurrencyIDFlag `arg:"" name:"from" help:"currency id to be exchanged" required:"true"`
+	To          CurrencyIDFlag `arg:"" name:"to" help:"currency id to exchange into" required:"true"`
+	Numerator   BigFlag        `arg:"" name:"numerator" help:"numerator of exchange rate" required:"true"`
+	Denominator BigFlag        `arg:"" name:"denominator" help:"denominator of exchange rate" required:"true"`
+	Node        AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	node        base.Address
+	rate        currency.ExchangeRate
+}
+
+func NewExchangeRateUpdaterCommand() ExchangeRateUpdaterCommand {
+	cmd := NewbaseCommand()
+	return ExchangeRateUpdaterCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ExchangeRateUpdaterCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create exchange-rate-updater operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid exchange-rate-updater operation")
+	} else {
+		cmd.log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ExchangeRateUpdaterCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	cmd.rate = currency.NewExchangeRate(cmd.From.CID, cmd.To.CID, cmd.Numerator.Big, cmd.Denominator.Big)
+	if err := cmd.rate.IsValid(nil); err != nil {
+		return err
+	}
+
+	cmd.log.Debug().Interface("exchange-rate", cmd.rate).Msg("exchange rate loaded")
+
+	return nil
+}
+
+func (cmd *ExchangeRateUpdaterCommand) createOperation() (currency.ExchangeRateUpdater, error) {
+	fact := currency.NewExchangeRateUpdaterFact([]byte(cmd.Token), cmd.rate)
+
+	op, err := currency.NewExchangeRateUpdater(fact)
+	if err != nil {
+		return currency.ExchangeRateUpdater{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.ExchangeRateUpdater{}, errors.Wrap(err, "failed to create exchange-rate-updater operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ExecuteScheduledOperationCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
+	Schedule string      `arg:"" name:"schedule" help:"scheduled operation id, fact hash of scheduled operation" required:"true"`
+	sender   base.Address
+	schedule util.Hash
+}
+
+func NewExecuteScheduledOperationCommand() ExecuteScheduledOperationCommand {
+	cmd := NewbaseCommand()
+	return ExecuteScheduledOperationCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ExecuteScheduledOperationCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ExecuteScheduledOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	schedule := valuehash.NewBytesFromString(cmd.Schedule)
+	if err := schedule.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid scheduled operation id, %q", cmd.Schedule)
+	}
+	cmd.schedule = schedule
+
+	return nil
+}
+
+func (cmd *ExecuteScheduledOperationCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewExecuteScheduledOperationFact([]byte(cmd.Token), cmd.sender, cmd.schedule)
+
+	op, err := currency.NewExecuteScheduledOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create execute-scheduled-operation operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create execute-scheduled-operation operation")
+	}
+
+	return op, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
 
 	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
@@ -233,3 +234,134 @@ func (v *ContractIDFlag) UnmarshalText(b []byte) error {
 func (v *ContractIDFlag) String() string {
 	return v.CID.String()
 }
+
+type MinterFlag struct {
+	AddressFlag
+	Quota currency.Big
+}
+
+func (v *MinterFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+
+	if err := v.AddressFlag.UnmarshalText([]byte(l[0])); err != nil {
+		return err
+	}
+
+	if len(l) < 2 {
+		v.Quota = currency.ZeroBig
+
+		return nil
+	}
+
+	if a, err := currency.NewBigFromString(l[1]); err != nil {
+		return errors.Wrapf(err, "invalid big string, %q", string(b))
+	} else if err := a.IsValid(nil); err != nil {
+		return err
+	} else {
+		v.Quota = a
+	}
+
+	return nil
+}
+
+func (v *MinterFlag) String() string {
+	return v.AddressFlag.String() + "," + v.Quota.String()
+}
+
+type FeeTierFlag struct {
+	Min    currency.Big
+	Amount currency.Big
+	Ratio  float64
+}
+
+func (v *FeeTierFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 3)
+	if len(l) < 2 {
+		return fmt.Errorf("invalid fee tier, %q", string(b))
+	}
+
+	if a, err := currency.NewBigFromString(l[0]); err != nil {
+		return errors.Wrapf(err, "invalid min of fee tier, %q", string(b))
+	} else {
+		v.Min = a
+	}
+
+	if a, err := currency.NewBigFromString(l[1]); err != nil {
+		return errors.Wrapf(err, "invalid amount of fee tier, %q", string(b))
+	} else {
+		v.Amount = a
+	}
+
+	if len(l) < 3 {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(l[2], 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ratio of fee tier, %q", string(b))
+	}
+	v.Ratio = f
+
+	return nil
+}
+
+func (v *FeeTierFlag) String() string {
+	return v.Min.String() + "," + v.Amount.String() + "," + strconv.FormatFloat(v.Ratio, 'f', -1, 64)
+}
+
+type FeeReceiverFlag struct {
+	AddressFlag
+	Weight uint64
+}
+
+func (v *FeeReceiverFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) < 2 {
+		return fmt.Errorf("invalid fee receiver, %q", string(b))
+	}
+
+	if err := v.AddressFlag.UnmarshalText([]byte(l[0])); err != nil {
+		return err
+	}
+
+	w, err := strconv.ParseUint(l[1], 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid weight of fee receiver, %q", string(b))
+	}
+	v.Weight = w
+
+	return nil
+}
+
+func (v *FeeReceiverFlag) String() string {
+	return v.AddressFlag.String() + "," + strconv.FormatUint(v.Weight, 10)
+}
+
+type OperationFeeerFlag struct {
+	Fact   hint.Type
+	Amount currency.Big
+}
+
+func (v *OperationFeeerFlag) UnmarshalText(b []byte) error {
+	l := strings.SplitN(string(b), ",", 2)
+	if len(l) < 2 {
+		return fmt.Errorf("invalid operation feeer, %q", string(b))
+	}
+
+	v.Fact = hint.Type(l[0])
+	if err := v.Fact.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid fact hint type of operation feeer, %q", string(b))
+	}
+
+	if a, err := currency.NewBigFromString(l[1]); err != nil {
+		return errors.Wrapf(err, "invalid amount of operation feeer, %q", string(b))
+	} else {
+		v.Amount = a
+	}
+
+	return nil
+}
+
+func (v *OperationFeeerFlag) String() string {
+	return string(v.Fact) + "," + v.Amount.String()
+}
@@ -0,0 +1,105 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type FreezeAccountsCommand struct {
+	baseCommand
+	OperationFlags
+	Node     AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	Accounts []AddressFlag  `arg:"" name:"account" help:"account address to freeze" required:"true"`
+	Currency CurrencyIDFlag `name:"currency-id" help:"currency id to freeze; all currencies if empty"`
+	node     base.Address
+	accounts []base.Address
+}
+
+func NewFreezeAccountsCommand() FreezeAccountsCommand {
+	cmd := NewbaseCommand()
+	return FreezeAccountsCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *FreezeAccountsCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create freeze-accounts operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid freeze-accounts operation")
+	} else {
+		cmd.log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *FreezeAccountsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	accounts, err := parseFreezeAccounts(cmd.Accounts)
+	if err != nil {
+		return err
+	}
+	cmd.accounts = accounts
+
+	return nil
+}
+
+func (cmd *FreezeAccountsCommand) createOperation() (currency.FreezeAccounts, error) {
+	fact := currency.NewFreezeAccountsFact([]byte(cmd.Token), cmd.accounts, cmd.Currency.CID)
+
+	op, err := currency.NewFreezeAccounts(fact)
+	if err != nil {
+		return currency.FreezeAccounts{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.FreezeAccounts{}, errors.Wrap(err, "failed to create freeze-accounts operation")
+	}
+
+	return op, nil
+}
+
+func parseFreezeAccounts(flags []AddressFlag) ([]base.Address, error) {
+	accounts := make([]base.Address, len(flags))
+	for i := range flags {
+		a, err := flags[i].Encode(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid account format, %q", flags[i].String())
+		}
+
+		accounts[i] = a
+	}
+
+	return accounts, nil
+}
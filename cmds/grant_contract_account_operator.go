@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type GrantContractAccountOperatorCommand struct {
+	baseCommand
+	OperationFlags
+	Sender     AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   AddressFlag          `arg:"" name:"contract" help:"contract account address" required:"true"`
+	Operator   AddressFlag          `arg:"" name:"operator" help:"operator address" required:"true"`
+	Currency   CurrencyIDFlag       `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Allowances []CurrencyAmountFlag `arg:"" name:"allowance" help:"withdraw allowance (ex: \"<currency>,<amount>\")"`
+	sender     base.Address
+	contract   base.Address
+	operator   base.Address
+}
+
+func NewGrantContractAccountOperatorCommand() GrantContractAccountOperatorCommand {
+	cmd := NewbaseCommand()
+	return GrantContractAccountOperatorCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *GrantContractAccountOperatorCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *GrantContractAccountOperatorCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Allowances) < 1 {
+		return errors.Errorf("empty allowance, must be given at least one")
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if contract, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract format, %q", cmd.Contract.String())
+	} else if operator, err := cmd.Operator.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid operator format, %q", cmd.Operator.String())
+	} else {
+		cmd.sender = sender
+		cmd.contract = contract
+		cmd.operator = operator
+	}
+
+	return nil
+}
+
+func (cmd *GrantContractAccountOperatorCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Allowances))
+	for i := range cmd.Allowances {
+		a := cmd.Allowances[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	fact := currency.NewGrantContractAccountOperatorFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.operator, ams, cmd.Currency.CID)
+
+	op, err := currency.NewGrantContractAccountOperator(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-operator-grant operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-operator-grant operation")
+	}
+
+	return op, nil
+}
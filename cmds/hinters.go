@@ -30,6 +30,8 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: mitumcurrency.TransfersHint, Instance: mitumcurrency.Transfers{}},
 	{Hint: currency.CurrencyDesignHint, Instance: currency.CurrencyDesign{}},
 	{Hint: currency.CurrencyPolicyHint, Instance: currency.CurrencyPolicy{}},
+	{Hint: currency.CurrencyMinterHint, Instance: currency.CurrencyMinter{}},
+	{Hint: currency.OperationFeeerHint, Instance: currency.OperationFeeer{}},
 	{Hint: currency.CurrencyRegisterHint, Instance: currency.CurrencyRegister{}},
 	{Hint: currency.CurrencyPolicyUpdaterHint, Instance: currency.CurrencyPolicyUpdater{}},
 	{Hint: mitumcurrency.SuffrageInflationHint, Instance: mitumcurrency.SuffrageInflation{}},
@@ -40,6 +42,53 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: currency.WithdrawsItemMultiAmountsHint, Instance: currency.WithdrawsItemMultiAmounts{}},
 	{Hint: currency.WithdrawsItemSingleAmountHint, Instance: currency.WithdrawsItemSingleAmount{}},
 	{Hint: currency.WithdrawsHint, Instance: currency.Withdraws{}},
+	{Hint: currency.UpdateContractAccountStatusHint, Instance: currency.UpdateContractAccountStatus{}},
+	{Hint: currency.ContractAccountOwnerUpdaterHint, Instance: currency.ContractAccountOwnerUpdater{}},
+	{Hint: currency.ContractAccountOperatorHint, Instance: currency.ContractAccountOperator{}},
+	{Hint: currency.GrantContractAccountOperatorHint, Instance: currency.GrantContractAccountOperator{}},
+	{Hint: currency.RevokeContractAccountOperatorHint, Instance: currency.RevokeContractAccountOperator{}},
+	{Hint: currency.UpdateContractAccountOwnersHint, Instance: currency.UpdateContractAccountOwners{}},
+	{Hint: currency.BurnsHint, Instance: currency.Burns{}},
+	{Hint: currency.MintHint, Instance: currency.Mint{}},
+	{Hint: currency.FeeExchangeTransfersHint, Instance: currency.FeeExchangeTransfers{}},
+	{Hint: currency.FeeExchangeCreateAccountsHint, Instance: currency.FeeExchangeCreateAccounts{}},
+	{Hint: currency.ExchangeRateHint, Instance: currency.ExchangeRate{}},
+	{Hint: currency.ExchangeRateUpdaterHint, Instance: currency.ExchangeRateUpdater{}},
+	{Hint: currency.SponsoredTransfersHint, Instance: currency.SponsoredTransfers{}},
+	{Hint: currency.SponsoredCreateAccountsHint, Instance: currency.SponsoredCreateAccounts{}},
+	{Hint: currency.FeeExchangeWithdrawsHint, Instance: currency.FeeExchangeWithdraws{}},
+	{Hint: currency.SponsoredWithdrawsHint, Instance: currency.SponsoredWithdraws{}},
+	{Hint: currency.LockedTransfersItemHint, Instance: currency.LockedTransfersItem{}},
+	{Hint: currency.VestingLockHint, Instance: currency.VestingLock{}},
+	{Hint: currency.LockedTransfersHint, Instance: currency.LockedTransfers{}},
+	{Hint: currency.ClaimVestedHint, Instance: currency.ClaimVested{}},
+	{Hint: currency.EscrowHint, Instance: currency.Escrow{}},
+	{Hint: currency.CreateEscrowHint, Instance: currency.CreateEscrow{}},
+	{Hint: currency.ReleaseEscrowHint, Instance: currency.ReleaseEscrow{}},
+	{Hint: currency.RefundEscrowHint, Instance: currency.RefundEscrow{}},
+	{Hint: currency.PendingOperationHint, Instance: currency.PendingOperation{}},
+	{Hint: currency.ProposeOperationHint, Instance: currency.ProposeOperation{}},
+	{Hint: currency.ApproveOperationHint, Instance: currency.ApproveOperation{}},
+	{Hint: currency.FreezeAccountsHint, Instance: currency.FreezeAccounts{}},
+	{Hint: currency.UnfreezeAccountsHint, Instance: currency.UnfreezeAccounts{}},
+	{Hint: currency.ApproveHint, Instance: currency.Approve{}},
+	{Hint: currency.TransferFromHint, Instance: currency.TransferFrom{}},
+	{Hint: currency.MemoTransfersItemHint, Instance: currency.MemoTransfersItem{}},
+	{Hint: currency.MemoTransfersHint, Instance: currency.MemoTransfers{}},
+	{Hint: currency.ScheduledOperationHint, Instance: currency.ScheduledOperation{}},
+	{Hint: currency.ScheduleOperationHint, Instance: currency.ScheduleOperation{}},
+	{Hint: currency.ExecuteScheduledOperationHint, Instance: currency.ExecuteScheduledOperation{}},
+	{Hint: currency.CancelScheduledOperationHint, Instance: currency.CancelScheduledOperation{}},
+	{Hint: currency.CurrencyMetadataHint, Instance: currency.CurrencyMetadata{}},
+	{Hint: currency.CurrencyMetadataUpdaterHint, Instance: currency.CurrencyMetadataUpdater{}},
+	{Hint: currency.SetRecoveryGuardiansHint, Instance: currency.SetRecoveryGuardians{}},
+	{Hint: currency.RecoveryApprovalHint, Instance: currency.RecoveryApproval{}},
+	{Hint: currency.AccountRecoveryHint, Instance: currency.AccountRecovery{}},
+	{Hint: currency.RecoverAccountHint, Instance: currency.RecoverAccount{}},
+	{Hint: currency.CancelAccountRecoveryHint, Instance: currency.CancelAccountRecovery{}},
+	{Hint: currency.SetKeyRotationDelayHint, Instance: currency.SetKeyRotationDelay{}},
+	{Hint: currency.KeyRotationHint, Instance: currency.KeyRotation{}},
+	{Hint: currency.CancelKeyRotationHint, Instance: currency.CancelKeyRotation{}},
 	// {Hint: mitumcurrency.FeeOperationFactHint, Instance: mitumcurrency.FeeOperationFact{}},
 	// {Hint: mitumcurrency.FeeOperationHint, Instance: mitumcurrency.FeeOperation{}},
 	{Hint: currency.GenesisCurrenciesFactHint, Instance: currency.GenesisCurrenciesFact{}},
@@ -49,10 +98,24 @@ var hinters = []encoder.DecodeDetail{
 	{Hint: currency.NilFeeerHint, Instance: currency.NilFeeer{}},
 	{Hint: currency.FixedFeeerHint, Instance: currency.FixedFeeer{}},
 	{Hint: currency.RatioFeeerHint, Instance: currency.RatioFeeer{}},
+	{Hint: currency.TieredFeeerHint, Instance: currency.TieredFeeer{}},
+	{Hint: currency.SplitFeeerHint, Instance: currency.SplitFeeer{}},
 	{Hint: mitumcurrency.AccountStateValueHint, Instance: mitumcurrency.AccountStateValue{}},
 	{Hint: mitumcurrency.BalanceStateValueHint, Instance: mitumcurrency.BalanceStateValue{}},
 	{Hint: currency.ContractAccountStateValueHint, Instance: currency.ContractAccountStateValue{}},
 	{Hint: currency.CurrencyDesignStateValueHint, Instance: currency.CurrencyDesignStateValue{}},
+	{Hint: currency.ExchangeRateStateValueHint, Instance: currency.ExchangeRateStateValue{}},
+	{Hint: currency.FeeStateValueHint, Instance: currency.FeeStateValue{}},
+	{Hint: currency.LockStateValueHint, Instance: currency.LockStateValue{}},
+	{Hint: currency.EscrowStateValueHint, Instance: currency.EscrowStateValue{}},
+	{Hint: currency.PendingOperationStateValueHint, Instance: currency.PendingOperationStateValue{}},
+	{Hint: currency.FreezeStateValueHint, Instance: currency.FreezeStateValue{}},
+	{Hint: currency.AllowanceStateValueHint, Instance: currency.AllowanceStateValue{}},
+	{Hint: currency.ScheduledOperationStateValueHint, Instance: currency.ScheduledOperationStateValue{}},
+	{Hint: currency.RecoveryGuardiansStateValueHint, Instance: currency.RecoveryGuardiansStateValue{}},
+	{Hint: currency.AccountRecoveryStateValueHint, Instance: currency.AccountRecoveryStateValue{}},
+	{Hint: currency.KeyRotationDelayStateValueHint, Instance: currency.KeyRotationDelayStateValue{}},
+	{Hint: currency.KeyRotationStateValueHint, Instance: currency.KeyRotationStateValue{}},
 	{Hint: digestisaac.ManifestHint, Instance: digestisaac.Manifest{}},
 	{Hint: digest.AccountValueHint, Instance: digest.AccountValue{}},
 	{Hint: digest.OperationValueHint, Instance: digest.OperationValue{}},
@@ -81,6 +144,41 @@ var supportedProposalOperationFactHinters = []encoder.DecodeDetail{
 	{Hint: mitumcurrency.SuffrageInflationFactHint, Instance: mitumcurrency.SuffrageInflationFact{}},
 	{Hint: currency.CreateContractAccountsFactHint, Instance: currency.CreateContractAccountsFact{}},
 	{Hint: currency.WithdrawsFactHint, Instance: currency.WithdrawsFact{}},
+	{Hint: currency.UpdateContractAccountStatusFactHint, Instance: currency.UpdateContractAccountStatusFact{}},
+	{Hint: currency.ContractAccountOwnerUpdaterFactHint, Instance: currency.ContractAccountOwnerUpdaterFact{}},
+	{Hint: currency.GrantContractAccountOperatorFactHint, Instance: currency.GrantContractAccountOperatorFact{}},
+	{Hint: currency.RevokeContractAccountOperatorFactHint, Instance: currency.RevokeContractAccountOperatorFact{}},
+	{Hint: currency.UpdateContractAccountOwnersFactHint, Instance: currency.UpdateContractAccountOwnersFact{}},
+	{Hint: currency.BurnsFactHint, Instance: currency.BurnsFact{}},
+	{Hint: currency.MintFactHint, Instance: currency.MintFact{}},
+	{Hint: currency.FeeExchangeTransfersFactHint, Instance: currency.FeeExchangeTransfersFact{}},
+	{Hint: currency.FeeExchangeCreateAccountsFactHint, Instance: currency.FeeExchangeCreateAccountsFact{}},
+	{Hint: currency.ExchangeRateUpdaterFactHint, Instance: currency.ExchangeRateUpdaterFact{}},
+	{Hint: currency.SponsoredTransfersFactHint, Instance: currency.SponsoredTransfersFact{}},
+	{Hint: currency.SponsoredCreateAccountsFactHint, Instance: currency.SponsoredCreateAccountsFact{}},
+	{Hint: currency.FeeExchangeWithdrawsFactHint, Instance: currency.FeeExchangeWithdrawsFact{}},
+	{Hint: currency.SponsoredWithdrawsFactHint, Instance: currency.SponsoredWithdrawsFact{}},
+	{Hint: currency.LockedTransfersFactHint, Instance: currency.LockedTransfersFact{}},
+	{Hint: currency.ClaimVestedFactHint, Instance: currency.ClaimVestedFact{}},
+	{Hint: currency.CreateEscrowFactHint, Instance: currency.CreateEscrowFact{}},
+	{Hint: currency.ReleaseEscrowFactHint, Instance: currency.ReleaseEscrowFact{}},
+	{Hint: currency.RefundEscrowFactHint, Instance: currency.RefundEscrowFact{}},
+	{Hint: currency.ProposeOperationFactHint, Instance: currency.ProposeOperationFact{}},
+	{Hint: currency.ApproveOperationFactHint, Instance: currency.ApproveOperationFact{}},
+	{Hint: currency.FreezeAccountsFactHint, Instance: currency.FreezeAccountsFact{}},
+	{Hint: currency.UnfreezeAccountsFactHint, Instance: currency.UnfreezeAccountsFact{}},
+	{Hint: currency.ApproveFactHint, Instance: currency.ApproveFact{}},
+	{Hint: currency.TransferFromFactHint, Instance: currency.TransferFromFact{}},
+	{Hint: currency.MemoTransfersFactHint, Instance: currency.MemoTransfersFact{}},
+	{Hint: currency.ScheduleOperationFactHint, Instance: currency.ScheduleOperationFact{}},
+	{Hint: currency.ExecuteScheduledOperationFactHint, Instance: currency.ExecuteScheduledOperationFact{}},
+	{Hint: currency.CancelScheduledOperationFactHint, Instance: currency.CancelScheduledOperationFact{}},
+	{Hint: currency.CurrencyMetadataUpdaterFactHint, Instance: currency.CurrencyMetadataUpdaterFact{}},
+	{Hint: currency.SetRecoveryGuardiansFactHint, Instance: currency.SetRecoveryGuardiansFact{}},
+	{Hint: currency.RecoverAccountFactHint, Instance: currency.RecoverAccountFact{}},
+	{Hint: currency.CancelAccountRecoveryFactHint, Instance: currency.CancelAccountRecoveryFact{}},
+	{Hint: currency.SetKeyRotationDelayFactHint, Instance: currency.SetKeyRotationDelayFact{}},
+	{Hint: currency.CancelKeyRotationFactHint, Instance: currency.CancelKeyRotationFact{}},
 }
 
 func init() {
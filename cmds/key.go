@@ -1,17 +1,19 @@
 package cmds
 
 type KeyCommand struct {
-	New     KeyNewCommand     `cmd:"" help:"generate new key"`
-	Address KeyAddressCommand `cmd:"" help:"generate address from key"`
-	Load    KeyLoadCommand    `cmd:"" help:"load key"`
-	Sign    KeySignCommand    `cmd:"" help:"sign"`
+	New             KeyNewCommand             `cmd:"" help:"generate new key"`
+	Address         KeyAddressCommand         `cmd:"" help:"generate address from key"`
+	ContractAddress KeyContractAddressCommand `cmd:"" name:"contract-address" help:"generate contract account address from sender and salt"`
+	Load            KeyLoadCommand            `cmd:"" help:"load key"`
+	Sign            KeySignCommand            `cmd:"" help:"sign"`
 }
 
 func NewKeyCommand() KeyCommand {
 	return KeyCommand{
-		New:     NewKeyNewCommand(),
-		Address: NewKeyAddressCommand(),
-		Load:    NewKeyLoadCommand(),
-		Sign:    NewKeySignCommand(),
+		New:             NewKeyNewCommand(),
+		Address:         NewKeyAddressCommand(),
+		ContractAddress: NewKeyContractAddressCommand(),
+		Load:            NewKeyLoadCommand(),
+		Sign:            NewKeySignCommand(),
 	}
 }
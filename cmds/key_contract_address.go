@@ -0,0 +1,44 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+)
+
+type KeyContractAddressCommand struct {
+	baseCommand
+	Sender AddressFlag `arg:"" name:"sender" help:"sender address which creates contract account" required:"true"`
+	Salt   string      `arg:"" name:"salt" help:"salt for address" required:"true"`
+}
+
+func NewKeyContractAddressCommand() KeyContractAddressCommand {
+	cmd := NewbaseCommand()
+	return KeyContractAddressCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *KeyContractAddressCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+
+	a, err := currency.NewContractAddressFromSalt(sender, cmd.Salt)
+	if err != nil {
+		return err
+	}
+
+	cmd.log.Debug().Stringer("sender", sender).Str("salt", cmd.Salt).Msg("contract address derived")
+
+	cmd.print(a.String())
+
+	return nil
+}
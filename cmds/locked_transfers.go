@@ -0,0 +1,109 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type LockedTransfersCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Start    uint64               `arg:"" name:"start" help:"block height where release starts" required:"true"`
+	End      uint64               `arg:"" name:"end" help:"block height where all amounts are released; same with start for cliff" required:"true"` // revive:disable-line:line-length-limit
+	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewLockedTransfersCommand() LockedTransfersCommand {
+	cmd := NewbaseCommand()
+	return LockedTransfersCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *LockedTransfersCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *LockedTransfersCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Amounts) < 1 {
+		return errors.Errorf("empty currency-amount, must be given at least one")
+	}
+
+	if cmd.End < cmd.Start {
+		return errors.Errorf("end height, %d should not be under start height, %d", cmd.End, cmd.Start)
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if receiver, err := cmd.Receiver.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = sender
+		cmd.receiver = receiver
+	}
+
+	return nil
+}
+
+func (cmd *LockedTransfersCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Amounts))
+	for i := range cmd.Amounts {
+		a := cmd.Amounts[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	item := currency.NewLockedTransfersItem(cmd.receiver, ams, base.Height(cmd.Start), base.Height(cmd.End))
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewLockedTransfersFact([]byte(cmd.Token), cmd.sender, []currency.LockedTransfersItem{item})
+
+	op, err := currency.NewLockedTransfers(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create locked-transfers operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create locked-transfers operation")
+	}
+
+	return op, nil
+}
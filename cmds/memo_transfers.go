@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type MemoTransfersCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Memo     string               `name:"memo" help:"memo of transfer"`
+	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewMemoTransfersCommand() MemoTransfersCommand {
+	cmd := NewbaseCommand()
+	return MemoTransfersCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *MemoTransfersCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *MemoTransfersCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Amounts) < 1 {
+		return errors.Errorf("empty currency-amount, must be given at least one")
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if receiver, err := cmd.Receiver.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = sender
+		cmd.receiver = receiver
+	}
+
+	return nil
+}
+
+func (cmd *MemoTransfersCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Amounts))
+	for i := range cmd.Amounts {
+		a := cmd.Amounts[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	item := currency.NewMemoTransfersItem(cmd.receiver, ams, cmd.Memo)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewMemoTransfersFact([]byte(cmd.Token), cmd.sender, []currency.MemoTransfersItem{item})
+
+	op, err := currency.NewMemoTransfers(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create memo-transfers operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create memo-transfers operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type MintCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag        `arg:"" name:"sender" help:"minter address" required:"true"`
+	Receiver AddressFlag        `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amount   CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")" required:"true"`
+	sender   base.Address
+	receiver base.Address
+}
+
+func NewMintCommand() MintCommand {
+	cmd := NewbaseCommand()
+	return MintCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *MintCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *MintCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	receiver, err := cmd.Receiver.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	}
+	cmd.receiver = receiver
+
+	return nil
+}
+
+func (cmd *MintCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	am := mitumcurrency.NewAmount(cmd.Amount.Big, cmd.Amount.CID)
+	if err := am.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	fact := currency.NewMintFact([]byte(cmd.Token), cmd.sender, cmd.receiver, am)
+
+	op, err := currency.NewMint(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mint operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mint operation")
+	}
+
+	return op, nil
+}
@@ -1,31 +1,89 @@
 package cmds
 
 type OperationCommand struct {
-	CreateAccount         CreateAccountCommand         `cmd:"" name:"create-account" help:"create new account"`
-	KeyUpdater            KeyUpdaterCommand            `cmd:"" name:"key-updater" help:"update account keys"`
-	Transfer              TransferCommand              `cmd:"" name:"transfer" help:"transfer amounts to receiver"`
-	CreateContractAccount CreateContractAccountCommand `cmd:"" name:"create-contract-account" help:"create new contract account"`
-	Withdraw              WithdrawCommand              `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
-	CurrencyRegister      CurrencyRegisterCommand      `cmd:"" name:"currency-register" help:"register new currency"`
-	CurrencyPolicyUpdater CurrencyPolicyUpdaterCommand `cmd:"" name:"currency-policy-updater" help:"update currency policy"`
-	SuffrageInflation     SuffrageInflationCommand     `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"`
-	SuffrageCandidate     SuffrageCandidateCommand     `cmd:"" name:"suffrage-candidate" help:"suffrage candidate operation"`
-	SuffrageJoin          SuffrageJoinCommand          `cmd:"" name:"suffrage-join" help:"suffrage join operation"`
-	SuffrageDisjoin       SuffrageDisjoinCommand       `cmd:"" name:"suffrage-disjoin" help:"suffrage disjoin operation"` // revive:disable-line:line-length-limit
+	CreateAccount                 CreateAccountCommand                 `cmd:"" name:"create-account" help:"create new account"`
+	KeyUpdater                    KeyUpdaterCommand                    `cmd:"" name:"key-updater" help:"update account keys"`
+	Transfer                      TransferCommand                      `cmd:"" name:"transfer" help:"transfer amounts to receiver"`
+	CreateContractAccount         CreateContractAccountCommand         `cmd:"" name:"create-contract-account" help:"create new contract account"`
+	Withdraw                      WithdrawCommand                      `cmd:"" name:"withdraw" help:"withdraw amounts from target contract account"`
+	UpdateContractAccountStatus   UpdateContractAccountStatusCommand   `cmd:"" name:"update-contract-account-status" help:"activate or deactivate contract account"`
+	ContractAccountOwnerUpdater   ContractAccountOwnerUpdaterCommand   `cmd:"" name:"contract-owner-update" help:"update contract account owner"`
+	GrantContractAccountOperator  GrantContractAccountOperatorCommand  `cmd:"" name:"contract-operator-grant" help:"grant withdraw allowances to contract account operator"`
+	RevokeContractAccountOperator RevokeContractAccountOperatorCommand `cmd:"" name:"contract-operator-revoke" help:"revoke contract account operator"`
+	UpdateContractAccountOwners   UpdateContractAccountOwnersCommand   `cmd:"" name:"contract-owners-update" help:"update contract account owners and threshold"`
+	Burn                          BurnCommand                          `cmd:"" name:"burn" help:"burn amounts from sender balance"`
+	Mint                          MintCommand                          `cmd:"" name:"mint" help:"mint amount to receiver by currency minter"`
+	LockedTransfers               LockedTransfersCommand               `cmd:"" name:"locked-transfers" help:"transfer amounts locked by vesting to receiver"`
+	ClaimVested                   ClaimVestedCommand                   `cmd:"" name:"claim-vested" help:"claim released amounts of vesting locks"`
+	CreateEscrow                  CreateEscrowCommand                  `cmd:"" name:"create-escrow" help:"hold amounts in escrow resolved by arbiter"`
+	ReleaseEscrow                 ReleaseEscrowCommand                 `cmd:"" name:"release-escrow" help:"release escrowed amounts to payee"`
+	RefundEscrow                  RefundEscrowCommand                  `cmd:"" name:"refund-escrow" help:"refund escrowed amounts to payer"`
+	ProposeOperation              ProposeOperationCommand              `cmd:"" name:"propose-operation" help:"propose operation of multi-key account to be approved"`
+	ApproveOperation              ApproveOperationCommand              `cmd:"" name:"approve-operation" help:"approve pending operation of multi-key account"`
+	CurrencyRegister              CurrencyRegisterCommand              `cmd:"" name:"currency-register" help:"register new currency"`
+	CurrencyPolicyUpdater         CurrencyPolicyUpdaterCommand         `cmd:"" name:"currency-policy-updater" help:"update currency policy"`
+	CurrencyMetadataUpdater       CurrencyMetadataUpdaterCommand       `cmd:"" name:"currency-metadata-updater" help:"update currency metadata"`
+	ExchangeRateUpdater           ExchangeRateUpdaterCommand           `cmd:"" name:"exchange-rate-updater" help:"update exchange rate between currencies"`
+	FreezeAccounts                FreezeAccountsCommand                `cmd:"" name:"freeze-accounts" help:"freeze accounts by suffrage"`
+	UnfreezeAccounts              UnfreezeAccountsCommand              `cmd:"" name:"unfreeze-accounts" help:"unfreeze frozen accounts by suffrage"`
+	Approve                       ApproveCommand                       `cmd:"" name:"approve" help:"approve allowance of currency to spender"`
+	TransferFrom                  TransferFromCommand                  `cmd:"" name:"transfer-from" help:"transfer amounts of owner within allowance"`
+	MemoTransfers                 MemoTransfersCommand                 `cmd:"" name:"memo-transfers" help:"transfer amounts to receiver with memo"`
+	ScheduleOperation             ScheduleOperationCommand             `cmd:"" name:"schedule-operation" help:"schedule signed operation to be processed at target height"`
+	ExecuteScheduledOperation     ExecuteScheduledOperationCommand     `cmd:"" name:"execute-scheduled-operation" help:"execute scheduled operation at or after target height"`
+	CancelScheduledOperation      CancelScheduledOperationCommand      `cmd:"" name:"cancel-scheduled-operation" help:"cancel scheduled operation before target height"`
+	SetRecoveryGuardians          SetRecoveryGuardiansCommand          `cmd:"" name:"set-recovery-guardians" help:"set guardians who can recover account keys"`
+	RecoverAccount                RecoverAccountCommand                `cmd:"" name:"recover-account" help:"approve or apply recovery of account keys by guardian"`
+	CancelAccountRecovery         CancelAccountRecoveryCommand         `cmd:"" name:"cancel-account-recovery" help:"cancel pending recovery of account keys"`
+	SetKeyRotationDelay           SetKeyRotationDelayCommand           `cmd:"" name:"set-key-rotation-delay" help:"set blocks for which key update of account is pending"`
+	CancelKeyRotation             CancelKeyRotationCommand             `cmd:"" name:"cancel-key-rotation" help:"cancel pending key rotation and recovery guardians with current keys"`
+	SuffrageInflation             SuffrageInflationCommand             `cmd:"" name:"suffrage-inflation" help:"suffrage inflation operation"`
+	SuffrageCandidate             SuffrageCandidateCommand             `cmd:"" name:"suffrage-candidate" help:"suffrage candidate operation"`
+	SuffrageJoin                  SuffrageJoinCommand                  `cmd:"" name:"suffrage-join" help:"suffrage join operation"`
+	SuffrageDisjoin               SuffrageDisjoinCommand               `cmd:"" name:"suffrage-disjoin" help:"suffrage disjoin operation"` // revive:disable-line:line-length-limit
 }
 
 func NewOperationCommand() OperationCommand {
 	return OperationCommand{
-		CreateAccount:         NewCreateAccountCommand(),
-		KeyUpdater:            NewKeyUpdaterCommand(),
-		Transfer:              NewTransferCommand(),
-		CreateContractAccount: NewCreateContractAccountCommand(),
-		Withdraw:              NewWithdrawCommand(),
-		CurrencyRegister:      NewCurrencyRegisterCommand(),
-		CurrencyPolicyUpdater: NewCurrencyPolicyUpdaterCommand(),
-		SuffrageInflation:     NewSuffrageInflationCommand(),
-		SuffrageCandidate:     NewSuffrageCandidateCommand(),
-		SuffrageJoin:          NewSuffrageJoinCommand(),
-		SuffrageDisjoin:       NewSuffrageDisjoinCommand(),
+		CreateAccount:                 NewCreateAccountCommand(),
+		KeyUpdater:                    NewKeyUpdaterCommand(),
+		Transfer:                      NewTransferCommand(),
+		CreateContractAccount:         NewCreateContractAccountCommand(),
+		Withdraw:                      NewWithdrawCommand(),
+		UpdateContractAccountStatus:   NewUpdateContractAccountStatusCommand(),
+		ContractAccountOwnerUpdater:   NewContractAccountOwnerUpdaterCommand(),
+		GrantContractAccountOperator:  NewGrantContractAccountOperatorCommand(),
+		RevokeContractAccountOperator: NewRevokeContractAccountOperatorCommand(),
+		UpdateContractAccountOwners:   NewUpdateContractAccountOwnersCommand(),
+		Burn:                          NewBurnCommand(),
+		Mint:                          NewMintCommand(),
+		LockedTransfers:               NewLockedTransfersCommand(),
+		ClaimVested:                   NewClaimVestedCommand(),
+		CreateEscrow:                  NewCreateEscrowCommand(),
+		ReleaseEscrow:                 NewReleaseEscrowCommand(),
+		RefundEscrow:                  NewRefundEscrowCommand(),
+		ProposeOperation:              NewProposeOperationCommand(),
+		ApproveOperation:              NewApproveOperationCommand(),
+		CurrencyRegister:              NewCurrencyRegisterCommand(),
+		CurrencyPolicyUpdater:         NewCurrencyPolicyUpdaterCommand(),
+		CurrencyMetadataUpdater:       NewCurrencyMetadataUpdaterCommand(),
+		ExchangeRateUpdater:           NewExchangeRateUpdaterCommand(),
+		FreezeAccounts:                NewFreezeAccountsCommand(),
+		UnfreezeAccounts:              NewUnfreezeAccountsCommand(),
+		Approve:                       NewApproveCommand(),
+		TransferFrom:                  NewTransferFromCommand(),
+		MemoTransfers:                 NewMemoTransfersCommand(),
+		ScheduleOperation:             NewScheduleOperationCommand(),
+		ExecuteScheduledOperation:     NewExecuteScheduledOperationCommand(),
+		CancelScheduledOperation:      NewCancelScheduledOperationCommand(),
+		SetRecoveryGuardians:          NewSetRecoveryGuardiansCommand(),
+		RecoverAccount:                NewRecoverAccountCommand(),
+		CancelAccountRecovery:         NewCancelAccountRecoveryCommand(),
+		SetKeyRotationDelay:           NewSetKeyRotationDelayCommand(),
+		CancelKeyRotation:             NewCancelKeyRotationCommand(),
+		SuffrageInflation:             NewSuffrageInflationCommand(),
+		SuffrageCandidate:             NewSuffrageCandidateCommand(),
+		SuffrageJoin:                  NewSuffrageJoinCommand(),
+		SuffrageDisjoin:               NewSuffrageDisjoinCommand(),
 	}
 }
@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type ProposeOperationCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag `arg:"" name:"sender" help:"sender address; multi-key account" required:"true"`
+	Operation *os.File    `arg:"" name:"operation" help:"operation json to propose; it is signed again for the proposal" required:"true"`
+	Lifespan  uint64      `arg:"" name:"lifespan" help:"blocks in which proposed operation can be approved" required:"true"`
+	sender    base.Address
+	operation base.Operation
+}
+
+func NewProposeOperationCommand() ProposeOperationCommand {
+	cmd := NewbaseCommand()
+	return ProposeOperationCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ProposeOperationCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	defer func() {
+		_ = cmd.Operation.Close()
+	}()
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	body, err := io.ReadAll(cmd.Operation)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	i, err := enc.Decode(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode operation")
+	}
+
+	op, err := signOperation(
+		i,
+		cmd.Privatekey,
+		currency.PendingNetworkID(cmd.NetworkID.NetworkID(), []byte(cmd.Token), base.Height(cmd.Lifespan)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign operation for proposal")
+	}
+	cmd.operation = op
+
+	return nil
+}
+
+func (cmd *ProposeOperationCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewProposeOperationFact([]byte(cmd.Token), cmd.sender, cmd.operation, base.Height(cmd.Lifespan))
+
+	op, err := currency.NewProposeOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create propose-operation operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create propose-operation operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type RecoverAccountCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag    `arg:"" name:"sender" help:"guardian address" required:"true"`
+	Account   AddressFlag    `arg:"" name:"account" help:"account address to be recovered" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Threshold uint           `help:"threshold for keys (default: ${create_account_threshold})" default:"${create_account_threshold}"` // nolint
+	Keys      []KeyFlag      `name:"key" help:"new key of account (ex: \"<public key>,<weight>\")" sep:"@"`
+	sender    base.Address
+	account   base.Address
+	keys      mitumcurrency.BaseAccountKeys
+}
+
+func NewRecoverAccountCommand() RecoverAccountCommand {
+	cmd := NewbaseCommand()
+	return RecoverAccountCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *RecoverAccountCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RecoverAccountCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if account, err := cmd.Account.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid account format, %q", cmd.Account.String())
+	} else {
+		cmd.sender = sender
+		cmd.account = account
+	}
+
+	if len(cmd.Keys) < 1 {
+		return errors.Errorf("--key must be given at least one")
+	}
+
+	{
+		ks := make([]mitumcurrency.AccountKey, len(cmd.Keys))
+		for i := range cmd.Keys {
+			ks[i] = cmd.Keys[i].Key
+		}
+
+		if kys, err := mitumcurrency.NewBaseAccountKeys(ks, cmd.Threshold); err != nil {
+			return err
+		} else if err := kys.IsValid(nil); err != nil {
+			return err
+		} else {
+			cmd.keys = kys
+		}
+	}
+
+	return nil
+}
+
+func (cmd *RecoverAccountCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewRecoverAccountFact([]byte(cmd.Token), cmd.sender, cmd.account, cmd.keys, cmd.Currency.CID)
+
+	op, err := currency.NewRecoverAccount(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recover-account operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recover-account operation")
+	}
+
+	return op, nil
+}
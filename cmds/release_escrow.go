@@ -0,0 +1,85 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ReleaseEscrowCommand struct {
+	baseCommand
+	OperationFlags
+	Sender AddressFlag `arg:"" name:"sender" help:"sender address; arbiter or payer after expiry" required:"true"`
+	Escrow string      `arg:"" name:"escrow" help:"escrow id, fact hash of create-escrow" required:"true"`
+	sender base.Address
+	escrow util.Hash
+}
+
+func NewReleaseEscrowCommand() ReleaseEscrowCommand {
+	cmd := NewbaseCommand()
+	return ReleaseEscrowCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ReleaseEscrowCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ReleaseEscrowCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	escrow := valuehash.NewBytesFromString(cmd.Escrow)
+	if err := escrow.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid escrow id, %q", cmd.Escrow)
+	}
+	cmd.escrow = escrow
+
+	return nil
+}
+
+func (cmd *ReleaseEscrowCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewReleaseEscrowFact([]byte(cmd.Token), cmd.sender, cmd.escrow)
+
+	op, err := currency.NewReleaseEscrow(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create release-escrow operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create release-escrow operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,134 @@
+package cmds
+
+import (
+	"context"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type ScheduleOperationCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Operation *os.File       `arg:"" name:"operation" help:"operation json to schedule; it is signed again for the schedule" required:"true"`
+	Height    uint64         `arg:"" name:"height" help:"target block height where operation is processed" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id to reserve fee" required:"true"`
+	sender    base.Address
+	operation base.Operation
+}
+
+func NewScheduleOperationCommand() ScheduleOperationCommand {
+	cmd := NewbaseCommand()
+	return ScheduleOperationCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *ScheduleOperationCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	defer func() {
+		_ = cmd.Operation.Close()
+	}()
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ScheduleOperationCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	body, err := io.ReadAll(cmd.Operation)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	i, err := enc.Decode(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode operation")
+	}
+
+	op, err := signOperation(
+		i, cmd.Privatekey, currency.ScheduleNetworkID(cmd.NetworkID.NetworkID(), base.Height(cmd.Height)))
+	if err != nil {
+		return errors.Wrap(err, "failed to sign operation for schedule")
+	}
+	cmd.operation = op
+
+	return nil
+}
+
+// signOperation signs the operation with the network id, like the network id
+// of schedule; the other signs of operation should be also signed with it.
+func signOperation(i interface{}, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+	if _, ok := i.(base.Operation); !ok {
+		return nil, errors.Errorf("expected base.Operation, not %T", i)
+	}
+
+	ptr := reflect.New(reflect.ValueOf(i).Type()).Interface()
+	if err := util.InterfaceSetValue(i, ptr); err != nil {
+		return nil, err
+	}
+
+	signer, ok := ptr.(base.Signer)
+	if !ok {
+		return nil, errors.Errorf("operation is not Signer, %T", i)
+	}
+
+	if err := signer.Sign(priv, networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign operation")
+	}
+
+	op, ok := reflect.ValueOf(ptr).Elem().Interface().(base.Operation)
+	if !ok {
+		return nil, errors.Errorf("expected base.Operation, not %T", ptr)
+	}
+
+	return op, nil
+}
+
+func (cmd *ScheduleOperationCommand) createOperation() (base.Operation, error) {
+	fact := currency.NewScheduleOperationFact(
+		[]byte(cmd.Token), cmd.sender, cmd.operation, base.Height(cmd.Height), cmd.Currency.CID)
+
+	op, err := currency.NewScheduleOperation(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create schedule-operation operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create schedule-operation operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,77 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type SetKeyRotationDelayCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Delay    uint64         `arg:"" name:"delay" help:"blocks for which key update is pending; 0 for immediate key update" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+}
+
+func NewSetKeyRotationDelayCommand() SetKeyRotationDelayCommand {
+	cmd := NewbaseCommand()
+	return SetKeyRotationDelayCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *SetKeyRotationDelayCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SetKeyRotationDelayCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	return nil
+}
+
+func (cmd *SetKeyRotationDelayCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewSetKeyRotationDelayFact([]byte(cmd.Token), cmd.sender, base.Height(cmd.Delay), cmd.Currency.CID)
+
+	op, err := currency.NewSetKeyRotationDelay(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create set-key-rotation-delay operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create set-key-rotation-delay operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type SetRecoveryGuardiansCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Threshold uint           `help:"number of guardians to approve recovery; 0 for removing guardians" default:"0"`
+	Delay     uint64         `help:"blocks between approval and recovery of keys" default:"0"`
+	Guardians []AddressFlag  `name:"guardian" help:"guardian address"`
+	sender    base.Address
+	guardians []base.Address
+}
+
+func NewSetRecoveryGuardiansCommand() SetRecoveryGuardiansCommand {
+	cmd := NewbaseCommand()
+	return SetRecoveryGuardiansCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *SetRecoveryGuardiansCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SetRecoveryGuardiansCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	guardians := make([]base.Address, len(cmd.Guardians))
+	for i := range cmd.Guardians {
+		guardian, err := cmd.Guardians[i].Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid guardian format, %q", cmd.Guardians[i].String())
+		}
+
+		guardians[i] = guardian
+	}
+	cmd.guardians = guardians
+
+	return nil
+}
+
+func (cmd *SetRecoveryGuardiansCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewSetRecoveryGuardiansFact(
+		[]byte(cmd.Token), cmd.sender, cmd.guardians, cmd.Threshold, base.Height(cmd.Delay), cmd.Currency.CID)
+
+	op, err := currency.NewSetRecoveryGuardians(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create set-recovery-guardians operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create set-recovery-guardians operation")
+	}
+
+	return op, nil
+}
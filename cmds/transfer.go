@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	currency "github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 )
@@ -12,11 +13,14 @@ import (
 type TransferCommand struct {
 	baseCommand
 	OperationFlags
-	Sender   AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
-	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
-	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
-	sender   base.Address
-	receiver base.Address
+	Sender      AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Receiver    AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee by exchange rate"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address which pays fee instead of sender"`
+	sender      base.Address
+	receiver    base.Address
+	feePayer    base.Address
 }
 
 func NewTransferCommand() TransferCommand {
@@ -66,6 +70,18 @@ func (cmd *TransferCommand) parseFlags() error {
 		cmd.receiver = receiver
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		if len(cmd.FeeCurrency.CID) > 0 {
+			return errors.Errorf("--fee-currency and --fee-payer can not be used together")
+		}
+
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %q", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -89,6 +105,36 @@ func (cmd *TransferCommand) createOperation() (base.Operation, error) { // nolin
 	}
 	items = append(items, item)
 
+	if cmd.feePayer != nil {
+		fact := extensioncurrency.NewSponsoredTransfersFact([]byte(cmd.Token), cmd.sender, items, cmd.feePayer, cmd.FeeCurrency.CID)
+
+		op, err := extensioncurrency.NewSponsoredTransfers(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-transfers operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-transfers operation")
+		}
+
+		return op, nil
+	}
+
+	if len(cmd.FeeCurrency.CID) > 0 {
+		fact := extensioncurrency.NewFeeExchangeTransfersFact([]byte(cmd.Token), cmd.sender, items, cmd.FeeCurrency.CID)
+
+		op, err := extensioncurrency.NewFeeExchangeTransfers(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-transfers operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-transfers operation")
+		}
+
+		return op, nil
+	}
+
 	fact := currency.NewTransfersFact([]byte(cmd.Token), cmd.sender, items)
 
 	op, err := currency.NewTransfers(fact)
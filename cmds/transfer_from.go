@@ -0,0 +1,103 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type TransferFromCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag          `arg:"" name:"sender" help:"spender address" required:"true"`
+	Owner    AddressFlag          `arg:"" name:"owner" help:"owner address which approved allowance" required:"true"`
+	Receiver AddressFlag          `arg:"" name:"receiver" help:"receiver address" required:"true"`
+	Amounts  []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+}
+
+func NewTransferFromCommand() TransferFromCommand {
+	cmd := NewbaseCommand()
+	return TransferFromCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *TransferFromCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *TransferFromCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if len(cmd.Amounts) < 1 {
+		return errors.Errorf("empty currency-amount, must be given at least one")
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if owner, err := cmd.Owner.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid owner format, %q", cmd.Owner.String())
+	} else if receiver, err := cmd.Receiver.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid receiver format, %q", cmd.Receiver.String())
+	} else {
+		cmd.sender = sender
+		cmd.owner = owner
+		cmd.receiver = receiver
+	}
+
+	return nil
+}
+
+func (cmd *TransferFromCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	ams := make([]mitumcurrency.Amount, len(cmd.Amounts))
+	for i := range cmd.Amounts {
+		a := cmd.Amounts[i]
+		am := mitumcurrency.NewAmount(a.Big, a.CID)
+		if err := am.IsValid(nil); err != nil {
+			return nil, err
+		}
+
+		ams[i] = am
+	}
+
+	fact := currency.NewTransferFromFact([]byte(cmd.Token), cmd.sender, cmd.owner, cmd.receiver, ams)
+
+	op, err := currency.NewTransferFrom(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transfer-from operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transfer-from operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UnfreezeAccountsCommand struct {
+	baseCommand
+	OperationFlags
+	Node     AddressFlag    `arg:"" name:"node" help:"node address" required:"true"`
+	Accounts []AddressFlag  `arg:"" name:"account" help:"account address to unfreeze" required:"true"`
+	Currency CurrencyIDFlag `name:"currency-id" help:"currency id to unfreeze; all currencies if empty"`
+	node     base.Address
+	accounts []base.Address
+}
+
+func NewUnfreezeAccountsCommand() UnfreezeAccountsCommand {
+	cmd := NewbaseCommand()
+	return UnfreezeAccountsCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *UnfreezeAccountsCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	var op base.Operation
+	if i, err := cmd.createOperation(); err != nil {
+		return errors.Wrap(err, "failed to create unfreeze-accounts operation")
+	} else if err := i.IsValid([]byte(cmd.OperationFlags.NetworkID)); err != nil {
+		return errors.Wrap(err, "invalid unfreeze-accounts operation")
+	} else {
+		cmd.log.Debug().Interface("operation", i).Msg("operation loaded")
+
+		op = i
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UnfreezeAccountsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	a, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = a
+
+	accounts, err := parseFreezeAccounts(cmd.Accounts)
+	if err != nil {
+		return err
+	}
+	cmd.accounts = accounts
+
+	return nil
+}
+
+func (cmd *UnfreezeAccountsCommand) createOperation() (currency.UnfreezeAccounts, error) {
+	fact := currency.NewUnfreezeAccountsFact([]byte(cmd.Token), cmd.accounts, cmd.Currency.CID)
+
+	op, err := currency.NewUnfreezeAccounts(fact)
+	if err != nil {
+		return currency.UnfreezeAccounts{}, err
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return currency.UnfreezeAccounts{}, errors.Wrap(err, "failed to create unfreeze-accounts operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,96 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateContractAccountOwnersCommand struct {
+	baseCommand
+	OperationFlags
+	Sender    AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
+	Currency  CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Threshold uint           `arg:"" name:"threshold" help:"threshold of owners; 0 for single owner" required:"true"`
+	Owners    []AddressFlag  `arg:"" name:"owner" help:"owner address" optional:""`
+	sender    base.Address
+	contract  base.Address
+	owners    []base.Address
+}
+
+func NewUpdateContractAccountOwnersCommand() UpdateContractAccountOwnersCommand {
+	cmd := NewbaseCommand()
+	return UpdateContractAccountOwnersCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *UpdateContractAccountOwnersCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountOwnersCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if contract, err := cmd.Contract.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid contract format, %q", cmd.Contract.String())
+	} else {
+		cmd.sender = sender
+		cmd.contract = contract
+	}
+
+	owners := make([]base.Address, len(cmd.Owners))
+	for i := range cmd.Owners {
+		owner, err := cmd.Owners[i].Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid owner format, %q", cmd.Owners[i].String())
+		}
+
+		owners[i] = owner
+	}
+	cmd.owners = owners
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountOwnersCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewUpdateContractAccountOwnersFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.owners, cmd.Threshold, cmd.Currency.CID)
+
+	op, err := currency.NewUpdateContractAccountOwners(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-owners-update operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create contract-owners-update operation")
+	}
+
+	return op, nil
+}
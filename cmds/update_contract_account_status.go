@@ -0,0 +1,93 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateContractAccountStatusCommand struct {
+	baseCommand
+	OperationFlags
+	Sender   AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Target   AddressFlag    `arg:"" name:"target" help:"target contract account address" required:"true"`
+	Status   string         `arg:"" name:"status" help:"contract account status (active | inactive)" required:"true"`
+	Currency CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender   base.Address
+	target   base.Address
+	isActive bool
+}
+
+func NewUpdateContractAccountStatusCommand() UpdateContractAccountStatusCommand {
+	cmd := NewbaseCommand()
+	return UpdateContractAccountStatusCommand{
+		baseCommand: *cmd,
+	}
+}
+
+func (cmd *UpdateContractAccountStatusCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.encs
+	enc = cmd.enc
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountStatusCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if sender, err := cmd.Sender.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	} else if target, err := cmd.Target.Encode(enc); err != nil {
+		return errors.Wrapf(err, "invalid target format, %q", cmd.Target.String())
+	} else {
+		cmd.sender = sender
+		cmd.target = target
+	}
+
+	switch cmd.Status {
+	case "active":
+		cmd.isActive = true
+	case "inactive":
+		cmd.isActive = false
+	default:
+		return errors.Errorf("invalid status, %q; must be active or inactive", cmd.Status)
+	}
+
+	return nil
+}
+
+func (cmd *UpdateContractAccountStatusCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := currency.NewUpdateContractAccountStatusFact([]byte(cmd.Token), cmd.sender, cmd.target, cmd.isActive, cmd.Currency.CID)
+
+	op, err := currency.NewUpdateContractAccountStatus(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-contract-account-status operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-contract-account-status operation")
+	}
+
+	return op, nil
+}
@@ -172,6 +172,41 @@ func POperationProcessorsMap(ctx context.Context) (context.Context, error) {
 	opr.SetProcessor(mitumcurrency.SuffrageInflationHint, currency.NewSuffrageInflationProcessor(params.Threshold()))
 	opr.SetProcessor(currency.CreateContractAccountsHint, currency.NewCreateContractAccountsProcessor())
 	opr.SetProcessor(currency.WithdrawsHint, currency.NewWithdrawsProcessor())
+	opr.SetProcessor(currency.UpdateContractAccountStatusHint, currency.NewUpdateContractAccountStatusProcessor())
+	opr.SetProcessor(currency.ContractAccountOwnerUpdaterHint, currency.NewContractAccountOwnerUpdaterProcessor())
+	opr.SetProcessor(currency.GrantContractAccountOperatorHint, currency.NewGrantContractAccountOperatorProcessor())
+	opr.SetProcessor(currency.RevokeContractAccountOperatorHint, currency.NewRevokeContractAccountOperatorProcessor())
+	opr.SetProcessor(currency.UpdateContractAccountOwnersHint, currency.NewUpdateContractAccountOwnersProcessor())
+	opr.SetProcessor(currency.BurnsHint, currency.NewBurnsProcessor())
+	opr.SetProcessor(currency.MintHint, currency.NewMintProcessor())
+	opr.SetProcessor(currency.FeeExchangeTransfersHint, currency.NewTransfersProcessor())
+	opr.SetProcessor(currency.FeeExchangeCreateAccountsHint, currency.NewCreateAccountsProcessor())
+	opr.SetProcessor(currency.ExchangeRateUpdaterHint, currency.NewExchangeRateUpdaterProcessor(params.Threshold()))
+	opr.SetProcessor(currency.SponsoredTransfersHint, currency.NewTransfersProcessor())
+	opr.SetProcessor(currency.SponsoredCreateAccountsHint, currency.NewCreateAccountsProcessor())
+	opr.SetProcessor(currency.FeeExchangeWithdrawsHint, currency.NewWithdrawsProcessor())
+	opr.SetProcessor(currency.SponsoredWithdrawsHint, currency.NewWithdrawsProcessor())
+	opr.SetProcessor(currency.LockedTransfersHint, currency.NewLockedTransfersProcessor())
+	opr.SetProcessor(currency.ClaimVestedHint, currency.NewClaimVestedProcessor())
+	opr.SetProcessor(currency.CreateEscrowHint, currency.NewCreateEscrowProcessor())
+	opr.SetProcessor(currency.ReleaseEscrowHint, currency.NewReleaseEscrowProcessor())
+	opr.SetProcessor(currency.RefundEscrowHint, currency.NewRefundEscrowProcessor())
+	opr.SetProcessor(currency.ProposeOperationHint, currency.NewProposeOperationProcessor(opr.GetNewProcessorByHint))
+	opr.SetProcessor(currency.ApproveOperationHint, currency.NewApproveOperationProcessor(opr.GetNewProcessorByHint))
+	opr.SetProcessor(currency.FreezeAccountsHint, currency.NewFreezeAccountsProcessor(params.Threshold()))
+	opr.SetProcessor(currency.UnfreezeAccountsHint, currency.NewUnfreezeAccountsProcessor(params.Threshold()))
+	opr.SetProcessor(currency.ApproveHint, currency.NewApproveProcessor())
+	opr.SetProcessor(currency.TransferFromHint, currency.NewTransferFromProcessor())
+	opr.SetProcessor(currency.MemoTransfersHint, currency.NewTransfersProcessor())
+	opr.SetProcessor(currency.ScheduleOperationHint, currency.NewScheduleOperationProcessor(opr.GetNewProcessorByHint))
+	opr.SetProcessor(currency.ExecuteScheduledOperationHint, currency.NewExecuteScheduledOperationProcessor(opr.GetNewProcessorByHint))
+	opr.SetProcessor(currency.CancelScheduledOperationHint, currency.NewCancelScheduledOperationProcessor())
+	opr.SetProcessor(currency.CurrencyMetadataUpdaterHint, currency.NewCurrencyMetadataUpdaterProcessor(params.Threshold()))
+	opr.SetProcessor(currency.SetRecoveryGuardiansHint, currency.NewSetRecoveryGuardiansProcessor())
+	opr.SetProcessor(currency.RecoverAccountHint, currency.NewRecoverAccountProcessor())
+	opr.SetProcessor(currency.CancelAccountRecoveryHint, currency.NewCancelAccountRecoveryProcessor())
+	opr.SetProcessor(currency.SetKeyRotationDelayHint, currency.NewSetKeyRotationDelayProcessor())
+	opr.SetProcessor(currency.CancelKeyRotationHint, currency.NewCancelKeyRotationProcessor())
 
 	_ = set.Add(mitumcurrency.CreateAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
 		return opr.New(
@@ -245,6 +280,321 @@ func POperationProcessorsMap(ctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(currency.UpdateContractAccountStatusHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ContractAccountOwnerUpdaterHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.GrantContractAccountOperatorHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.RevokeContractAccountOperatorHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.UpdateContractAccountOwnersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.BurnsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.MintHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.FeeExchangeTransfersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.FeeExchangeCreateAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ExchangeRateUpdaterHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.SponsoredTransfersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.SponsoredCreateAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.FeeExchangeWithdrawsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.SponsoredWithdrawsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.LockedTransfersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ClaimVestedHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CreateEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ReleaseEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.RefundEscrowHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ProposeOperationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ApproveOperationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.FreezeAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.UnfreezeAccountsHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ApproveHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.TransferFromHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.MemoTransfersHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ScheduleOperationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.ExecuteScheduledOperationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CancelScheduledOperationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CurrencyMetadataUpdaterHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.SetRecoveryGuardiansHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.RecoverAccountHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CancelAccountRecoveryHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.SetKeyRotationDelayHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(currency.CancelKeyRotationHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	_ = set.Add(isaacoperation.SuffrageCandidateHint, func(height base.Height) (base.OperationProcessor, error) {
 		policy := db.LastNetworkPolicy()
 		if policy == nil { // NOTE Usually it means empty block data
@@ -14,11 +14,14 @@ import (
 type WithdrawCommand struct {
 	baseCommand
 	OperationFlags
-	Sender  AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
-	Target  AddressFlag          `arg:"" name:"target" help:"target contract account address" required:"true"`
-	Amounts []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
-	sender  base.Address
-	target  base.Address
+	Sender      AddressFlag          `arg:"" name:"sender" help:"sender address" required:"true"`
+	Target      AddressFlag          `arg:"" name:"target" help:"target contract account address" required:"true"`
+	Amounts     []CurrencyAmountFlag `arg:"" name:"currency-amount" help:"amount (ex: \"<currency>,<amount>\")"`
+	FeeCurrency CurrencyIDFlag       `name:"fee-currency" help:"currency id to pay fee by exchange rate"`
+	FeePayer    AddressFlag          `name:"fee-payer" help:"fee payer address which pays fee instead of sender"`
+	sender      base.Address
+	target      base.Address
+	feePayer    base.Address
 }
 
 func NewWithdrawCommand() WithdrawCommand {
@@ -68,6 +71,14 @@ func (cmd *WithdrawCommand) parseFlags() error {
 		cmd.target = target
 	}
 
+	if len(cmd.FeePayer.String()) > 0 {
+		a, err := cmd.FeePayer.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid fee payer format, %q", cmd.FeePayer.String())
+		}
+		cmd.feePayer = a
+	}
+
 	return nil
 }
 
@@ -91,6 +102,36 @@ func (cmd *WithdrawCommand) createOperation() (base.Operation, error) { // nolin
 	}
 	items = append(items, item)
 
+	if cmd.feePayer != nil {
+		fact := currency.NewSponsoredWithdrawsFact([]byte(cmd.Token), cmd.sender, items, cmd.feePayer, cmd.FeeCurrency.CID)
+
+		op, err := currency.NewSponsoredWithdraws(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-withdraws operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sponsored-withdraws operation")
+		}
+
+		return op, nil
+	}
+
+	if len(cmd.FeeCurrency.CID) > 0 {
+		fact := currency.NewFeeExchangeWithdrawsFact([]byte(cmd.Token), cmd.sender, items, cmd.FeeCurrency.CID)
+
+		op, err := currency.NewFeeExchangeWithdraws(fact)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-withdraws operation")
+		}
+		err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create fee-exchange-withdraws operation")
+		}
+
+		return op, nil
+	}
+
 	fact := currency.NewWithdrawsFact([]byte(cmd.Token), cmd.sender, items)
 
 	op, err := currency.NewWithdraws(fact)
@@ -0,0 +1,258 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	RecoveryApprovalHint = hint.MustNewHint("mitum-currency-recovery-approval-v0.0.1")
+	AccountRecoveryHint  = hint.MustNewHint("mitum-currency-account-recovery-v0.0.1")
+)
+
+type AccountRecoveryStatus string
+
+const (
+	AccountRecoveryPending   = AccountRecoveryStatus("pending")
+	AccountRecoveryRecovered = AccountRecoveryStatus("recovered")
+	AccountRecoveryCancelled = AccountRecoveryStatus("cancelled")
+)
+
+func (s AccountRecoveryStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s AccountRecoveryStatus) IsValid([]byte) error {
+	switch s {
+	case AccountRecoveryPending, AccountRecoveryRecovered, AccountRecoveryCancelled:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown account recovery status, %q", s)
+	}
+}
+
+// RecoveryApproval is the new keys of account which the guardian approves.
+type RecoveryApproval struct {
+	hint.BaseHinter
+	guardian base.Address
+	keys     mitumcurrency.AccountKeys
+}
+
+func NewRecoveryApproval(guardian base.Address, keys mitumcurrency.AccountKeys) RecoveryApproval {
+	return RecoveryApproval{
+		BaseHinter: hint.NewBaseHinter(RecoveryApprovalHint),
+		guardian:   guardian,
+		keys:       keys,
+	}
+}
+
+func (ra RecoveryApproval) Bytes() []byte {
+	return util.ConcatBytesSlice(ra.guardian.Bytes(), ra.keys.Bytes())
+}
+
+func (ra RecoveryApproval) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, ra.BaseHinter, ra.guardian, ra.keys); err != nil {
+		return util.ErrInvalid.Errorf("invalid recovery approval: %w", err)
+	}
+
+	return nil
+}
+
+func (ra RecoveryApproval) Guardian() base.Address {
+	return ra.guardian
+}
+
+func (ra RecoveryApproval) Keys() mitumcurrency.AccountKeys {
+	return ra.keys
+}
+
+// AccountRecovery is the recovery of account keys by the guardians of
+// account. Each guardian approves the new keys by RecoverAccount; once the
+// approvals for the same keys reach the threshold of guardians, the keys are
+// approved and they replace the account keys after the delay of guardians.
+// Until then, the account can cancel the recovery by CancelAccountRecovery.
+type AccountRecovery struct {
+	hint.BaseHinter
+	account   base.Address
+	approvals []RecoveryApproval
+	keys      mitumcurrency.AccountKeys
+	ready     base.Height
+	status    AccountRecoveryStatus
+}
+
+func NewAccountRecovery(account base.Address) AccountRecovery {
+	return AccountRecovery{
+		BaseHinter: hint.NewBaseHinter(AccountRecoveryHint),
+		account:    account,
+		status:     AccountRecoveryPending,
+	}
+}
+
+func (ar AccountRecovery) Bytes() []byte {
+	bs := make([][]byte, len(ar.approvals))
+	for i := range ar.approvals {
+		bs[i] = ar.approvals[i].Bytes()
+	}
+
+	var kb []byte
+	if ar.keys != nil {
+		kb = ar.keys.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		ar.account.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		kb,
+		ar.ready.Bytes(),
+		ar.status.Bytes(),
+	)
+}
+
+func (ar AccountRecovery) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		ar.BaseHinter,
+		ar.account,
+		ar.status,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid account recovery: %w", err)
+	}
+
+	if n := len(ar.approvals); n > MaxRecoveryGuardians {
+		return util.ErrInvalid.Errorf("approvals, %d over max, %d", n, MaxRecoveryGuardians)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range ar.approvals {
+		ra := ar.approvals[i]
+		if err := ra.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid account recovery: %w", err)
+		}
+
+		if _, found := founds[ra.guardian.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate approval found, %q", ra.guardian)
+		}
+		founds[ra.guardian.String()] = struct{}{}
+	}
+
+	if ar.keys != nil {
+		if err := ar.keys.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid account recovery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ar AccountRecovery) Account() base.Address {
+	return ar.account
+}
+
+func (ar AccountRecovery) Approvals() []RecoveryApproval {
+	return ar.approvals
+}
+
+// Keys returns the approved keys; it is nil until the approvals reach the
+// threshold.
+func (ar AccountRecovery) Keys() mitumcurrency.AccountKeys {
+	return ar.keys
+}
+
+// Ready returns the height from which the approved keys can replace the
+// account keys.
+func (ar AccountRecovery) Ready() base.Height {
+	return ar.ready
+}
+
+func (ar AccountRecovery) Status() AccountRecoveryStatus {
+	return ar.status
+}
+
+func (ar AccountRecovery) IsPending() bool {
+	return ar.status == AccountRecoveryPending
+}
+
+func (ar AccountRecovery) IsApproved() bool {
+	return ar.keys != nil
+}
+
+func (ar AccountRecovery) IsReady(height base.Height) bool {
+	return ar.IsApproved() && height >= ar.ready
+}
+
+// Approve replaces the approval of guardian with the new keys; when the
+// approvals for the keys reach the threshold, the keys are approved and they
+// are ready after the delay from the height.
+func (ar AccountRecovery) Approve(
+	guardian base.Address,
+	keys mitumcurrency.AccountKeys,
+	threshold uint,
+	delay base.Height,
+	height base.Height,
+) (AccountRecovery, error) {
+	if ar.IsApproved() {
+		return ar, errors.Errorf("recovery already approved")
+	}
+
+	approvals := make([]RecoveryApproval, 0, len(ar.approvals)+1)
+
+	var count uint
+
+	for i := range ar.approvals {
+		ra := ar.approvals[i]
+
+		if ra.guardian.Equal(guardian) {
+			if ra.keys.Equal(keys) {
+				return ar, errors.Errorf("already approved by guardian, %q", guardian)
+			}
+
+			continue
+		}
+
+		if ra.keys.Equal(keys) {
+			count++
+		}
+
+		approvals = append(approvals, ra)
+	}
+
+	ar.approvals = append(approvals, NewRecoveryApproval(guardian, keys))
+
+	if count+1 >= threshold {
+		ar.keys = keys
+		ar.ready = height + delay
+	}
+
+	return ar, nil
+}
+
+func (ar AccountRecovery) Recovered() AccountRecovery {
+	ar.status = AccountRecoveryRecovered
+
+	return ar
+}
+
+func (ar AccountRecovery) Cancelled() AccountRecovery {
+	ar.status = AccountRecoveryCancelled
+
+	return ar
+}
+
+// pendingAccountRecovery returns the pending recovery of account.
+func pendingAccountRecovery(a base.Address, getStateFunc base.GetStateFunc) (AccountRecovery, bool, error) {
+	switch st, found, err := getStateFunc(StateKeyAccountRecovery(a)); {
+	case err != nil:
+		return AccountRecovery{}, false, err
+	case !found:
+		return AccountRecovery{}, false, nil
+	default:
+		ar, err := StateAccountRecoveryValue(st)
+		if err != nil {
+			return AccountRecovery{}, false, err
+		}
+
+		return ar, ar.IsPending(), nil
+	}
+}
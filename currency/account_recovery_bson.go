@@ -0,0 +1,82 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (ra RecoveryApproval) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    ra.Hint().String(),
+			"guardian": ra.guardian,
+			"keys":     ra.keys,
+		},
+	)
+}
+
+type RecoveryApprovalBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Guardian string   `bson:"guardian"`
+	Keys     bson.Raw `bson:"keys"`
+}
+
+func (ra *RecoveryApproval) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of RecoveryApproval")
+
+	var ura RecoveryApprovalBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &ura); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(ura.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return ra.unpack(enc, ht, ura.Guardian, ura.Keys)
+}
+
+func (ar AccountRecovery) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":     ar.Hint().String(),
+		"account":   ar.account,
+		"approvals": ar.approvals,
+		"ready":     ar.ready,
+		"status":    ar.status,
+	}
+
+	if ar.keys != nil {
+		m["keys"] = ar.keys
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type AccountRecoveryBSONUnmarshaler struct {
+	Hint      string      `bson:"_hint"`
+	Account   string      `bson:"account"`
+	Approvals bson.Raw    `bson:"approvals"`
+	Keys      bson.Raw    `bson:"keys,omitempty"`
+	Ready     base.Height `bson:"ready"`
+	Status    string      `bson:"status"`
+}
+
+func (ar *AccountRecovery) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AccountRecovery")
+
+	var uar AccountRecoveryBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uar); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uar.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return ar.unpack(enc, ht, uar.Account, uar.Approvals, uar.Keys, uar.Ready, uar.Status)
+}
@@ -0,0 +1,85 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ra *RecoveryApproval) unpack(enc encoder.Encoder, ht hint.Hint, gd string, bks []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal RecoveryApproval")
+
+	ra.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(gd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		ra.guardian = a
+	}
+
+	if hinter, err := enc.Decode(bks); err != nil {
+		return e(err, "")
+	} else if k, ok := hinter.(mitumcurrency.AccountKeys); !ok {
+		return e(util.ErrWrongType.Errorf("expected AccountKeys, not %T", hinter), "")
+	} else {
+		ra.keys = k
+	}
+
+	return nil
+}
+
+func (ar *AccountRecovery) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	bap []byte,
+	bks []byte,
+	ready base.Height,
+	status string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal AccountRecovery")
+
+	ar.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		ar.account = a
+	}
+
+	hap, err := enc.DecodeSlice(bap)
+	if err != nil {
+		return e(err, "failed to decode approvals")
+	}
+
+	approvals := make([]RecoveryApproval, len(hap))
+	for i := range hap {
+		j, ok := hap[i].(RecoveryApproval)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected RecoveryApproval, not %T", hap[i]), "")
+		}
+
+		approvals[i] = j
+	}
+	ar.approvals = approvals
+
+	ar.keys = nil
+	if len(bks) > 0 {
+		if hinter, err := enc.Decode(bks); err != nil {
+			return e(err, "")
+		} else if k, ok := hinter.(mitumcurrency.AccountKeys); !ok {
+			return e(util.ErrWrongType.Errorf("expected AccountKeys, not %T", hinter), "")
+		} else {
+			ar.keys = k
+		}
+	}
+
+	ar.ready = ready
+	ar.status = AccountRecoveryStatus(status)
+
+	return nil
+}
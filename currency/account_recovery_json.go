@@ -0,0 +1,82 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RecoveryApprovalJSONMarshaler struct {
+	hint.BaseHinter
+	Guardian base.Address              `json:"guardian"`
+	Keys     mitumcurrency.AccountKeys `json:"keys"`
+}
+
+func (ra RecoveryApproval) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoveryApprovalJSONMarshaler{
+		BaseHinter: ra.BaseHinter,
+		Guardian:   ra.guardian,
+		Keys:       ra.keys,
+	})
+}
+
+type RecoveryApprovalJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Guardian string          `json:"guardian"`
+	Keys     json.RawMessage `json:"keys"`
+}
+
+func (ra *RecoveryApproval) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RecoveryApproval")
+
+	var ura RecoveryApprovalJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ura); err != nil {
+		return e(err, "")
+	}
+
+	return ra.unpack(enc, ura.Hint, ura.Guardian, ura.Keys)
+}
+
+type AccountRecoveryJSONMarshaler struct {
+	hint.BaseHinter
+	Account   base.Address              `json:"account"`
+	Approvals []RecoveryApproval        `json:"approvals"`
+	Keys      mitumcurrency.AccountKeys `json:"keys,omitempty"`
+	Ready     base.Height               `json:"ready"`
+	Status    AccountRecoveryStatus     `json:"status"`
+}
+
+func (ar AccountRecovery) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AccountRecoveryJSONMarshaler{
+		BaseHinter: ar.BaseHinter,
+		Account:    ar.account,
+		Approvals:  ar.approvals,
+		Keys:       ar.keys,
+		Ready:      ar.ready,
+		Status:     ar.status,
+	})
+}
+
+type AccountRecoveryJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Account   string          `json:"account"`
+	Approvals json.RawMessage `json:"approvals"`
+	Keys      json.RawMessage `json:"keys"`
+	Ready     base.Height     `json:"ready"`
+	Status    string          `json:"status"`
+}
+
+func (ar *AccountRecovery) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AccountRecovery")
+
+	var uar AccountRecoveryJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uar); err != nil {
+		return e(err, "")
+	}
+
+	return ar.unpack(enc, uar.Hint, uar.Account, uar.Approvals, uar.Keys, uar.Ready, uar.Status)
+}
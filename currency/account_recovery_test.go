@@ -0,0 +1,389 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testAccountRecovery struct {
+	testProcessorSuite
+	cid       mitumcurrency.CurrencyID
+	states    *testStates
+	account   base.Address
+	priv      base.Privatekey
+	guardians []base.Address
+	gprivs    []base.Privatekey
+}
+
+func (t *testAccountRecovery) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	account, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(account, t.cid, 100)
+
+	t.account, t.priv = account, privs[0]
+
+	t.guardians = make([]base.Address, 3)
+	t.gprivs = make([]base.Privatekey, 3)
+
+	for i := range t.guardians {
+		guardian, gprivs, err := t.states.newAccount([]uint{100}, 100)
+		t.NoError(err)
+		t.states.setBalance(guardian, t.cid, 100)
+
+		t.guardians[i], t.gprivs[i] = guardian, gprivs[0]
+	}
+
+	op, err := NewSetRecoveryGuardians(NewSetRecoveryGuardiansFact(
+		util.UUID().Bytes(), t.account, t.guardians, 2, 10, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	t.Nil(t.process(NewSetRecoveryGuardiansProcessor(), op, t.states))
+}
+
+func (t *testAccountRecovery) newKeys() mitumcurrency.AccountKeys {
+	k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testAccountRecovery) keys() mitumcurrency.AccountKeys {
+	st, found, err := t.states.getStateFunc(mitumcurrency.StateKeyAccount(t.account))
+	t.NoError(err)
+	t.True(found)
+
+	keys, err := mitumcurrency.StateKeysValue(st)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testAccountRecovery) recover(i int, keys mitumcurrency.AccountKeys) RecoverAccount {
+	op, err := NewRecoverAccount(NewRecoverAccountFact(util.UUID().Bytes(), t.guardians[i], t.account, keys, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.gprivs[i], t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testAccountRecovery) cancel() CancelAccountRecovery {
+	op, err := NewCancelAccountRecovery(NewCancelAccountRecoveryFact(util.UUID().Bytes(), t.account, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testAccountRecovery) recovery() AccountRecovery {
+	st, found, err := t.states.getStateFunc(StateKeyAccountRecovery(t.account))
+	t.NoError(err)
+	t.True(found)
+
+	ar, err := StateAccountRecoveryValue(st)
+	t.NoError(err)
+
+	return ar
+}
+
+func (t *testAccountRecovery) TestRecover() {
+	keys := t.newKeys()
+	old := t.keys()
+
+	t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(0, keys), t.states))
+
+	ar := t.recovery()
+	t.True(ar.IsPending())
+	t.False(ar.IsApproved())
+	t.Equal(1, len(ar.Approvals()))
+
+	t.Run("approve again", func() {
+		reason := t.process(NewRecoverAccountProcessor(), t.recover(0, keys), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "already approved by guardian")
+	})
+
+	t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(1, keys), t.states))
+
+	ar = t.recovery()
+	t.True(ar.IsApproved())
+	t.True(ar.Keys().Equal(keys))
+	t.Equal(t.states.height+10, ar.Ready())
+	t.True(t.keys().Equal(old))
+
+	t.Run("other keys", func() {
+		reason := t.process(NewRecoverAccountProcessor(), t.recover(2, t.newKeys()), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "other keys already approved for account")
+	})
+
+	t.Run("not yet ready", func() {
+		reason := t.process(NewRecoverAccountProcessor(), t.recover(2, keys), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "account recovery not yet ready")
+	})
+
+	t.states.height = ar.Ready()
+
+	t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(2, keys), t.states))
+
+	t.Equal(AccountRecoveryRecovered, t.recovery().Status())
+	t.True(t.keys().Equal(keys))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.account, t.cid))
+}
+
+func (t *testAccountRecovery) TestCancel() {
+	old := t.keys()
+
+	t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(0, t.newKeys()), t.states))
+	t.Nil(t.process(NewCancelAccountRecoveryProcessor(), t.cancel(), t.states))
+
+	t.Equal(AccountRecoveryCancelled, t.recovery().Status())
+	t.True(t.keys().Equal(old))
+
+	t.Run("cancel again", func() {
+		reason := t.process(NewCancelAccountRecoveryProcessor(), t.cancel(), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "pending account recovery not found")
+	})
+
+	t.Run("new recovery after cancel", func() {
+		t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(1, t.newKeys()), t.states))
+
+		ar := t.recovery()
+		t.True(ar.IsPending())
+		t.Equal(1, len(ar.Approvals()))
+		t.True(t.guardians[1].Equal(ar.Approvals()[0].Guardian()))
+	})
+}
+
+func (t *testAccountRecovery) TestNotGuardian() {
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	op, err := NewRecoverAccount(NewRecoverAccountFact(util.UUID().Bytes(), sender, t.account, t.newKeys(), t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(privs[0], t.networkID))
+
+	reason := t.process(NewRecoverAccountProcessor(), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "sender is not guardian")
+}
+
+func (t *testAccountRecovery) TestSameKeys() {
+	reason := t.preProcess(NewRecoverAccountProcessor(), t.recover(0, t.keys()), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "same keys as existing")
+}
+
+func (t *testAccountRecovery) TestInvalidGuardians() {
+	t.Run("over threshold", func() {
+		err := NewSetRecoveryGuardiansFact(util.UUID().Bytes(), t.account, t.guardians, 4, 10, t.cid).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "invalid threshold")
+	})
+
+	t.Run("account as guardian", func() {
+		err := NewSetRecoveryGuardiansFact(
+			util.UUID().Bytes(), t.account, []base.Address{t.account}, 1, 10, t.cid).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "guardian is same with account")
+	})
+
+	t.Run("zero delay", func() {
+		err := NewSetRecoveryGuardiansFact(util.UUID().Bytes(), t.account, t.guardians, 2, 0, t.cid).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "delay should be over zero")
+	})
+}
+
+func TestAccountRecovery(t *testing.T) {
+	suite.Run(t, new(testAccountRecovery))
+}
+
+func TestSetRecoveryGuardiansFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: SetRecoveryGuardiansFactHint, Instance: SetRecoveryGuardiansFact{}}))
+
+		fact := NewSetRecoveryGuardiansFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]base.Address{
+				mitumcurrency.NewAddress(util.UUID().String()),
+				mitumcurrency.NewAddress(util.UUID().String()),
+			},
+			2,
+			base.Height(10),
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(SetRecoveryGuardiansFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(SetRecoveryGuardiansFact)
+		t.True(ok)
+		bf, ok := b.(SetRecoveryGuardiansFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Threshold(), bf.Threshold())
+		t.Equal(af.Delay(), bf.Delay())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestRecoverAccountFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: base.MPublickeyHint, Instance: base.MPublickey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeyHint, Instance: mitumcurrency.BaseAccountKey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeysHint, Instance: mitumcurrency.BaseAccountKeys{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: RecoverAccountFactHint, Instance: RecoverAccountFact{}}))
+
+		k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+		t.NoError(err)
+
+		keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+		t.NoError(err)
+
+		fact := NewRecoverAccountFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			keys,
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(RecoverAccountFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(RecoverAccountFact)
+		t.True(ok)
+		bf, ok := b.(RecoverAccountFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.True(af.Keys().Equal(bf.Keys()))
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestAccountRecoveryStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: base.MPublickeyHint, Instance: base.MPublickey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeyHint, Instance: mitumcurrency.BaseAccountKey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeysHint, Instance: mitumcurrency.BaseAccountKeys{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: RecoveryApprovalHint, Instance: RecoveryApproval{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: AccountRecoveryHint, Instance: AccountRecovery{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: AccountRecoveryStateValueHint, Instance: AccountRecoveryStateValue{},
+		}))
+
+		k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+		t.NoError(err)
+
+		keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+		t.NoError(err)
+
+		ar := NewAccountRecovery(mitumcurrency.NewAddress(util.UUID().String()))
+
+		ar, err = ar.Approve(mitumcurrency.NewAddress(util.UUID().String()), keys, 2, 10, 33)
+		t.NoError(err)
+		ar, err = ar.Approve(mitumcurrency.NewAddress(util.UUID().String()), keys, 2, 10, 33)
+		t.NoError(err)
+		t.True(ar.IsApproved())
+
+		sv := NewAccountRecoveryStateValue(ar)
+		t.NoError(sv.IsValid(nil))
+
+		b, err := enc.Marshal(sv)
+		t.NoError(err)
+
+		return sv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(AccountRecoveryStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(AccountRecoveryStateValue)
+		t.True(ok)
+		bv, ok := b.(AccountRecoveryStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.Equal(av.HashBytes(), bv.HashBytes())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,118 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ApproveFactHint = hint.MustNewHint("mitum-currency-approve-operation-fact-v0.0.1")
+	ApproveHint     = hint.MustNewHint("mitum-currency-approve-operation-v0.0.1")
+)
+
+type ApproveFact struct {
+	base.BaseFact
+	sender  base.Address
+	spender base.Address
+	amount  mitumcurrency.Amount
+}
+
+func NewApproveFact(token []byte, sender, spender base.Address, amount mitumcurrency.Amount) ApproveFact {
+	bf := base.NewBaseFact(ApproveFactHint, token)
+	fact := ApproveFact{
+		BaseFact: bf,
+		sender:   sender,
+		spender:  spender,
+		amount:   amount,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.spender.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact ApproveFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.spender, fact.amount); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverNil() {
+		return util.ErrInvalid.Errorf("amount under zero")
+	}
+
+	if fact.sender.Equal(fact.spender) {
+		return util.ErrInvalid.Errorf("spender is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Spender() base.Address {
+	return fact.spender
+}
+
+func (fact ApproveFact) Amount() mitumcurrency.Amount {
+	return fact.amount
+}
+
+func (fact ApproveFact) Allowance() AllowanceStateValue {
+	return NewAllowanceStateValue(fact.sender, fact.spender, fact.amount)
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.spender}, nil
+}
+
+// Approve sets the allowance of spender for the currency of amount; the
+// spender can move the allowance out of the balance of sender by TransferFrom.
+// Zero amount revokes the allowance.
+type Approve struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewApprove(fact ApproveFact) (Approve, error) {
+	return Approve{BaseOperation: mitumcurrency.NewBaseOperation(ApproveHint, fact)}, nil
+}
+
+func (op *Approve) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
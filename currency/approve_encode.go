@@ -0,0 +1,34 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ApproveFact) unpack(enc encoder.Encoder, sd, sp string, bam []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal ApproveFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(sp, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.spender = a
+	}
+
+	var am mitumcurrency.Amount
+	if err := encoder.Decode(enc, bam, &am); err != nil {
+		return e(err, "failed to decode amount")
+	}
+	fact.amount = am
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ApproveFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender  base.Address         `json:"sender"`
+	Spender base.Address         `json:"spender"`
+	Amount  mitumcurrency.Amount `json:"amount"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Spender:               fact.spender,
+		Amount:                fact.amount,
+	})
+}
+
+type ApproveFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender  string          `json:"sender"`
+	Spender string          `json:"spender"`
+	Amount  json.RawMessage `json:"amount"`
+}
+
+func (fact *ApproveFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ApproveFact")
+
+	var uf ApproveFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Spender, uf.Amount)
+}
+
+type approveMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op Approve) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(approveMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Approve) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Approve")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
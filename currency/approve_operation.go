@@ -0,0 +1,172 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ApproveOperationFactHint = hint.MustNewHint("mitum-currency-approve-operation-operation-fact-v0.0.1")
+	ApproveOperationHint     = hint.MustNewHint("mitum-currency-approve-operation-operation-v0.0.1")
+)
+
+// ApproveOperationFact approves the pending operation by approvals, the signs
+// over operation, the fact hash of the pending operation.
+type ApproveOperationFact struct {
+	base.BaseFact
+	sender    base.Address
+	pending   util.Hash
+	operation util.Hash
+	approvals []base.Sign
+}
+
+func NewApproveOperationFact(
+	token []byte,
+	sender base.Address,
+	pending util.Hash,
+	operation util.Hash,
+	approvals []base.Sign,
+) ApproveOperationFact {
+	bf := base.NewBaseFact(ApproveOperationFactHint, token)
+	fact := ApproveOperationFact{
+		BaseFact:  bf,
+		sender:    sender,
+		pending:   pending,
+		operation: operation,
+		approvals: approvals,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveOperationFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.approvals))
+	for i := range fact.approvals {
+		bs[i] = fact.approvals[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.pending.Bytes(),
+		fact.operation.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (fact ApproveOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.pending, fact.operation); err != nil {
+		return err
+	}
+
+	if len(fact.approvals) < 1 {
+		return util.ErrInvalid.Errorf("empty approvals")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.approvals {
+		if err := fact.approvals[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		k := fact.approvals[i].Signer().String()
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicate approval found, %q", k)
+		}
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact ApproveOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveOperationFact) Pending() util.Hash {
+	return fact.pending
+}
+
+func (fact ApproveOperationFact) Operation() util.Hash {
+	return fact.operation
+}
+
+func (fact ApproveOperationFact) Approvals() []base.Sign {
+	return fact.approvals
+}
+
+func (fact ApproveOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// ApproveOperation approves the pending operation of multi-key account; the
+// approvals of fact are added to the approvals of pending operation.
+type ApproveOperation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewApproveOperation(fact ApproveOperationFact) (ApproveOperation, error) {
+	return ApproveOperation{BaseOperation: mitumcurrency.NewBaseOperation(ApproveOperationHint, fact)}, nil
+}
+
+// ApprovalNetworkID returns the network id for the approvals of the pending
+// operation; the approval signed by it is only valid for the pending operation.
+func ApprovalNetworkID(networkID []byte, pending util.Hash) base.NetworkID {
+	return util.ConcatBytesSlice(networkID, []byte("approval"), pending.Bytes())
+}
+
+// IsValid verifies the approvals by the fact hash of the pending operation
+// with ApprovalNetworkID; the approval of the other fact or the other pending
+// operation is rejected.
+func (op ApproveOperation) IsValid(networkID []byte) error {
+	if err := op.BaseOperation.IsValid(networkID); err != nil {
+		return err
+	}
+
+	fact, ok := op.Fact().(ApproveOperationFact)
+	if !ok {
+		return util.ErrInvalid.Errorf("expected ApproveOperationFact, not %T", op.Fact())
+	}
+
+	approvalNetworkID := ApprovalNetworkID(networkID, fact.pending)
+
+	for i := range fact.approvals {
+		if err := fact.approvals[i].Verify(approvalNetworkID, fact.operation.Bytes()); err != nil {
+			return util.ErrInvalid.Errorf("failed to verify approval, %q: %w", fact.approvals[i].Signer(), err)
+		}
+	}
+
+	return nil
+}
+
+func (op *ApproveOperation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact ApproveOperationFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     fact.Hint().String(),
+			"sender":    fact.sender,
+			"pending":   fact.pending.String(),
+			"operation": fact.operation.String(),
+			"approvals": fact.approvals,
+			"hash":      fact.BaseFact.Hash().String(),
+			"token":     fact.BaseFact.Token(),
+		},
+	)
+}
+
+type ApproveOperationFactBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Sender    string `bson:"sender"`
+	Pending   string `bson:"pending"`
+	Operation string `bson:"operation"`
+	// Approvals []bson.Raw `bson:"approvals"`
+}
+
+func (fact *ApproveOperationFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ApproveOperationFact")
+
+	var ubf mitumcurrency.BaseFactBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf ApproveOperationFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	// NOTE like the signs of BaseOperation, approvals are not decoded from bson
+	return fact.unpack(
+		enc, uf.Sender,
+		valuehash.NewBytesFromString(uf.Pending),
+		valuehash.NewBytesFromString(uf.Operation),
+	)
+}
+
+func (op ApproveOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *ApproveOperation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ApproveOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
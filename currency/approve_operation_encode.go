@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ApproveOperationFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	pending, operation util.Hash,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal ApproveOperationFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	fact.pending = pending
+	fact.operation = operation
+
+	return nil
+}
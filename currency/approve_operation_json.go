@@ -0,0 +1,84 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ApproveOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address `json:"sender"`
+	Pending   util.Hash    `json:"pending"`
+	Operation util.Hash    `json:"operation"`
+	Approvals []base.Sign  `json:"approvals"`
+}
+
+func (fact ApproveOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Pending:               fact.pending,
+		Operation:             fact.operation,
+		Approvals:             fact.approvals,
+	})
+}
+
+type ApproveOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string                `json:"sender"`
+	Pending   valuehash.HashDecoder `json:"pending"`
+	Operation valuehash.HashDecoder `json:"operation"`
+	Approvals []json.RawMessage     `json:"approvals"`
+}
+
+func (fact *ApproveOperationFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ApproveOperationFact")
+
+	var uf ApproveOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	approvals := make([]base.Sign, len(uf.Approvals))
+	for i := range uf.Approvals {
+		var ub base.BaseSign
+		if err := ub.DecodeJSON(uf.Approvals[i], enc); err != nil {
+			return e(err, "failed to decode approval")
+		}
+
+		approvals[i] = ub
+	}
+	fact.approvals = approvals
+
+	return fact.unpack(enc, uf.Sender, uf.Pending.Hash(), uf.Operation.Hash())
+}
+
+type approveOperationMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ApproveOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(approveOperationMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ApproveOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveOperationProcessor)
+	},
+}
+
+func (ApproveOperation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ApproveOperationProcessor struct {
+	*base.BaseOperationProcessor
+	getNewProcessorByHint GetNewProcessorByHint
+}
+
+func NewApproveOperationProcessor(getNewProcessorByHint GetNewProcessorByHint) GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ApproveOperationProcessor")
+
+		nopp := approveOperationProcessorPool.Get()
+		opp, ok := nopp.(*ApproveOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveOperationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getNewProcessorByHint = getNewProcessorByHint
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ApproveOperation")
+
+	if _, ok := op.Fact().(ApproveOperationFact); !ok {
+		return ctx, nil, e(nil, "expected ApproveOperationFact, not %T", op.Fact())
+	}
+
+	if _, _, err := checkApprovePendingOperation(op, opp.Height(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveOperationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ApproveOperation")
+
+	fact, ok := op.Fact().(ApproveOperationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ApproveOperationFact, not %T", op.Fact())
+	}
+
+	po, keys, rerr := checkApprovePendingOperation(op, opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	po, _ = po.Approve(fact.Approvals())
+	if !po.IsApproved(keys) {
+		return []base.StateMergeValue{
+			NewPendingOperationStateMergeValue(StateKeyPendingOperation(po.ID()), NewPendingOperationStateValue(po)),
+		}, nil, nil
+	}
+
+	sts, rerr, err := executePendingOperation(ctx, po, opp.getNewProcessorByHint, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, nil, e(err, "")
+	}
+
+	return sts, rerr, nil
+}
+
+func (opp *ApproveOperationProcessor) Close() error {
+	opp.getNewProcessorByHint = nil
+	approveOperationProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,403 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testPendingOperation struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	sender   base.Address
+	privs    []base.Privatekey
+	receiver base.Address
+}
+
+func (t *testPendingOperation) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := t.states.newAccount([]uint{50, 50}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	t.sender, t.privs, t.receiver = sender, privs, receiver
+}
+
+func (t *testPendingOperation) getNewProcessorByHint(ht hint.Hint) (GetNewProcessor, bool) {
+	if !ht.Equal(mitumcurrency.TransfersHint) {
+		return nil, false
+	}
+
+	return NewTransfersProcessor(), true
+}
+
+// newTransfers returns the transfers of sender signed by the first key, which
+// does not reach the threshold of sender.
+func (t *testPendingOperation) newTransfers(big int64, networkID base.NetworkID) mitumcurrency.Transfers {
+	fact := mitumcurrency.NewTransfersFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.TransfersItem{
+			mitumcurrency.NewTransfersItemMultiAmounts(
+				t.receiver,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+			),
+		},
+	)
+
+	op, err := mitumcurrency.NewTransfers(fact)
+	t.NoError(err)
+	t.NoError(op.HashSign(t.privs[0], networkID))
+
+	return op
+}
+
+// propose returns the proposal of the transfers signed with the network id of
+// proposal.
+func (t *testPendingOperation) propose(big int64, lifespan base.Height) (mitumcurrency.Transfers, ProposeOperation) {
+	token := util.UUID().Bytes()
+	inner := t.newTransfers(big, PendingNetworkID(t.networkID, token, lifespan))
+
+	op, err := NewProposeOperation(NewProposeOperationFact(token, t.sender, inner, lifespan))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.privs[0], t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return inner, op
+}
+
+func (t *testPendingOperation) approve(pending, operation util.Hash, approval base.Sign) ApproveOperation {
+	op, err := NewApproveOperation(
+		NewApproveOperationFact(util.UUID().Bytes(), t.sender, pending, operation, []base.Sign{approval}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.privs[1], t.networkID))
+
+	return op
+}
+
+func (t *testPendingOperation) approval(priv base.Privatekey, pending util.Hash, fact base.Fact) base.Sign {
+	sign, err := base.NewBaseSignFromFact(priv, ApprovalNetworkID(t.networkID, pending), fact)
+	t.NoError(err)
+
+	return sign
+}
+
+func (t *testPendingOperation) pendingOperation(id util.Hash) PendingOperation {
+	st, found, err := t.states.getStateFunc(StateKeyPendingOperation(id))
+	t.NoError(err)
+	t.True(found)
+
+	po, err := StatePendingOperationValue(st)
+	t.NoError(err)
+
+	return po
+}
+
+func (t *testPendingOperation) TestProposeAndApprove() {
+	inner, op := t.propose(10, 10)
+
+	t.Nil(t.process(NewProposeOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	po := t.pendingOperation(op.Fact().Hash())
+	t.True(po.IsPending())
+	t.Equal(t.states.height+10, po.Expiry())
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+
+	aop := t.approve(op.Fact().Hash(), inner.Fact().Hash(), t.approval(t.privs[1], op.Fact().Hash(), inner.Fact()))
+	t.NoError(aop.IsValid(t.networkID))
+
+	t.Nil(t.process(NewApproveOperationProcessor(t.getNewProcessorByHint), aop, t.states))
+
+	po = t.pendingOperation(op.Fact().Hash())
+	t.Equal(PendingOperationExecuted, po.Status())
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testPendingOperation) TestForgedApproval() {
+	inner := t.newTransfers(10, t.networkID)
+	pending := valuehash.RandomSHA256()
+
+	// NOTE the approval is signed over the other fact, but claims the fact hash
+	// of inner operation.
+	forged := t.approval(t.privs[1], pending, t.newTransfers(10, t.networkID).Fact())
+	aop := t.approve(pending, inner.Fact().Hash(), forged)
+
+	err := aop.IsValid(t.networkID)
+	t.Error(err)
+	t.ErrorContains(err, "failed to verify approval")
+}
+
+func (t *testPendingOperation) TestMismatchedApproval() {
+	_, op := t.propose(10, 10)
+
+	t.Nil(t.process(NewProposeOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	other := t.newTransfers(100, t.networkID)
+	aop := t.approve(op.Fact().Hash(), other.Fact().Hash(), t.approval(t.privs[1], op.Fact().Hash(), other.Fact()))
+	t.NoError(aop.IsValid(t.networkID))
+
+	reason := t.preProcess(NewApproveOperationProcessor(t.getNewProcessorByHint), aop, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "approvals are not of pending operation")
+
+	t.True(t.pendingOperation(op.Fact().Hash()).IsPending())
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+}
+
+func (t *testPendingOperation) TestUnknownApprover() {
+	inner, op := t.propose(10, 10)
+
+	t.Nil(t.process(NewProposeOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	aop := t.approve(
+		op.Fact().Hash(), inner.Fact().Hash(), t.approval(base.NewMPrivatekey(), op.Fact().Hash(), inner.Fact()))
+	t.NoError(aop.IsValid(t.networkID))
+
+	reason := t.preProcess(NewApproveOperationProcessor(t.getNewProcessorByHint), aop, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "signer is not the key of account")
+}
+
+func (t *testPendingOperation) TestExpired() {
+	inner, op := t.propose(10, 1)
+
+	t.Nil(t.process(NewProposeOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	t.states.height += 2
+
+	aop := t.approve(op.Fact().Hash(), inner.Fact().Hash(), t.approval(t.privs[1], op.Fact().Hash(), inner.Fact()))
+
+	reason := t.preProcess(NewApproveOperationProcessor(t.getNewProcessorByHint), aop, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "expired")
+}
+
+func (t *testPendingOperation) TestLifespan() {
+	inner := t.newTransfers(10, t.networkID)
+
+	t.Run("under 1", func() {
+		fact := NewProposeOperationFact(util.UUID().Bytes(), t.sender, inner, 0)
+
+		err := fact.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "lifespan under 1")
+	})
+
+	t.Run("over max", func() {
+		fact := NewProposeOperationFact(util.UUID().Bytes(), t.sender, inner, MaxPendingOperationLifespan+1)
+
+		err := fact.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "over max")
+	})
+}
+
+func (t *testPendingOperation) TestInnerOperationAlone() {
+	inner, op := t.propose(10, 10)
+
+	t.Nil(t.process(NewProposeOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	// NOTE the proposed operation is not valid by itself
+	t.Error(inner.IsValid(t.networkID))
+
+	t.Run("signed with network id", func() {
+		inner := t.newTransfers(10, t.networkID)
+
+		fact := NewProposeOperationFact(util.UUID().Bytes(), t.sender, inner, 10)
+
+		err := fact.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "invalid operation")
+	})
+
+	t.Run("proposed again", func() {
+		fact := NewProposeOperationFact(util.UUID().Bytes(), t.sender, inner, 10)
+
+		err := fact.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "invalid operation")
+	})
+
+	t.Run("approval signed with network id", func() {
+		approval, err := base.NewBaseSignFromFact(t.privs[1], t.networkID, inner.Fact())
+		t.NoError(err)
+
+		aop := t.approve(op.Fact().Hash(), inner.Fact().Hash(), approval)
+
+		err = aop.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "failed to verify approval")
+	})
+
+	t.Run("approval of other pending operation", func() {
+		approval := t.approval(t.privs[1], valuehash.RandomSHA256(), inner.Fact())
+		aop := t.approve(op.Fact().Hash(), inner.Fact().Hash(), approval)
+
+		err := aop.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "failed to verify approval")
+	})
+
+	t.True(t.pendingOperation(op.Fact().Hash()).IsPending())
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+}
+
+func TestPendingOperation(t *testing.T) {
+	suite.Run(t, new(testPendingOperation))
+}
+
+func newTestPendingOperationEncoder(t *encoder.BaseTestEncode) *jsonenc.Encoder {
+	enc := jsonenc.NewEncoder()
+
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: base.MPublickeyHint, Instance: base.MPublickey{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{
+		Hint: mitumcurrency.TransfersItemMultiAmountsHint, Instance: mitumcurrency.TransfersItemMultiAmounts{},
+	}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.TransfersFactHint, Instance: mitumcurrency.TransfersFact{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.TransfersHint, Instance: mitumcurrency.Transfers{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: ProposeOperationFactHint, Instance: ProposeOperationFact{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: ApproveOperationFactHint, Instance: ApproveOperationFact{}}))
+
+	return enc
+}
+
+func newTestTransfers(t *encoder.BaseTestEncode, networkID base.NetworkID) (mitumcurrency.Transfers, base.Privatekey) {
+	priv := base.NewMPrivatekey()
+
+	fact := mitumcurrency.NewTransfersFact(
+		util.UUID().Bytes(),
+		mitumcurrency.NewAddress(util.UUID().String()),
+		[]mitumcurrency.TransfersItem{
+			mitumcurrency.NewTransfersItemMultiAmounts(
+				mitumcurrency.NewAddress(util.UUID().String()),
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+			),
+		},
+	)
+
+	op, err := mitumcurrency.NewTransfers(fact)
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, networkID))
+
+	return op, priv
+}
+
+func TestProposeOperationFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestPendingOperationEncoder(t)
+	networkID := base.NetworkID(util.UUID().Bytes())
+
+	t.Encode = func() (interface{}, []byte) {
+		token := util.UUID().Bytes()
+		inner, _ := newTestTransfers(t, PendingNetworkID(networkID, token, base.Height(10)))
+
+		fact := NewProposeOperationFact(
+			token,
+			mitumcurrency.NewAddress(util.UUID().String()),
+			inner,
+			base.Height(10),
+		)
+		t.NoError(fact.IsValid(networkID))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ProposeOperationFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ProposeOperationFact)
+		t.True(ok)
+		bf, ok := b.(ProposeOperationFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(networkID))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Lifespan(), bf.Lifespan())
+		t.True(af.Operation().Hash().Equal(bf.Operation().Hash()))
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestApproveOperationFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestPendingOperationEncoder(t)
+
+	t.Encode = func() (interface{}, []byte) {
+		networkID := base.NetworkID(util.UUID().Bytes())
+		inner, _ := newTestTransfers(t, networkID)
+		pending := valuehash.RandomSHA256()
+
+		approval, err := base.NewBaseSignFromFact(base.NewMPrivatekey(), ApprovalNetworkID(networkID, pending), inner.Fact())
+		t.NoError(err)
+
+		fact := NewApproveOperationFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			pending,
+			inner.Fact().Hash(),
+			[]base.Sign{approval},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ApproveOperationFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ApproveOperationFact)
+		t.True(ok)
+		bf, ok := b.(ApproveOperationFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.True(af.Operation().Equal(bf.Operation()))
+		t.Equal(len(af.Approvals()), len(bf.Approvals()))
+	}
+
+	suite.Run(tt, t)
+}
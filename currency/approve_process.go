@@ -0,0 +1,141 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveProcessor)
+	},
+}
+
+func (Approve) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ApproveProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ApproveProcessor")
+
+		nopp := approveProcessorPool.Get()
+		opp, ok := nopp.(*ApproveProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Approve")
+
+	fact, ok := op.Fact().(ApproveFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ApproveFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot approve, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.spender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("spender not found, %q: %w", fact.spender, err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.amount.Currency(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.amount.Currency(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Approve")
+
+	fact, ok := op.Fact().(ApproveFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ApproveFact, not %T", op.Fact())
+	}
+
+	cid := fact.amount.Currency()
+
+	policy, err := existsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", cid, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{cid: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[cid].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[cid].Value()), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewAllowanceStateMergeValue(StateKeyAllowance(fact.sender, fact.spender, cid), fact.Allowance()),
+		NewBalanceStateMergeValue(sb[cid].Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))),
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), cid, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ApproveProcessor) Close() error {
+	approveProcessorPool.Put(opp)
+
+	return nil
+}
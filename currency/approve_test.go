@@ -0,0 +1,225 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testApprove struct {
+	testProcessorSuite
+	cid         mitumcurrency.CurrencyID
+	states      *testStates
+	owner       base.Address
+	opriv       base.Privatekey
+	spender     base.Address
+	spriv       base.Privatekey
+	receiver    base.Address
+	feeReceiver base.Address
+}
+
+func (t *testApprove) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	owner, oprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(owner, t.cid, 100)
+
+	spender, sprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(spender, t.cid, 10)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(1), mitumcurrency.ZeroBig)))
+
+	t.owner, t.opriv, t.spender, t.spriv = owner, oprivs[0], spender, sprivs[0]
+	t.receiver, t.feeReceiver = receiver, feeReceiver
+}
+
+func (t *testApprove) approve(big int64) Approve {
+	op, err := NewApprove(NewApproveFact(
+		util.UUID().Bytes(), t.owner, t.spender, mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.opriv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testApprove) transferFrom(sender base.Address, priv base.Privatekey, big int64) TransferFrom {
+	op, err := NewTransferFrom(NewTransferFromFact(
+		util.UUID().Bytes(), sender, t.owner, t.receiver,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testApprove) allowance() mitumcurrency.Big {
+	st, found, err := t.states.getStateFunc(StateKeyAllowance(t.owner, t.spender, t.cid))
+	t.NoError(err)
+	t.True(found)
+
+	av, err := StateAllowanceValue(st)
+	t.NoError(err)
+
+	return av.Amount().Big()
+}
+
+func (t *testApprove) TestTransferFrom() {
+	t.Nil(t.process(NewApproveProcessor(), t.approve(50), t.states))
+
+	t.Equal(mitumcurrency.NewBig(50), t.allowance())
+	t.Equal(mitumcurrency.NewBig(99), t.states.balance(t.owner, t.cid))
+	t.Equal(mitumcurrency.NewBig(1), t.states.balance(t.feeReceiver, t.cid))
+
+	t.Nil(t.process(NewTransferFromProcessor(), t.transferFrom(t.spender, t.spriv, 30), t.states))
+
+	// NOTE the amount is paid by owner and the fee is paid by spender
+	t.Equal(mitumcurrency.NewBig(20), t.allowance())
+	t.Equal(mitumcurrency.NewBig(69), t.states.balance(t.owner, t.cid))
+	t.Equal(mitumcurrency.NewBig(9), t.states.balance(t.spender, t.cid))
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.receiver, t.cid))
+	t.Equal(mitumcurrency.NewBig(2), t.states.balance(t.feeReceiver, t.cid))
+
+	t.Run("over allowance", func() {
+		reason := t.preProcess(NewTransferFromProcessor(), t.transferFrom(t.spender, t.spriv, 21), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "not enough allowance of spender")
+	})
+
+	t.Nil(t.process(NewTransferFromProcessor(), t.transferFrom(t.spender, t.spriv, 20), t.states))
+	t.True(t.allowance().IsZero())
+	t.Equal(mitumcurrency.NewBig(50), t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testApprove) TestNotApproved() {
+	other, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(other, t.cid, 10)
+
+	t.Nil(t.process(NewApproveProcessor(), t.approve(50), t.states))
+
+	reason := t.preProcess(NewTransferFromProcessor(), t.transferFrom(other, privs[0], 10), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "invalid allowance")
+
+	t.Equal(mitumcurrency.NewBig(99), t.states.balance(t.owner, t.cid))
+}
+
+func TestApprove(t *testing.T) {
+	suite.Run(t, new(testApprove))
+}
+
+func TestApproveFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: ApproveFactHint, Instance: ApproveFact{}}))
+
+		fact := NewApproveFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ApproveFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ApproveFact)
+		t.True(ok)
+		bf, ok := b.(ApproveFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Allowance().HashBytes(), bf.Allowance().HashBytes())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestTransferFromFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: TransferFromFactHint, Instance: TransferFromFact{}}))
+
+		fact := NewTransferFromFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(TransferFromFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(TransferFromFact)
+		t.True(ok)
+		bf, ok := b.(TransferFromFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,126 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	BurnsFactHint = hint.MustNewHint("mitum-currency-burns-operation-fact-v0.0.1")
+	BurnsHint     = hint.MustNewHint("mitum-currency-burns-operation-v0.0.1")
+)
+
+var MaxBurnsAmounts uint = 10
+
+type BurnsFact struct {
+	base.BaseFact
+	sender  base.Address
+	amounts []mitumcurrency.Amount
+}
+
+func NewBurnsFact(token []byte, sender base.Address, amounts []mitumcurrency.Amount) BurnsFact {
+	bf := base.NewBaseFact(BurnsFactHint, token)
+	fact := BurnsFact{
+		BaseFact: bf,
+		sender:   sender,
+		amounts:  amounts,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact BurnsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact BurnsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact BurnsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact BurnsFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.amounts))
+	for i := range fact.amounts {
+		bs[i] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (fact BurnsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.amounts); n < 1 {
+		return util.ErrInvalid.Errorf("empty amounts")
+	} else if n > int(MaxBurnsAmounts) {
+		return util.ErrInvalid.Errorf("amounts, %d over max, %d", n, MaxBurnsAmounts)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount should be over zero")
+		}
+
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact BurnsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact BurnsFact) Amounts() []mitumcurrency.Amount {
+	return fact.amounts
+}
+
+func (fact BurnsFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type Burns struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewBurns(fact BurnsFact) (Burns, error) {
+	return Burns{BaseOperation: mitumcurrency.NewBaseOperation(BurnsHint, fact)}, nil
+}
+
+func (op *Burns) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
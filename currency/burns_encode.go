@@ -0,0 +1,37 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *BurnsFact) unpack(enc encoder.Encoder, sd string, bam []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal BurnsFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+	fact.amounts = amounts
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type BurnsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender  base.Address           `json:"sender"`
+	Amounts []mitumcurrency.Amount `json:"amounts"`
+}
+
+func (fact BurnsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BurnsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Amounts:               fact.amounts,
+	})
+}
+
+type BurnsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender  string          `json:"sender"`
+	Amounts json.RawMessage `json:"amounts"`
+}
+
+func (fact *BurnsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of BurnsFact")
+
+	var uf BurnsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Amounts)
+}
+
+type burnsMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op Burns) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(burnsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Burns) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Burns")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
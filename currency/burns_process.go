@@ -0,0 +1,160 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var burnsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(BurnsProcessor)
+	},
+}
+
+func (Burns) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type BurnsProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewBurnsProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new BurnsProcessor")
+
+		nopp := burnsProcessorPool.Get()
+		opp, ok := nopp.(*BurnsProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected BurnsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *BurnsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Burns")
+
+	fact, ok := op.Fact().(BurnsFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected BurnsFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be burns sender, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for i := range fact.amounts {
+		cid := fact.amounts[i].Currency()
+
+		if err := checkExistsState(StateKeyCurrencyDesign(cid), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *BurnsProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Burns")
+
+	fact, ok := op.Fact().(BurnsFact)
+	if !ok {
+		return nil, nil, e(nil, "expected BurnsFact, not %T", op.Fact())
+	}
+
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), []mitumcurrency.AmountsItem{fact})
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fees := RequiredFees(required)
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		k := StateKeyCurrencyDesign(am.Currency())
+		st, err := existsState(k, "key of currency design", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", am.Currency(), err), nil
+		}
+
+		de, err := StateCurrencyDesignValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get currency design value, %q: %w", am.Currency(), err), nil
+		}
+
+		nde, err := de.SubAggregate(am.Big())
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to sub aggregate, %q: %w", am.Currency(), err), nil
+		}
+
+		sts = append(sts, NewCurrencyDesignStateMergeValue(k, NewCurrencyDesignStateValue(nde)))
+	}
+
+	for k := range required {
+		rq := required[k]
+		v, ok := sb[k].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[k].Value()), nil
+		}
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(rq[0])))
+		sts = append(sts, NewBalanceStateMergeValue(sb[k].Key(), stv))
+	}
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *BurnsProcessor) Close() error {
+	burnsProcessorPool.Put(opp)
+
+	return nil
+}
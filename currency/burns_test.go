@@ -0,0 +1,139 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testBurns struct {
+	testProcessorSuite
+	cid    mitumcurrency.CurrencyID
+	states *testStates
+	sender base.Address
+	priv   base.Privatekey
+}
+
+func (t *testBurns) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	t.sender, t.priv = sender, privs[0]
+}
+
+func (t *testBurns) burns(big int64) Burns {
+	op, err := NewBurns(NewBurnsFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testBurns) TestBurn() {
+	t.Nil(t.process(NewBurnsProcessor(), t.burns(30), t.states))
+
+	t.Equal(mitumcurrency.NewBig(70), t.states.balance(t.sender, t.cid))
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1000000-30), de.Aggregate())
+	t.Equal(mitumcurrency.NewBig(30), de.Burned())
+}
+
+func (t *testBurns) TestNotEnoughBalance() {
+	reason := t.process(NewBurnsProcessor(), t.burns(101), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not enough balance of sender")
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1000000), de.Aggregate())
+}
+
+func (t *testBurns) TestUnknownCurrency() {
+	op, err := NewBurns(NewBurnsFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("FINDME"))},
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	reason := t.preProcess(NewBurnsProcessor(), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "currency not found")
+}
+
+func TestBurns(t *testing.T) {
+	suite.Run(t, new(testBurns))
+}
+
+func TestBurnsFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: BurnsFactHint, Instance: BurnsFact{}}))
+
+		fact := NewBurnsFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.Amount{
+				mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+				mitumcurrency.NewAmount(mitumcurrency.NewBig(20), mitumcurrency.CurrencyID("FINDME")),
+			},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(BurnsFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(BurnsFact)
+		t.True(ok)
+		bf, ok := b.(BurnsFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(len(af.Amounts()), len(bf.Amounts()))
+
+		for i := range af.Amounts() {
+			t.True(af.Amounts()[i].Equal(bf.Amounts()[i]))
+		}
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,98 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CancelAccountRecoveryFactHint = hint.MustNewHint("mitum-currency-cancel-account-recovery-operation-fact-v0.0.1")
+	CancelAccountRecoveryHint     = hint.MustNewHint("mitum-currency-cancel-account-recovery-operation-v0.0.1")
+)
+
+// CancelAccountRecoveryFact cancels the pending recovery of sender account
+// before the approved keys replace the account keys.
+type CancelAccountRecoveryFact struct {
+	base.BaseFact
+	sender   base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewCancelAccountRecoveryFact(
+	token []byte,
+	sender base.Address,
+	currency mitumcurrency.CurrencyID,
+) CancelAccountRecoveryFact {
+	bf := base.NewBaseFact(CancelAccountRecoveryFactHint, token)
+	fact := CancelAccountRecoveryFact{
+		BaseFact: bf,
+		sender:   sender,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelAccountRecoveryFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelAccountRecoveryFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelAccountRecoveryFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelAccountRecoveryFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CancelAccountRecoveryFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.currency)
+}
+
+func (fact CancelAccountRecoveryFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelAccountRecoveryFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact CancelAccountRecoveryFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type CancelAccountRecovery struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewCancelAccountRecovery(fact CancelAccountRecoveryFact) (CancelAccountRecovery, error) {
+	return CancelAccountRecovery{BaseOperation: mitumcurrency.NewBaseOperation(CancelAccountRecoveryHint, fact)}, nil
+}
+
+func (op *CancelAccountRecovery) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CancelAccountRecoveryFact) unpack(enc encoder.Encoder, sd, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal CancelAccountRecoveryFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CancelAccountRecoveryFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact CancelAccountRecoveryFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CancelAccountRecoveryFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Currency:              fact.currency,
+	})
+}
+
+type CancelAccountRecoveryFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Currency string `json:"currency"`
+}
+
+func (fact *CancelAccountRecoveryFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CancelAccountRecoveryFact")
+
+	var uf CancelAccountRecoveryFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Currency)
+}
+
+type cancelAccountRecoveryMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op CancelAccountRecovery) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(cancelAccountRecoveryMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CancelAccountRecovery) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CancelAccountRecovery")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelAccountRecoveryProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelAccountRecoveryProcessor)
+	},
+}
+
+func (CancelAccountRecovery) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelAccountRecoveryProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelAccountRecoveryProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CancelAccountRecoveryProcessor")
+
+		nopp := cancelAccountRecoveryProcessorPool.Get()
+		opp, ok := nopp.(*CancelAccountRecoveryProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CancelAccountRecoveryProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelAccountRecoveryProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CancelAccountRecovery")
+
+	fact, ok := op.Fact().(CancelAccountRecoveryFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected CancelAccountRecoveryFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if _, rerr := opp.checkCancel(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelAccountRecoveryProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CancelAccountRecovery")
+
+	fact, ok := op.Fact().(CancelAccountRecoveryFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CancelAccountRecoveryFact, not %T", op.Fact())
+	}
+
+	ar, rerr := opp.checkCancel(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewAccountRecoveryStateMergeValue(StateKeyAccountRecovery(fact.sender), NewAccountRecoveryStateValue(ar.Cancelled())),
+		NewBalanceStateMergeValue(
+			sb[fact.currency].Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		),
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *CancelAccountRecoveryProcessor) Close() error {
+	cancelAccountRecoveryProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *CancelAccountRecoveryProcessor) checkCancel(
+	fact CancelAccountRecoveryFact,
+	getStateFunc base.GetStateFunc,
+) (AccountRecovery, base.OperationProcessReasonError) {
+	switch ar, found, err := pendingAccountRecovery(fact.sender, getStateFunc); {
+	case err != nil:
+		return AccountRecovery{}, base.NewBaseOperationProcessReasonError(
+			"failed to get account recovery, %q: %w", fact.sender, err)
+	case !found:
+		return AccountRecovery{}, base.NewBaseOperationProcessReasonError(
+			"pending account recovery not found, %q", fact.sender)
+	default:
+		return ar, nil
+	}
+}
@@ -0,0 +1,99 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CancelKeyRotationFactHint = hint.MustNewHint("mitum-currency-cancel-key-rotation-operation-fact-v0.0.1")
+	CancelKeyRotationHint     = hint.MustNewHint("mitum-currency-cancel-key-rotation-operation-v0.0.1")
+)
+
+// CancelKeyRotationFact cancels the pending key rotation of sender account
+// before the new keys replace the account keys; it is signed by the current
+// keys of sender. The pending recovery guardians of sender are also cancelled.
+type CancelKeyRotationFact struct {
+	base.BaseFact
+	sender   base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewCancelKeyRotationFact(
+	token []byte,
+	sender base.Address,
+	currency mitumcurrency.CurrencyID,
+) CancelKeyRotationFact {
+	bf := base.NewBaseFact(CancelKeyRotationFactHint, token)
+	fact := CancelKeyRotationFact{
+		BaseFact: bf,
+		sender:   sender,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelKeyRotationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelKeyRotationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelKeyRotationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelKeyRotationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CancelKeyRotationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.currency)
+}
+
+func (fact CancelKeyRotationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelKeyRotationFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact CancelKeyRotationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type CancelKeyRotation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewCancelKeyRotation(fact CancelKeyRotationFact) (CancelKeyRotation, error) {
+	return CancelKeyRotation{BaseOperation: mitumcurrency.NewBaseOperation(CancelKeyRotationHint, fact)}, nil
+}
+
+func (op *CancelKeyRotation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
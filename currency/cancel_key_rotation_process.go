@@ -0,0 +1,184 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelKeyRotationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelKeyRotationProcessor)
+	},
+}
+
+func (CancelKeyRotation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelKeyRotationProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelKeyRotationProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CancelKeyRotationProcessor")
+
+		nopp := cancelKeyRotationProcessorPool.Get()
+		opp, ok := nopp.(*CancelKeyRotationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CancelKeyRotationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelKeyRotationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CancelKeyRotation")
+
+	fact, ok := op.Fact().(CancelKeyRotationFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected CancelKeyRotationFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if _, rerr := opp.checkCancel(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelKeyRotationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CancelKeyRotation")
+
+	fact, ok := op.Fact().(CancelKeyRotationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CancelKeyRotationFact, not %T", op.Fact())
+	}
+
+	sts, rerr := opp.checkCancel(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	sts = append(sts, NewBalanceStateMergeValue(
+		sb[fact.currency].Key(),
+		mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+	))
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *CancelKeyRotationProcessor) Close() error {
+	cancelKeyRotationProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkCancel returns the states which cancel the pending key rotation and
+// the pending recovery guardians of sender.
+func (opp *CancelKeyRotationProcessor) checkCancel(
+	fact CancelKeyRotationFact,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError) {
+	var sts []base.StateMergeValue
+
+	switch kr, found, err := pendingKeyRotation(fact.sender, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation, %q: %w", fact.sender, err)
+	case found:
+		sts = append(sts, NewKeyRotationStateMergeValue(
+			StateKeyKeyRotation(fact.sender), NewKeyRotationStateValue(kr.Cancelled())))
+	}
+
+	switch st, found, err := getStateFunc(StateKeyRecoveryGuardians(fact.sender)); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to get recovery guardians, %q: %w", fact.sender, err)
+	case found:
+		gv, err := StateRecoveryGuardiansValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get recovery guardians value, %q: %w", fact.sender, err)
+		}
+
+		if gv.IsPending(opp.Height()) {
+			previous, _ := gv.Previous()
+
+			sts = append(sts, NewRecoveryGuardiansStateMergeValue(st.Key(), previous))
+		}
+	}
+
+	if len(sts) < 1 {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"pending key rotation not found, %q", fact.sender)
+	}
+
+	return sts, nil
+}
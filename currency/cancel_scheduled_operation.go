@@ -0,0 +1,94 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CancelScheduledOperationFactHint = hint.MustNewHint("mitum-currency-cancel-scheduled-operation-operation-fact-v0.0.1")
+	CancelScheduledOperationHint     = hint.MustNewHint("mitum-currency-cancel-scheduled-operation-operation-v0.0.1")
+)
+
+type CancelScheduledOperationFact struct {
+	base.BaseFact
+	sender   base.Address
+	schedule util.Hash
+}
+
+func NewCancelScheduledOperationFact(token []byte, sender base.Address, schedule util.Hash) CancelScheduledOperationFact {
+	bf := base.NewBaseFact(CancelScheduledOperationFactHint, token)
+	fact := CancelScheduledOperationFact{
+		BaseFact: bf,
+		sender:   sender,
+		schedule: schedule,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CancelScheduledOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CancelScheduledOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CancelScheduledOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CancelScheduledOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.schedule.Bytes(),
+	)
+}
+
+func (fact CancelScheduledOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.schedule)
+}
+
+func (fact CancelScheduledOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CancelScheduledOperationFact) Schedule() util.Hash {
+	return fact.schedule
+}
+
+func (fact CancelScheduledOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// CancelScheduledOperation cancels the scheduled operation of sender before the
+// target height; the reserved fee is refunded to the sender.
+type CancelScheduledOperation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewCancelScheduledOperation(fact CancelScheduledOperationFact) (CancelScheduledOperation, error) {
+	return CancelScheduledOperation{BaseOperation: mitumcurrency.NewBaseOperation(CancelScheduledOperationHint, fact)}, nil
+}
+
+func (op *CancelScheduledOperation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
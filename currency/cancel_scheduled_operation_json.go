@@ -0,0 +1,65 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type CancelScheduledOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address `json:"sender"`
+	Schedule util.Hash    `json:"schedule"`
+}
+
+func (fact CancelScheduledOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CancelScheduledOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Schedule:              fact.schedule,
+	})
+}
+
+type CancelScheduledOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string                `json:"sender"`
+	Schedule valuehash.HashDecoder `json:"schedule"`
+}
+
+func (fact *CancelScheduledOperationFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CancelScheduledOperationFact")
+
+	var uf CancelScheduledOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Schedule.Hash())
+}
+
+type cancelScheduledOperationMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op CancelScheduledOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(cancelScheduledOperationMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CancelScheduledOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CancelScheduledOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
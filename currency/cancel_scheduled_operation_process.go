@@ -0,0 +1,126 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var cancelScheduledOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CancelScheduledOperationProcessor)
+	},
+}
+
+func (CancelScheduledOperation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CancelScheduledOperationProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCancelScheduledOperationProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CancelScheduledOperationProcessor")
+
+		nopp := cancelScheduledOperationProcessorPool.Get()
+		opp, ok := nopp.(*CancelScheduledOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CancelScheduledOperationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CancelScheduledOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CancelScheduledOperation")
+
+	fact, ok := op.Fact().(CancelScheduledOperationFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected CancelScheduledOperationFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, rerr := opp.checkCancel(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CancelScheduledOperationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CancelScheduledOperation")
+
+	fact, ok := op.Fact().(CancelScheduledOperationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CancelScheduledOperationFact, not %T", op.Fact())
+	}
+
+	so, rerr := opp.checkCancel(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	return closeScheduledOperation(so, ScheduledOperationCancelled), nil, nil
+}
+
+func (opp *CancelScheduledOperationProcessor) Close() error {
+	cancelScheduledOperationProcessorPool.Put(opp)
+
+	return nil
+}
+
+func (opp *CancelScheduledOperationProcessor) checkCancel(
+	fact CancelScheduledOperationFact,
+	getStateFunc base.GetStateFunc,
+) (ScheduledOperation, base.OperationProcessReasonError) {
+	so, rerr := checkScheduledOperation(fact.schedule, getStateFunc)
+	if rerr != nil {
+		return ScheduledOperation{}, rerr
+	}
+
+	switch {
+	case !so.Account().Equal(fact.sender):
+		return ScheduledOperation{}, base.NewBaseOperationProcessReasonError(
+			"scheduled operation is not of sender, %q: %q", fact.schedule, fact.sender)
+	case so.IsDue(opp.Height()):
+		return ScheduledOperation{}, base.NewBaseOperationProcessReasonError(
+			"scheduled operation already due, %q; %d >= %d", fact.schedule, opp.Height(), so.Height())
+	}
+
+	return so, nil
+}
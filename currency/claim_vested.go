@@ -0,0 +1,94 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ClaimVestedFactHint = hint.MustNewHint("mitum-currency-claim-vested-operation-fact-v0.0.1")
+	ClaimVestedHint     = hint.MustNewHint("mitum-currency-claim-vested-operation-v0.0.1")
+)
+
+type ClaimVestedFact struct {
+	base.BaseFact
+	sender   base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewClaimVestedFact(token []byte, sender base.Address, currency mitumcurrency.CurrencyID) ClaimVestedFact {
+	bf := base.NewBaseFact(ClaimVestedFactHint, token)
+	fact := ClaimVestedFact{
+		BaseFact: bf,
+		sender:   sender,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ClaimVestedFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ClaimVestedFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ClaimVestedFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ClaimVestedFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ClaimVestedFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.currency)
+}
+
+func (fact ClaimVestedFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ClaimVestedFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact ClaimVestedFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// ClaimVested moves the released amounts of the vesting locks of sender to
+// the balance; the fee is paid from the claimed amount.
+type ClaimVested struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewClaimVested(fact ClaimVestedFact) (ClaimVested, error) {
+	return ClaimVested{BaseOperation: mitumcurrency.NewBaseOperation(ClaimVestedHint, fact)}, nil
+}
+
+func (op *ClaimVested) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
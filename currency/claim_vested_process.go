@@ -0,0 +1,168 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var claimVestedProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ClaimVestedProcessor)
+	},
+}
+
+func (ClaimVested) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ClaimVestedProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewClaimVestedProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ClaimVestedProcessor")
+
+		nopp := claimVestedProcessorPool.Get()
+		opp, ok := nopp.(*ClaimVestedProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ClaimVestedProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ClaimVestedProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ClaimVested")
+
+	fact, ok := op.Fact().(ClaimVestedFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ClaimVestedFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, err := existsActiveCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if err := checkExistsState(StateKeyLock(fact.sender, fact.currency), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("vesting lock not found, %q, %q: %w", fact.sender, fact.currency, err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ClaimVestedProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ClaimVested")
+
+	fact, ok := op.Fact().(ClaimVestedFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ClaimVestedFact, not %T", op.Fact())
+	}
+
+	st, err := existsState(StateKeyLock(fact.sender, fact.currency), "key of vesting lock", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("vesting lock not found, %q, %q: %w", fact.sender, fact.currency, err), nil
+	}
+
+	lv, err := StateLockValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get lock value, %q: %w", st.Key(), err), nil
+	}
+
+	nlv, claimed, err := lv.Claim(opp.Height())
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to claim, %q, %q: %w", fact.sender, fact.currency, err), nil
+	}
+
+	policy, err := existsActiveCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(claimed)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	if fee.OverZero() {
+		if err := checkNotFrozen(fact.sender, fact.currency, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+		}
+	}
+
+	var balance mitumcurrency.Amount
+
+	k := mitumcurrency.StateKeyBalance(fact.sender, fact.currency)
+	switch bst, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to find sender balance state, %q: %w", k, err), nil
+	case !found:
+		balance = mitumcurrency.NewZeroAmount(fact.currency)
+	default:
+		b, err := mitumcurrency.StateBalanceValue(bst)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", k, err), nil
+		}
+		balance = b
+	}
+
+	nb := balance.Big().Add(claimed).Sub(fee)
+	if !nb.OverNil() {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"not enough balance of sender for fee, %q; %v + %v < %v", fact.sender, balance.Big(), claimed, fee), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewLockStateMergeValue(st.Key(), nlv),
+		NewBalanceStateMergeValue(k, mitumcurrency.NewBalanceStateValue(balance.WithBig(nb))),
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *ClaimVestedProcessor) Close() error {
+	claimVestedProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var ContractAccountOperatorHint = hint.MustNewHint("mitum-currency-contract-account-operator-v0.0.1")
+
+var (
+	MaxContractAccountOperators = 10
+	MaxContractAccountOwners    = 10
+)
+
+type ContractAccountOperator struct {
+	hint.BaseHinter
+	address    base.Address
+	allowances []mitumcurrency.Amount
+}
+
+func NewContractAccountOperator(address base.Address, allowances []mitumcurrency.Amount) ContractAccountOperator {
+	return ContractAccountOperator{
+		BaseHinter: hint.NewBaseHinter(ContractAccountOperatorHint),
+		address:    address,
+		allowances: allowances,
+	}
+}
+
+func (op ContractAccountOperator) Bytes() []byte {
+	bs := make([][]byte, len(op.allowances))
+	for i := range op.allowances {
+		bs[i] = op.allowances[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(op.address.Bytes(), util.ConcatBytesSlice(bs...))
+}
+
+func (op ContractAccountOperator) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, op.BaseHinter, op.address); err != nil {
+		return err
+	}
+
+	if len(op.allowances) < 1 {
+		return util.ErrInvalid.Errorf("empty allowances of operator, %q", op.address)
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range op.allowances {
+		am := op.allowances[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency of allowance, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+
+		if am.Big().Compare(mitumcurrency.ZeroBig) < 0 {
+			return util.ErrInvalid.Errorf("negative allowance, %q", am.Currency())
+		}
+	}
+
+	return nil
+}
+
+func (op ContractAccountOperator) Address() base.Address {
+	return op.address
+}
+
+func (op ContractAccountOperator) Allowances() []mitumcurrency.Amount {
+	return op.allowances
+}
+
+func (op ContractAccountOperator) Allowance(cid mitumcurrency.CurrencyID) (mitumcurrency.Amount, bool) {
+	for i := range op.allowances {
+		if op.allowances[i].Currency() == cid {
+			return op.allowances[i], true
+		}
+	}
+
+	return mitumcurrency.Amount{}, false
+}
+
+// Spend decreases the allowance of the given currency by am; the allowance
+// must exist and must be enough.
+func (op ContractAccountOperator) Spend(am mitumcurrency.Amount) (ContractAccountOperator, error) {
+	allowances := make([]mitumcurrency.Amount, len(op.allowances))
+	copy(allowances, op.allowances)
+
+	for i := range allowances {
+		if allowances[i].Currency() != am.Currency() {
+			continue
+		}
+
+		if allowances[i].Big().Compare(am.Big()) < 0 {
+			return ContractAccountOperator{}, errors.Errorf(
+				"not enough allowance of operator, %q; %v < %v", op.address, allowances[i].Big(), am.Big())
+		}
+
+		allowances[i] = allowances[i].WithBig(allowances[i].Big().Sub(am.Big()))
+		op.allowances = allowances
+
+		return op, nil
+	}
+
+	return ContractAccountOperator{}, errors.Errorf("no allowance of operator, %q for currency, %q", op.address, am.Currency())
+}
+
+func (op ContractAccountOperator) Equal(b ContractAccountOperator) bool {
+	if !op.address.Equal(b.address) {
+		return false
+	}
+
+	if len(op.allowances) != len(b.allowances) {
+		return false
+	}
+
+	for i := range op.allowances {
+		if !op.allowances[i].Equal(b.allowances[i]) {
+			return false
+		}
+	}
+
+	return true
+}
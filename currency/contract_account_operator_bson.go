@@ -0,0 +1,40 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (op ContractAccountOperator) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      op.Hint().String(),
+			"address":    op.address,
+			"allowances": op.allowances,
+		},
+	)
+}
+
+type ContractAccountOperatorBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Address    string   `bson:"address"`
+	Allowances bson.Raw `bson:"allowances"`
+}
+
+func (op *ContractAccountOperator) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ContractAccountOperator")
+
+	var uop ContractAccountOperatorBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uop); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uop.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return op.unpack(enc, ht, uop.Address, uop.Allowances)
+}
@@ -0,0 +1,45 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (op *ContractAccountOperator) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ad string,
+	bam []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal ContractAccountOperator")
+
+	op.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ad, enc); {
+	case err != nil:
+		return e(err, "failed to decode address")
+	default:
+		op.address = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+	op.allowances = amounts
+
+	return nil
+}
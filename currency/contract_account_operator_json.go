@@ -0,0 +1,42 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ContractAccountOperatorJSONMarshaler struct {
+	hint.BaseHinter
+	Address    base.Address           `json:"address"`
+	Allowances []mitumcurrency.Amount `json:"allowances"`
+}
+
+func (op ContractAccountOperator) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ContractAccountOperatorJSONMarshaler{
+		BaseHinter: op.BaseHinter,
+		Address:    op.address,
+		Allowances: op.allowances,
+	})
+}
+
+type ContractAccountOperatorJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Address    string          `json:"address"`
+	Allowances json.RawMessage `json:"allowances"`
+}
+
+func (op *ContractAccountOperator) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ContractAccountOperator")
+
+	var uop ContractAccountOperatorJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uop); err != nil {
+		return e(err, "")
+	}
+
+	return op.unpack(enc, uop.Hint, uop.Address, uop.Allowances)
+}
@@ -0,0 +1,198 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testContractAccountOperator struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	owner    base.Address
+	priv     base.Privatekey
+	operator base.Address
+	opriv    base.Privatekey
+	contract base.Address
+}
+
+func (t *testContractAccountOperator) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	owner, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(owner, t.cid, 100)
+
+	operator, oprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(operator, t.cid, 100)
+
+	contract, err := t.states.newContractAccount(owner)
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	t.owner, t.priv = owner, privs[0]
+	t.operator, t.opriv = operator, oprivs[0]
+	t.contract = contract
+}
+
+func (t *testContractAccountOperator) grant(big int64) GrantContractAccountOperator {
+	op, err := NewGrantContractAccountOperator(NewGrantContractAccountOperatorFact(
+		util.UUID().Bytes(),
+		t.owner,
+		t.contract,
+		t.operator,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		t.cid,
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testContractAccountOperator) revoke() RevokeContractAccountOperator {
+	op, err := NewRevokeContractAccountOperator(
+		NewRevokeContractAccountOperatorFact(util.UUID().Bytes(), t.owner, t.contract, t.operator, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testContractAccountOperator) withdraws(big int64) Withdraws {
+	op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), t.operator, []WithdrawsItem{
+		NewWithdrawsItemMultiAmounts(
+			t.contract,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.opriv, t.networkID))
+
+	return op
+}
+
+func (t *testContractAccountOperator) TestWithdrawWithinAllowance() {
+	t.Nil(t.process(NewGrantContractAccountOperatorProcessor(), t.grant(30), t.states))
+
+	t.Nil(t.process(NewWithdrawsProcessor(), t.withdraws(20), t.states))
+	t.Equal(mitumcurrency.NewBig(80), t.states.balance(t.contract, t.cid))
+	t.Equal(mitumcurrency.NewBig(120), t.states.balance(t.operator, t.cid))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+
+	operator, found := ca.Operator(t.operator)
+	t.True(found)
+
+	am, found := operator.Allowance(t.cid)
+	t.True(found)
+	t.Equal(mitumcurrency.NewBig(10), am.Big())
+
+	reason := t.process(NewWithdrawsProcessor(), t.withdraws(20), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not enough allowance of operator")
+}
+
+func (t *testContractAccountOperator) TestRevoke() {
+	t.Nil(t.process(NewGrantContractAccountOperatorProcessor(), t.grant(30), t.states))
+	t.Nil(t.process(NewRevokeContractAccountOperatorProcessor(), t.revoke(), t.states))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.Empty(ca.Operators())
+
+	reason := t.process(NewWithdrawsProcessor(), t.withdraws(10), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "neither owner nor operator")
+
+	reason = t.preProcess(NewRevokeContractAccountOperatorProcessor(), t.revoke(), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "operator not found in contract account")
+}
+
+func (t *testContractAccountOperator) TestGrantByNotOwner() {
+	op, err := NewGrantContractAccountOperator(NewGrantContractAccountOperatorFact(
+		util.UUID().Bytes(),
+		t.operator,
+		t.contract,
+		t.owner,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		t.cid,
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.opriv, t.networkID))
+
+	reason := t.preProcess(NewGrantContractAccountOperatorProcessor(), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "invalid owner signing")
+}
+
+func TestContractAccountOperator(t *testing.T) {
+	suite.Run(t, new(testContractAccountOperator))
+}
+
+func TestContractAccountStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: ContractAccountOperatorHint, Instance: ContractAccountOperator{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: ContractAccountStateValueHint, Instance: ContractAccountStateValue{},
+		}))
+
+		ca, err := NewContractAccount(mitumcurrency.NewAddress(util.UUID().String()), true).SetOperator(
+			NewContractAccountOperator(
+				mitumcurrency.NewAddress(util.UUID().String()),
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+			),
+		)
+		t.NoError(err)
+
+		sv := NewContractAccountStateValue(ca)
+		t.NoError(sv.IsValid(nil))
+
+		b, err := enc.Marshal(sv)
+		t.NoError(err)
+
+		return sv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ContractAccountStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(ContractAccountStateValue)
+		t.True(ok)
+		bv, ok := b.(ContractAccountStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.True(av.account.Equal(bv.account))
+		t.Equal(ContractAccountHint.String(), bv.account.Hint().String())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,125 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ContractAccountOwnerUpdaterFactHint = hint.MustNewHint("mitum-currency-contract-account-owner-updater-operation-fact-v0.0.1")
+	ContractAccountOwnerUpdaterHint     = hint.MustNewHint("mitum-currency-contract-account-owner-updater-operation-v0.0.1")
+)
+
+type ContractAccountOwnerUpdaterFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	owner    base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewContractAccountOwnerUpdaterFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	owner base.Address,
+	currency mitumcurrency.CurrencyID,
+) ContractAccountOwnerUpdaterFact {
+	bf := base.NewBaseFact(ContractAccountOwnerUpdaterFactHint, token)
+	fact := ContractAccountOwnerUpdaterFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		owner:    owner,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ContractAccountOwnerUpdaterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.owner.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ContractAccountOwnerUpdaterFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.owner, fact.currency); err != nil {
+		return err
+	}
+
+	switch {
+	case fact.sender.Equal(fact.contract):
+		return util.ErrInvalid.Errorf("contract account is same with sender, %q", fact.sender)
+	case fact.sender.Equal(fact.owner):
+		return util.ErrInvalid.Errorf("new owner is same with sender, %q", fact.sender)
+	case fact.contract.Equal(fact.owner):
+		return util.ErrInvalid.Errorf("new owner is same with contract account, %q", fact.contract)
+	}
+
+	return nil
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact ContractAccountOwnerUpdaterFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract, fact.owner}, nil
+}
+
+type ContractAccountOwnerUpdater struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewContractAccountOwnerUpdater(fact ContractAccountOwnerUpdaterFact) (ContractAccountOwnerUpdater, error) {
+	return ContractAccountOwnerUpdater{BaseOperation: mitumcurrency.NewBaseOperation(ContractAccountOwnerUpdaterHint, fact)}, nil
+}
+
+func (op *ContractAccountOwnerUpdater) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
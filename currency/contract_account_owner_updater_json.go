@@ -0,0 +1,70 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ContractAccountOwnerUpdaterFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Owner    base.Address             `json:"owner"`
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact ContractAccountOwnerUpdaterFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ContractAccountOwnerUpdaterFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Owner:                 fact.owner,
+		Currency:              fact.currency,
+	})
+}
+
+type ContractAccountOwnerUpdaterFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (fact *ContractAccountOwnerUpdaterFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ContractAccountOwnerUpdaterFact")
+
+	var uf ContractAccountOwnerUpdaterFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Owner, uf.Currency)
+}
+
+type contractAccountOwnerUpdaterMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ContractAccountOwnerUpdater) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(contractAccountOwnerUpdaterMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ContractAccountOwnerUpdater) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ContractAccountOwnerUpdater")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
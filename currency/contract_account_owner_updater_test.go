@@ -0,0 +1,186 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testContractAccountOwnerUpdater struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	owner    base.Address
+	priv     base.Privatekey
+	newOwner base.Address
+	npriv    base.Privatekey
+	contract base.Address
+}
+
+func (t *testContractAccountOwnerUpdater) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	owner, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(owner, t.cid, 100)
+
+	newOwner, nprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(newOwner, t.cid, 100)
+
+	contract, err := t.states.newContractAccount(owner)
+	t.NoError(err)
+
+	t.owner, t.priv = owner, privs[0]
+	t.newOwner, t.npriv = newOwner, nprivs[0]
+	t.contract = contract
+}
+
+func (t *testContractAccountOwnerUpdater) update(
+	sender base.Address, priv base.Privatekey, owner base.Address,
+) ContractAccountOwnerUpdater {
+	op, err := NewContractAccountOwnerUpdater(
+		NewContractAccountOwnerUpdaterFact(util.UUID().Bytes(), sender, t.contract, owner, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testContractAccountOwnerUpdater) TestUpdate() {
+	t.Nil(t.process(NewContractAccountOwnerUpdaterProcessor(), t.update(t.owner, t.priv, t.newOwner), t.states))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.True(ca.Owner().Equal(t.newOwner))
+	t.True(ca.IsActive())
+
+	t.Run("by previous owner", func() {
+		other, _, err := t.states.newAccount([]uint{100}, 100)
+		t.NoError(err)
+
+		reason := t.preProcess(NewContractAccountOwnerUpdaterProcessor(), t.update(t.owner, t.priv, other), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "contract account owner is not matched")
+	})
+
+	t.Nil(t.process(NewContractAccountOwnerUpdaterProcessor(), t.update(t.newOwner, t.npriv, t.owner), t.states))
+
+	ca, err = t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.True(ca.Owner().Equal(t.owner))
+}
+
+func (t *testContractAccountOwnerUpdater) TestOperatorsRemoved() {
+	operator, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+
+	grant, err := NewGrantContractAccountOperator(NewGrantContractAccountOperatorFact(
+		util.UUID().Bytes(),
+		t.owner,
+		t.contract,
+		operator,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(30), t.cid)},
+		t.cid,
+	))
+	t.NoError(err)
+	t.NoError(grant.HashSign(t.priv, t.networkID))
+
+	t.Nil(t.process(NewGrantContractAccountOperatorProcessor(), grant, t.states))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.Equal(1, len(ca.Operators()))
+
+	t.Nil(t.process(NewContractAccountOwnerUpdaterProcessor(), t.update(t.owner, t.priv, t.newOwner), t.states))
+
+	ca, err = t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.True(ca.Owner().Equal(t.newOwner))
+	t.Empty(ca.Operators())
+
+	_, found := ca.Operator(operator)
+	t.False(found)
+}
+
+func (t *testContractAccountOwnerUpdater) TestContractAccountOwner() {
+	other, err := t.states.newContractAccount(t.owner)
+	t.NoError(err)
+
+	reason := t.preProcess(NewContractAccountOwnerUpdaterProcessor(), t.update(t.owner, t.priv, other), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "contract account cannot be contract account owner")
+}
+
+func (t *testContractAccountOwnerUpdater) TestUnknownOwner() {
+	reason := t.preProcess(
+		NewContractAccountOwnerUpdaterProcessor(),
+		t.update(t.owner, t.priv, mitumcurrency.NewAddress(util.UUID().String())),
+		t.states,
+	)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "new owner not found")
+}
+
+func TestContractAccountOwnerUpdater(t *testing.T) {
+	suite.Run(t, new(testContractAccountOwnerUpdater))
+}
+
+func TestContractAccountOwnerUpdaterFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: ContractAccountOwnerUpdaterFactHint, Instance: ContractAccountOwnerUpdaterFact{},
+		}))
+
+		fact := NewContractAccountOwnerUpdaterFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ContractAccountOwnerUpdaterFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ContractAccountOwnerUpdaterFact)
+		t.True(ok)
+		bf, ok := b.(ContractAccountOwnerUpdaterFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.True(af.Owner().Equal(bf.Owner()))
+	}
+
+	suite.Run(tt, t)
+}
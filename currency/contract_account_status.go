@@ -7,14 +7,24 @@ import (
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
 )
 
-var ContractAccountHint = hint.MustNewHint("mitum-currency-contract-account-status-v0.0.1")
+var (
+	ContractAccountHint = hint.MustNewHint("mitum-currency-contract-account-status-v0.0.2")
+	// ContractAccountV001Hint is the hint of the contract account status without
+	// operators; it is still decoded from the existing states and migrated to
+	// ContractAccountHint when the operators are updated.
+	ContractAccountV001Hint = hint.MustNewHint("mitum-currency-contract-account-status-v0.0.1")
+)
 
 type ContractAccount struct {
 	hint.BaseHinter
-	owner    base.Address
-	isActive bool
+	owner     base.Address
+	isActive  bool
+	operators []ContractAccountOperator
+	owners    []base.Address
+	threshold uint
 }
 
 func NewContractAccount(owner base.Address, isActive bool) ContractAccount {
@@ -32,7 +42,22 @@ func (cs ContractAccount) Bytes() []byte {
 		v = 1
 	}
 
-	return util.ConcatBytesSlice(cs.owner.Bytes(), []byte{byte(v)})
+	bs := make([][]byte, len(cs.operators))
+	for i := range cs.operators {
+		bs[i] = cs.operators[i].Bytes()
+	}
+
+	b := util.ConcatBytesSlice(cs.owner.Bytes(), []byte{byte(v)}, util.ConcatBytesSlice(bs...))
+	if !cs.IsMultiOwners() {
+		return b
+	}
+
+	ows := make([][]byte, len(cs.owners))
+	for i := range cs.owners {
+		ows[i] = cs.owners[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(b, util.ConcatBytesSlice(ows...), util.UintToBytes(cs.threshold))
 }
 
 func (cs ContractAccount) Hash() util.Hash {
@@ -44,6 +69,62 @@ func (cs ContractAccount) GenerateHash() util.Hash {
 }
 
 func (cs ContractAccount) IsValid([]byte) error { // nolint:revive
+	if n := len(cs.operators); n > MaxContractAccountOperators {
+		return util.ErrInvalid.Errorf("operators, %d over max, %d", n, MaxContractAccountOperators)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range cs.operators {
+		op := cs.operators[i]
+		if err := op.IsValid(nil); err != nil {
+			return err
+		}
+
+		if op.Address().Equal(cs.owner) || cs.IsOwner(op.Address()) {
+			return util.ErrInvalid.Errorf("operator is same with owner, %q", op.Address())
+		}
+
+		if _, found := founds[op.Address().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate operator found, %q", op.Address())
+		}
+		founds[op.Address().String()] = struct{}{}
+	}
+
+	return cs.isValidOwners()
+}
+
+func (cs ContractAccount) isValidOwners() error {
+	if !cs.IsMultiOwners() {
+		if cs.threshold != 0 {
+			return util.ErrInvalid.Errorf("threshold without owners, %d", cs.threshold)
+		}
+
+		return nil
+	}
+
+	switch n := len(cs.owners); {
+	case n > MaxContractAccountOwners:
+		return util.ErrInvalid.Errorf("owners, %d over max, %d", n, MaxContractAccountOwners)
+	case cs.threshold < 1 || cs.threshold > uint(n):
+		return util.ErrInvalid.Errorf("invalid threshold, %d for %d owners", cs.threshold, n)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range cs.owners {
+		if err := cs.owners[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[cs.owners[i].String()]; found {
+			return util.ErrInvalid.Errorf("duplicate owner found, %q", cs.owners[i])
+		}
+		founds[cs.owners[i].String()] = struct{}{}
+	}
+
+	if _, found := founds[cs.owner.String()]; !found {
+		return util.ErrInvalid.Errorf("owner, %q not in owners", cs.owner)
+	}
+
 	return nil
 }
 
@@ -51,6 +132,8 @@ func (cs ContractAccount) Owner() base.Address { // nolint:revive
 	return cs.owner
 }
 
+// SetOwner replaces the owner; the operators appointed by the previous owner
+// are removed, so the new owner does not inherit their allowances.
 func (cs ContractAccount) SetOwner(a base.Address) (ContractAccount, error) { // nolint:revive
 	err := a.IsValid(nil)
 	if err != nil {
@@ -58,6 +141,7 @@ func (cs ContractAccount) SetOwner(a base.Address) (ContractAccount, error) { //
 	}
 
 	cs.owner = a
+	cs.operators = nil
 
 	return cs, nil
 }
@@ -71,6 +155,111 @@ func (cs ContractAccount) SetIsActive(b bool) ContractAccount { // nolint:revive
 	return cs
 }
 
+func (cs ContractAccount) Operators() []ContractAccountOperator { // nolint:revive
+	return cs.operators
+}
+
+func (cs ContractAccount) Operator(a base.Address) (ContractAccountOperator, bool) { // nolint:revive
+	for i := range cs.operators {
+		if cs.operators[i].Address().Equal(a) {
+			return cs.operators[i], true
+		}
+	}
+
+	return ContractAccountOperator{}, false
+}
+
+// SetOperator adds the operator or replaces the existing operator of same
+// address. The legacy contract account is migrated to ContractAccountHint.
+func (cs ContractAccount) SetOperator(op ContractAccountOperator) (ContractAccount, error) { // nolint:revive
+	if err := op.IsValid(nil); err != nil {
+		return ContractAccount{}, err
+	}
+
+	operators := make([]ContractAccountOperator, len(cs.operators), len(cs.operators)+1)
+	copy(operators, cs.operators)
+
+	var replaced bool
+	for i := range operators {
+		if operators[i].Address().Equal(op.Address()) {
+			operators[i] = op
+			replaced = true
+
+			break
+		}
+	}
+
+	if !replaced {
+		operators = append(operators, op)
+	}
+
+	cs.BaseHinter = hint.NewBaseHinter(ContractAccountHint)
+	cs.operators = operators
+
+	return cs, cs.IsValid(nil)
+}
+
+// RemoveOperator removes the operator; it fails when the operator does not
+// exist. The legacy contract account is migrated to ContractAccountHint.
+func (cs ContractAccount) RemoveOperator(a base.Address) (ContractAccount, error) { // nolint:revive
+	operators := make([]ContractAccountOperator, 0, len(cs.operators))
+
+	for i := range cs.operators {
+		if cs.operators[i].Address().Equal(a) {
+			continue
+		}
+
+		operators = append(operators, cs.operators[i])
+	}
+
+	if len(operators) == len(cs.operators) {
+		return ContractAccount{}, errors.Errorf("operator, %q not found", a)
+	}
+
+	cs.BaseHinter = hint.NewBaseHinter(ContractAccountHint)
+	cs.operators = operators
+
+	return cs, nil
+}
+
+// IsMultiOwners returns true when the contract account is controlled by
+// threshold of the owners.
+func (cs ContractAccount) IsMultiOwners() bool { // nolint:revive
+	return len(cs.owners) > 0
+}
+
+func (cs ContractAccount) Owners() []base.Address { // nolint:revive
+	return cs.owners
+}
+
+func (cs ContractAccount) Threshold() uint { // nolint:revive
+	return cs.threshold
+}
+
+func (cs ContractAccount) IsOwner(a base.Address) bool { // nolint:revive
+	for i := range cs.owners {
+		if cs.owners[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetOwners sets the owners and threshold; empty owners with zero threshold
+// returns the contract account to the single owner.
+func (cs ContractAccount) SetOwners(owners []base.Address, threshold uint) (ContractAccount, error) { // nolint:revive
+	cs.BaseHinter = hint.NewBaseHinter(ContractAccountHint)
+	cs.owners = owners
+	cs.threshold = threshold
+
+	if err := cs.IsValid(nil); err != nil {
+		return ContractAccount{}, err
+	}
+
+	return cs, nil
+}
+
 func (cs ContractAccount) Equal(b ContractAccount) bool {
 	if cs.isActive != b.isActive {
 		return false
@@ -78,6 +267,22 @@ func (cs ContractAccount) Equal(b ContractAccount) bool {
 	if !cs.owner.Equal(b.owner) {
 		return false
 	}
+	if len(cs.operators) != len(b.operators) {
+		return false
+	}
+	for i := range cs.operators {
+		if !cs.operators[i].Equal(b.operators[i]) {
+			return false
+		}
+	}
+	if cs.threshold != b.threshold || len(cs.owners) != len(b.owners) {
+		return false
+	}
+	for i := range cs.owners {
+		if !cs.owners[i].Equal(b.owners[i]) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -10,17 +10,23 @@ import (
 func (cs ContractAccount) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    cs.Hint().String(),
-			"isactive": cs.isActive,
-			"owner":    cs.owner,
+			"_hint":     cs.Hint().String(),
+			"isactive":  cs.isActive,
+			"owner":     cs.owner,
+			"operators": cs.operators,
+			"owners":    cs.owners,
+			"threshold": cs.threshold,
 		},
 	)
 }
 
 type ContractAccountBSONUnmarshaler struct {
-	Hint     string `json:"_hint"`
-	IsActive bool   `bson:"isactive"`
-	Owner    string `bson:"owner"`
+	Hint      string   `json:"_hint"`
+	IsActive  bool     `bson:"isactive"`
+	Owner     string   `bson:"owner"`
+	Operators bson.Raw `bson:"operators"`
+	Owners    []string `bson:"owners"`
+	Threshold uint     `bson:"threshold"`
 }
 
 func (cs *ContractAccount) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +42,5 @@ func (cs *ContractAccount) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return cs.unpack(enc, ht, ucs.IsActive, ucs.Owner)
+	return cs.unpack(enc, ht, ucs.IsActive, ucs.Owner, ucs.Operators, ucs.Owners, ucs.Threshold)
 }
@@ -12,6 +12,9 @@ func (cs *ContractAccount) unpack(
 	ht hint.Hint,
 	ia bool,
 	ow string,
+	bops []byte,
+	ows []string,
+	th uint,
 ) error {
 	e := util.StringErrorFunc("failed to unmarshal ContractAccount")
 
@@ -26,5 +29,43 @@ func (cs *ContractAccount) unpack(
 
 	cs.isActive = ia
 
+	owners := make([]base.Address, len(ows))
+	for i := range ows {
+		a, err := base.DecodeAddress(ows[i], enc)
+		if err != nil {
+			return e(err, "failed to decode owner address")
+		}
+
+		owners[i] = a
+	}
+
+	if len(owners) > 0 {
+		cs.owners = owners
+	}
+	cs.threshold = th
+
+	// NOTE the legacy contract account, ContractAccountV001Hint has no operators
+	if len(bops) < 1 || ht.String() == ContractAccountV001Hint.String() {
+		cs.operators = nil
+
+		return nil
+	}
+
+	hops, err := enc.DecodeSlice(bops)
+	if err != nil {
+		return e(err, "")
+	}
+
+	operators := make([]ContractAccountOperator, len(hops))
+	for i := range hops {
+		j, ok := hops[i].(ContractAccountOperator)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected ContractAccountOperator, not %T", hops[i]), "")
+		}
+
+		operators[i] = j
+	}
+	cs.operators = operators
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package currency
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -9,8 +11,11 @@ import (
 
 type ContractAccountJSONMarshaler struct {
 	hint.BaseHinter
-	IsActive bool         `json:"isactive"`
-	Owner    base.Address `json:"owner"`
+	IsActive  bool                      `json:"isactive"`
+	Owner     base.Address              `json:"owner"`
+	Operators []ContractAccountOperator `json:"operators"`
+	Owners    []base.Address            `json:"owners"`
+	Threshold uint                      `json:"threshold"`
 }
 
 func (cs ContractAccount) MarshalJSON() ([]byte, error) {
@@ -18,13 +23,19 @@ func (cs ContractAccount) MarshalJSON() ([]byte, error) {
 		BaseHinter: cs.BaseHinter,
 		IsActive:   cs.isActive,
 		Owner:      cs.owner,
+		Operators:  cs.operators,
+		Owners:     cs.owners,
+		Threshold:  cs.threshold,
 	})
 }
 
 type ContractAccountJSONUnmarshaler struct {
-	Hint     hint.Hint `json:"_hint"`
-	IsActive bool      `json:"isactive"`
-	Owner    string    `json:"owner"`
+	Hint      hint.Hint       `json:"_hint"`
+	IsActive  bool            `json:"isactive"`
+	Owner     string          `json:"owner"`
+	Operators json.RawMessage `json:"operators"`
+	Owners    []string        `json:"owners"`
+	Threshold uint            `json:"threshold"`
 }
 
 func (ca *ContractAccount) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -35,5 +46,5 @@ func (ca *ContractAccount) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return ca.unpack(enc, ucs.Hint, ucs.IsActive, ucs.Owner)
+	return ca.unpack(enc, ucs.Hint, ucs.IsActive, ucs.Owner, ucs.Operators, ucs.Owners, ucs.Threshold)
 }
@@ -0,0 +1,51 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var MaxContractAccountSaltLength = 64
+
+var contractAddressSaltPrefix = []byte("contract-account-salt")
+
+// NewContractAddressFromSalt derives the address of contract account from the
+// sender address and salt, like CREATE2; the address can be known before the
+// contract account is created.
+func NewContractAddressFromSalt(sender base.Address, salt string) (mitumcurrency.Address, error) {
+	if err := util.CheckIsValiders(nil, false, sender); err != nil {
+		return mitumcurrency.Address{}, err
+	}
+
+	if err := isValidContractAccountSalt(salt); err != nil {
+		return mitumcurrency.Address{}, err
+	}
+
+	h := valuehash.NewSHA256(util.ConcatBytesSlice(contractAddressSaltPrefix, sender.Bytes(), []byte(salt)))
+
+	return mitumcurrency.NewAddress(h.String()), nil
+}
+
+func isValidContractAccountSalt(salt string) error {
+	switch n := len(salt); {
+	case n < 1:
+		return util.ErrInvalid.Errorf("empty salt")
+	case n > MaxContractAccountSaltLength:
+		return util.ErrInvalid.Errorf("salt, %d over max, %d", n, MaxContractAccountSaltLength)
+	default:
+		return nil
+	}
+}
+
+// contractAccountAddress returns the address of new contract account; if the
+// item has salt, the address is derived from sender and salt, otherwise from
+// the keys of item.
+func contractAccountAddress(sender base.Address, it CreateContractAccountsItem) (base.Address, error) {
+	if salt := it.Salt(); len(salt) > 0 {
+		return NewContractAddressFromSalt(sender, salt)
+	}
+
+	return it.Address()
+}
@@ -0,0 +1,249 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testContractAddress struct {
+	testProcessorSuite
+	cid    mitumcurrency.CurrencyID
+	states *testStates
+	sender base.Address
+	priv   base.Privatekey
+}
+
+func (t *testContractAddress) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	t.sender, t.priv = sender, privs[0]
+}
+
+func (t *testContractAddress) newKeys() mitumcurrency.AccountKeys {
+	k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testContractAddress) item(salt string) CreateContractAccountsItemMultiAmounts {
+	return NewCreateContractAccountsItemMultiAmounts(
+		t.newKeys(),
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		mitumcurrency.AddressHint.Type(),
+		salt,
+	)
+}
+
+func (t *testContractAddress) create(salt string) CreateContractAccounts {
+	op, err := NewCreateContractAccounts(NewCreateContractAccountsFact(
+		util.UUID().Bytes(), t.sender, []CreateContractAccountsItem{t.item(salt)}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testContractAddress) TestNewContractAddressFromSalt() {
+	a, err := NewContractAddressFromSalt(t.sender, "showme")
+	t.NoError(err)
+	t.NoError(a.IsValid(nil))
+
+	b, err := NewContractAddressFromSalt(t.sender, "showme")
+	t.NoError(err)
+	t.True(a.Equal(b))
+
+	t.Run("different salt", func() {
+		c, err := NewContractAddressFromSalt(t.sender, "findme")
+		t.NoError(err)
+		t.False(a.Equal(c))
+	})
+
+	t.Run("different sender", func() {
+		c, err := NewContractAddressFromSalt(base.RandomAddress(""), "showme")
+		t.NoError(err)
+		t.False(a.Equal(c))
+	})
+}
+
+func (t *testContractAddress) TestInvalidSalt() {
+	t.Run("empty salt", func() {
+		_, err := NewContractAddressFromSalt(t.sender, "")
+		t.Error(err)
+		t.ErrorContains(err, "empty salt")
+	})
+
+	t.Run("over max", func() {
+		_, err := NewContractAddressFromSalt(t.sender, strings.Repeat("a", MaxContractAccountSaltLength+1))
+		t.Error(err)
+		t.ErrorContains(err, "over max")
+
+		err = t.item(strings.Repeat("a", MaxContractAccountSaltLength+1)).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "over max")
+	})
+
+	t.Run("max", func() {
+		_, err := NewContractAddressFromSalt(t.sender, strings.Repeat("a", MaxContractAccountSaltLength))
+		t.NoError(err)
+	})
+
+	t.Run("not AddressHint type", func() {
+		it := NewCreateContractAccountsItemMultiAmounts(
+			t.newKeys(),
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+			mitumcurrency.EthAddressHint.Type(),
+			"showme",
+		)
+
+		err := it.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "address with salt should be")
+	})
+
+	t.Run("duplicate salt", func() {
+		fact := NewCreateContractAccountsFact(
+			util.UUID().Bytes(), t.sender, []CreateContractAccountsItem{t.item("showme"), t.item("showme")})
+
+		err := fact.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "duplicate")
+	})
+}
+
+func (t *testContractAddress) TestCreate() {
+	target, err := NewContractAddressFromSalt(t.sender, "showme")
+	t.NoError(err)
+
+	op := t.create("showme")
+
+	fact, ok := op.Fact().(CreateContractAccountsFact)
+	t.True(ok)
+
+	targets, err := fact.Targets()
+	t.NoError(err)
+	t.Equal(1, len(targets))
+	t.True(target.Equal(targets[0]))
+
+	t.Nil(t.process(NewCreateContractAccountsProcessor(), op, t.states))
+
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(target, t.cid))
+
+	ca, err := t.states.contractAccount(target)
+	t.NoError(err)
+	t.True(t.sender.Equal(ca.Owner()))
+	t.True(ca.IsActive())
+
+	t.Run("same salt again", func() {
+		reason := t.process(NewCreateContractAccountsProcessor(), t.create("showme"), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "key of target account")
+	})
+
+	t.Run("different salt", func() {
+		t.Nil(t.process(NewCreateContractAccountsProcessor(), t.create("findme"), t.states))
+		t.Equal(mitumcurrency.NewBig(80), t.states.balance(t.sender, t.cid))
+	})
+}
+
+func TestContractAddress(t *testing.T) {
+	suite.Run(t, new(testContractAddress))
+}
+
+func TestCreateContractAccountsFactWithSaltEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: base.MPublickeyHint, Instance: base.MPublickey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeyHint, Instance: mitumcurrency.BaseAccountKey{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AccountKeysHint, Instance: mitumcurrency.BaseAccountKeys{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: CreateContractAccountsItemMultiAmountsHint, Instance: CreateContractAccountsItemMultiAmounts{},
+		}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: CreateContractAccountsFactHint, Instance: CreateContractAccountsFact{}}))
+
+		k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+		t.NoError(err)
+
+		keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+		t.NoError(err)
+
+		amounts := []mitumcurrency.Amount{
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+		}
+
+		fact := NewCreateContractAccountsFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]CreateContractAccountsItem{
+				NewCreateContractAccountsItemMultiAmounts(keys, amounts, mitumcurrency.AddressHint.Type(), "showme"),
+				NewCreateContractAccountsItemMultiAmounts(keys, amounts, mitumcurrency.AddressHint.Type(), ""),
+			},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CreateContractAccountsFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(CreateContractAccountsFact)
+		t.True(ok)
+		bf, ok := b.(CreateContractAccountsFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(len(af.Items()), len(bf.Items()))
+
+		for i := range af.Items() {
+			t.Equal(af.Items()[i].Salt(), bf.Items()[i].Salt())
+		}
+
+		ats, err := af.Targets()
+		t.NoError(err)
+		bts, err := bf.Targets()
+		t.NoError(err)
+
+		for i := range ats {
+			t.True(ats[i].Equal(bts[i]))
+		}
+	}
+
+	suite.Run(tt, t)
+}
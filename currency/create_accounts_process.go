@@ -8,6 +8,7 @@ import (
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/isaac"
 	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
 )
 
@@ -23,6 +24,27 @@ var createAccountsProcessorPool = sync.Pool{
 	},
 }
 
+func (FeeExchangeCreateAccounts) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+func (SponsoredCreateAccounts) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+// createAccountsFact is the fact of CreateAccounts, FeeExchangeCreateAccounts
+// and SponsoredCreateAccounts.
+type createAccountsFact interface {
+	Sender() base.Address
+	Items() []mitumcurrency.CreateAccountsItem
+}
+
 type CreateAccountsItemProcessor struct {
 	h    util.Hash
 	item mitumcurrency.CreateAccountsItem
@@ -36,7 +58,7 @@ func (opp *CreateAccountsItemProcessor) PreProcess(
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		policy, err := existsCurrencyPolicy(am.Currency(), getStateFunc)
+		policy, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
 		if err != nil {
 			return err
 		}
@@ -66,7 +88,7 @@ func (opp *CreateAccountsItemProcessor) PreProcess(
 		case found:
 			return isaac.ErrStopProcessingRetry.Errorf("target balance already exists, %q", target)
 		default:
-			nb[am.Currency()] = mitumcurrency.NewBalanceStateMergeValue(mitumcurrency.StateKeyBalance(target, am.Currency()), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(am.Currency())))
+			nb[am.Currency()] = NewBalanceStateMergeValue(mitumcurrency.StateKeyBalance(target, am.Currency()), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(am.Currency())))
 		}
 	}
 	opp.nb = nb
@@ -103,7 +125,7 @@ func (opp *CreateAccountsItemProcessor) Process(
 			return nil, errors.Errorf("expected BalanceStateValue, not %T", opp.nb[am.Currency()].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(am.Big())))
-		sts[i+1] = mitumcurrency.NewBalanceStateMergeValue(opp.nb[am.Currency()].Key(), stv)
+		sts[i+1] = NewBalanceStateMergeValue(opp.nb[am.Currency()].Key(), stv)
 	}
 
 	return sts, nil
@@ -156,7 +178,7 @@ func (opp *CreateAccountsProcessor) PreProcess(
 ) (context.Context, base.OperationProcessReasonError, error) {
 	e := util.StringErrorFunc("failed to preprocess CreateAccounts")
 
-	fact, ok := op.Fact().(mitumcurrency.CreateAccountsFact)
+	fact, ok := op.Fact().(createAccountsFact)
 	if !ok {
 		return ctx, nil, e(nil, "expected CreateAccountsFact, not %T", op.Fact())
 	}
@@ -169,7 +191,7 @@ func (opp *CreateAccountsProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be create-account sender, %q: %w", fact.Sender(), err), nil
 	}
 
-	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+	if err := checkSenderSigns(op, fact.Sender(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
@@ -182,7 +204,7 @@ func (opp *CreateAccountsProcessor) Process( // nolint:dupl
 ) {
 	e := util.StringErrorFunc("failed to process CreateAccounts")
 
-	fact, ok := op.Fact().(mitumcurrency.CreateAccountsFact)
+	fact, ok := op.Fact().(createAccountsFact)
 	if !ok {
 		return nil, nil, e(nil, "expected CreateAccountsFact, not %T", op.Fact())
 	}
@@ -192,7 +214,14 @@ func (opp *CreateAccountsProcessor) Process( // nolint:dupl
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	sb, err := CheckEnoughBalance(fact.Sender(), required, getStateFunc)
+	fees := RequiredFees(required)
+
+	required, psts, err := PayFeeByFeePayer(feePayerOf(op.Fact()), required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee by fee payer: %w", err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(fact.Sender(), required, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
 	}
@@ -232,8 +261,16 @@ func (opp *CreateAccountsProcessor) Process( // nolint:dupl
 			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+		sts = append(sts, NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	sts = append(sts, psts...)
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
 	}
+	sts = append(sts, fsts...)
 
 	return sts, nil, nil
 }
@@ -245,7 +282,7 @@ func (opp *CreateAccountsProcessor) Close() error {
 }
 
 func (opp *CreateAccountsProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
-	fact, ok := op.Fact().(mitumcurrency.CreateAccountsFact)
+	fact, ok := op.Fact().(createAccountsFact)
 	if !ok {
 		return nil, errors.Errorf("expected CreateAccountsFact, not %T", op.Fact())
 	}
@@ -255,10 +292,25 @@ func (opp *CreateAccountsProcessor) calculateItemsFee(op base.Operation, getStat
 		items[i] = fact.Items()[i]
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), items)
+	if err != nil {
+		return nil, err
+	}
+
+	if fe, ok := op.Fact().(FeeExchangeFact); ok {
+		return ExchangeItemsFee(getStateFunc, factHint(op.Fact()), required, fe.FeeCurrency())
+	}
+
+	return required, nil
 }
 
-func CalculateItemsFee(getStateFunc base.GetStateFunc, items []mitumcurrency.AmountsItem) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
+// CalculateItemsFee calculates the fees of items by the feeer of currency
+// policy for the operation, of which fact has the fact hint.
+func CalculateItemsFee(
+	getStateFunc base.GetStateFunc,
+	fact hint.Hint,
+	items []mitumcurrency.AmountsItem,
+) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
 	required := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
 
 	for i := range items {
@@ -277,7 +329,7 @@ func CalculateItemsFee(getStateFunc base.GetStateFunc, items []mitumcurrency.Amo
 				return nil, err
 			}
 
-			switch k, err := policy.Feeer().Fee(am.Big()); {
+			switch k, err := policy.FeeerOf(fact).Fee(am.Big()); {
 			case err != nil:
 				return nil, err
 			case !k.OverZero():
@@ -291,6 +343,27 @@ func CalculateItemsFee(getStateFunc base.GetStateFunc, items []mitumcurrency.Amo
 	return required, nil
 }
 
+// CheckEnoughDebitBalance checks the balance of holder like
+// CheckEnoughBalance and also rejects the debit from the frozen holder; the
+// balance of the credited holder should be checked by CheckEnoughBalance.
+func CheckEnoughDebitBalance(
+	holder base.Address,
+	required map[mitumcurrency.CurrencyID][2]mitumcurrency.Big,
+	getStateFunc base.GetStateFunc,
+) (map[mitumcurrency.CurrencyID]base.StateMergeValue, error) {
+	for cid := range required {
+		if !required[cid][0].OverZero() {
+			continue
+		}
+
+		if err := checkNotFrozen(holder, cid, getStateFunc); err != nil {
+			return nil, err
+		}
+	}
+
+	return CheckEnoughBalance(holder, required, getStateFunc)
+}
+
 func CheckEnoughBalance(
 	holder base.Address,
 	required map[mitumcurrency.CurrencyID][2]mitumcurrency.Big,
@@ -314,7 +387,7 @@ func CheckEnoughBalance(
 		if am.Big().Compare(rq[0]) < 0 {
 			return nil, errors.Errorf("not enough balance of sender, %q; %v !> %v", holder, am.Big(), rq[0])
 		}
-		sb[cid] = mitumcurrency.NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(am))
+		sb[cid] = NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(am))
 	}
 
 	return sb, nil
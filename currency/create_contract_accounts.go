@@ -22,6 +22,7 @@ type CreateContractAccountsItem interface {
 	Bytes() []byte
 	Keys() mitumcurrency.AccountKeys
 	Address() (base.Address, error)
+	Salt() string
 	Rebuild() CreateContractAccountsItem
 	AddressType() hint.Type
 }
@@ -92,11 +93,15 @@ func (fact CreateContractAccountsFact) IsValid(b []byte) error {
 
 		it := fact.items[i]
 		k := it.Keys().Hash().String()
+		if salt := it.Salt(); len(salt) > 0 {
+			k = "salt:" + salt
+		}
+
 		if _, found := foundKeys[k]; found {
 			return util.ErrInvalid.Errorf("duplicate acocunt Keys found, %s", k)
 		}
 
-		switch a, err := it.Address(); {
+		switch a, err := contractAccountAddress(fact.sender, it); {
 		case err != nil:
 			return err
 		case fact.sender.Equal(a):
@@ -124,7 +129,7 @@ func (fact CreateContractAccountsFact) Items() []CreateContractAccountsItem {
 func (fact CreateContractAccountsFact) Targets() ([]base.Address, error) {
 	as := make([]base.Address, len(fact.items))
 	for i := range fact.items {
-		a, err := fact.items[i].Address()
+		a, err := contractAccountAddress(fact.sender, fact.items[i])
 		if err != nil {
 			return nil, err
 		}
@@ -12,14 +12,16 @@ type BaseCreateContractAccountsItem struct {
 	keys        mitumcurrency.AccountKeys
 	amounts     []mitumcurrency.Amount
 	addressType hint.Type
+	salt        string
 }
 
-func NewBaseCreateContractAccountsItem(ht hint.Hint, keys mitumcurrency.AccountKeys, amounts []mitumcurrency.Amount, addrType hint.Type) BaseCreateContractAccountsItem {
+func NewBaseCreateContractAccountsItem(ht hint.Hint, keys mitumcurrency.AccountKeys, amounts []mitumcurrency.Amount, addrType hint.Type, salt string) BaseCreateContractAccountsItem {
 	return BaseCreateContractAccountsItem{
 		BaseHinter:  hint.NewBaseHinter(ht),
 		keys:        keys,
 		amounts:     amounts,
 		addressType: addrType,
+		salt:        salt,
 	}
 }
 
@@ -32,6 +34,12 @@ func (it BaseCreateContractAccountsItem) Bytes() []byte {
 		bs[i+length] = it.amounts[i].Bytes()
 	}
 
+	// NOTE salt is added only when it is given, so the bytes of item without
+	// salt is not changed
+	if len(it.salt) > 0 {
+		bs = append(bs, []byte(it.salt))
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -48,6 +56,16 @@ func (it BaseCreateContractAccountsItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid AddressHint")
 	}
 
+	if len(it.salt) > 0 {
+		if err := isValidContractAccountSalt(it.salt); err != nil {
+			return err
+		}
+
+		if it.addressType != mitumcurrency.AddressHint.Type() {
+			return util.ErrInvalid.Errorf("address with salt should be %q type", mitumcurrency.AddressHint.Type())
+		}
+	}
+
 	founds := map[mitumcurrency.CurrencyID]struct{}{}
 	for i := range it.amounts {
 		am := it.amounts[i]
@@ -70,10 +88,17 @@ func (it BaseCreateContractAccountsItem) Keys() mitumcurrency.AccountKeys {
 	return it.keys
 }
 
+// Address returns the address derived from the keys; if the item has salt, the
+// address of new contract account is derived from the sender and salt by
+// NewContractAddressFromSalt.
 func (it BaseCreateContractAccountsItem) Address() (base.Address, error) {
 	return mitumcurrency.NewAddressFromKeys(it.keys)
 }
 
+func (it BaseCreateContractAccountsItem) Salt() string {
+	return it.salt
+}
+
 func (it BaseCreateContractAccountsItem) AddressType() hint.Type {
 	return it.addressType
 }
@@ -8,14 +8,18 @@ import (
 )
 
 func (it BaseCreateContractAccountsItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":    it.Hint().String(),
-			"keys":     it.keys,
-			"amounts":  it.amounts,
-			"addrtype": it.addressType,
-		},
-	)
+	m := bson.M{
+		"_hint":    it.Hint().String(),
+		"keys":     it.keys,
+		"amounts":  it.amounts,
+		"addrtype": it.addressType,
+	}
+
+	if len(it.salt) > 0 {
+		m["salt"] = it.salt
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CreateContractAccountsItemBSONUnmarshaler struct {
@@ -23,6 +27,7 @@ type CreateContractAccountsItemBSONUnmarshaler struct {
 	Keys     bson.Raw `bson:"keys"`
 	Amounts  bson.Raw `bson:"amounts"`
 	AddrType string   `bson:"addrtype"`
+	Salt     string   `bson:"salt,omitempty"`
 }
 
 func (it *BaseCreateContractAccountsItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,5 +43,5 @@ func (it *BaseCreateContractAccountsItem) DecodeBSON(b []byte, enc *bsonenc.Enco
 		return e(err, "")
 	}
 
-	return it.unpack(enc, ht, uit.Keys, uit.Amounts, uit.AddrType)
+	return it.unpack(enc, ht, uit.Keys, uit.Amounts, uit.AddrType, uit.Salt)
 }
@@ -7,7 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (it *BaseCreateContractAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte, sadtype string) error {
+func (it *BaseCreateContractAccountsItem) unpack(enc encoder.Encoder, ht hint.Hint, bks []byte, bam []byte, sadtype, salt string) error {
 	e := util.StringErrorFunc("failed to unmarshal BaseCreateContractAccountsItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
@@ -37,6 +37,7 @@ func (it *BaseCreateContractAccountsItem) unpack(enc encoder.Encoder, ht hint.Hi
 
 	it.amounts = amounts
 	it.addressType = hint.Type(sadtype)
+	it.salt = salt
 
 	return nil
 }
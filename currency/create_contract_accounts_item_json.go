@@ -14,6 +14,7 @@ type CreateContractAccountsItemJSONMarshaler struct {
 	Keys     mitumcurrency.AccountKeys `json:"keys"`
 	Amounts  []mitumcurrency.Amount    `json:"amounts"`
 	AddrType hint.Type                 `json:"addrtype"`
+	Salt     string                    `json:"salt,omitempty"`
 }
 
 func (it BaseCreateContractAccountsItem) MarshalJSON() ([]byte, error) {
@@ -22,6 +23,7 @@ func (it BaseCreateContractAccountsItem) MarshalJSON() ([]byte, error) {
 		Keys:       it.keys,
 		Amounts:    it.amounts,
 		AddrType:   it.addressType,
+		Salt:       it.salt,
 	})
 }
 
@@ -30,6 +32,7 @@ type CreateContractAccountsItemJSONUnMarshaler struct {
 	Keys     json.RawMessage `json:"keys"`
 	Amounts  json.RawMessage `json:"amounts"`
 	AddrType string          `json:"addrtype"`
+	Salt     string          `json:"salt"`
 }
 
 func (it *BaseCreateContractAccountsItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +43,5 @@ func (it *BaseCreateContractAccountsItem) DecodeJSON(b []byte, enc *jsonenc.Enco
 		return e(err, "")
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts, uit.AddrType)
+	return it.unpack(enc, uit.Hint, uit.Keys, uit.Amounts, uit.AddrType, uit.Salt)
 }
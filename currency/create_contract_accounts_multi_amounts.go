@@ -16,9 +16,9 @@ type CreateContractAccountsItemMultiAmounts struct {
 	BaseCreateContractAccountsItem
 }
 
-func NewCreateContractAccountsItemMultiAmounts(keys mitumcurrency.AccountKeys, amounts []mitumcurrency.Amount, addrType hint.Type) CreateContractAccountsItemMultiAmounts {
+func NewCreateContractAccountsItemMultiAmounts(keys mitumcurrency.AccountKeys, amounts []mitumcurrency.Amount, addrType hint.Type, salt string) CreateContractAccountsItemMultiAmounts {
 	return CreateContractAccountsItemMultiAmounts{
-		BaseCreateContractAccountsItem: NewBaseCreateContractAccountsItem(CreateContractAccountsItemMultiAmountsHint, keys, amounts, addrType),
+		BaseCreateContractAccountsItem: NewBaseCreateContractAccountsItem(CreateContractAccountsItemMultiAmountsHint, keys, amounts, addrType, salt),
 	}
 }
 
@@ -34,6 +34,7 @@ type CreateContractAccountsItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   CreateContractAccountsItem
+	target base.Address
 	ns     base.StateMergeValue
 	oas    base.StateMergeValue
 	oac    mitumcurrency.Account
@@ -46,7 +47,7 @@ func (opp *CreateContractAccountsItemProcessor) PreProcess(
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		policy, err := existsCurrencyPolicy(am.Currency(), getStateFunc)
+		policy, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
 		if err != nil {
 			return err
 		}
@@ -56,10 +57,11 @@ func (opp *CreateContractAccountsItemProcessor) PreProcess(
 		}
 	}
 
-	target, err := opp.item.Address()
+	target, err := contractAccountAddress(opp.sender, opp.item)
 	if err != nil {
 		return err
 	}
+	opp.target = target
 
 	st, err := notExistsState(mitumcurrency.StateKeyAccount(target), "key of target account", getStateFunc)
 	if err != nil {
@@ -92,7 +94,7 @@ func (opp *CreateContractAccountsItemProcessor) PreProcess(
 		case found:
 			return isaac.ErrStopProcessingRetry.Errorf("target balance already exists, %q", target)
 		default:
-			nb[am.Currency()] = mitumcurrency.NewBalanceStateMergeValue(mitumcurrency.StateKeyBalance(target, am.Currency()), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(am.Currency())))
+			nb[am.Currency()] = NewBalanceStateMergeValue(mitumcurrency.StateKeyBalance(target, am.Currency()), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(am.Currency())))
 		}
 	}
 	opp.nb = nb
@@ -112,9 +114,12 @@ func (opp *CreateContractAccountsItemProcessor) Process(
 		err error
 	)
 
-	if opp.item.AddressType() == mitumcurrency.EthAddressHint.Type() {
+	switch {
+	case len(opp.item.Salt()) > 0:
+		nac, err = mitumcurrency.NewAccount(opp.target, opp.item.Keys())
+	case opp.item.AddressType() == mitumcurrency.EthAddressHint.Type():
 		nac, err = mitumcurrency.NewEthAccountFromKeys(opp.item.Keys())
-	} else {
+	default:
 		nac, err = mitumcurrency.NewAccountFromKeys(opp.item.Keys())
 	}
 	if err != nil {
@@ -142,7 +147,7 @@ func (opp *CreateContractAccountsItemProcessor) Process(
 			return nil, errors.Errorf("expected BalanceStateValue, not %T", opp.nb[am.Currency()].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(am.Big())))
-		sts[i+2] = mitumcurrency.NewBalanceStateMergeValue(opp.nb[am.Currency()].Key(), stv)
+		sts[i+2] = NewBalanceStateMergeValue(opp.nb[am.Currency()].Key(), stv)
 	}
 
 	return sts, nil
@@ -154,6 +159,7 @@ func (opp *CreateContractAccountsItemProcessor) Close() error {
 	opp.ns = nil
 	opp.nb = nil
 	opp.sender = nil
+	opp.target = nil
 	opp.oas = nil
 	opp.oac = mitumcurrency.Account{}
 
@@ -234,7 +240,9 @@ func (opp *CreateContractAccountsProcessor) Process( // nolint:dupl
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	sb, err := CheckEnoughBalance(fact.sender, required, getStateFunc)
+	fees := RequiredFees(required)
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, required, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
 	}
@@ -275,8 +283,14 @@ func (opp *CreateContractAccountsProcessor) Process( // nolint:dupl
 			return nil, nil, e(nil, "expected BalanceStateValue, not %T", sb[i].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(sb[i].Key(), stv))
+		sts = append(sts, NewBalanceStateMergeValue(sb[i].Key(), stv))
+	}
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
 	}
+	sts = append(sts, fsts...)
 
 	return sts, nil, nil
 }
@@ -298,5 +312,5 @@ func (opp *CreateContractAccountsProcessor) calculateItemsFee(op base.Operation,
 		items[i] = fact.items[i]
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	return CalculateItemsFee(getStateFunc, factHint(op.Fact()), items)
 }
@@ -14,9 +14,9 @@ type CreateContractAccountsItemSingleAmount struct {
 	BaseCreateContractAccountsItem
 }
 
-func NewCreateContractAccountsItemSingleAmount(keys mitumcurrency.AccountKeys, amount mitumcurrency.Amount, addrType hint.Type) CreateContractAccountsItemSingleAmount {
+func NewCreateContractAccountsItemSingleAmount(keys mitumcurrency.AccountKeys, amount mitumcurrency.Amount, addrType hint.Type, salt string) CreateContractAccountsItemSingleAmount {
 	return CreateContractAccountsItemSingleAmount{
-		BaseCreateContractAccountsItem: NewBaseCreateContractAccountsItem(CreateContractAccountsItemSingleAmountHint, keys, []mitumcurrency.Amount{amount}, addrType),
+		BaseCreateContractAccountsItem: NewBaseCreateContractAccountsItem(CreateContractAccountsItemSingleAmountHint, keys, []mitumcurrency.Amount{amount}, addrType, salt),
 	}
 }
 
@@ -0,0 +1,141 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CreateEscrowFactHint = hint.MustNewHint("mitum-currency-create-escrow-operation-fact-v0.0.1")
+	CreateEscrowHint     = hint.MustNewHint("mitum-currency-create-escrow-operation-v0.0.1")
+)
+
+type CreateEscrowFact struct {
+	base.BaseFact
+	sender  base.Address
+	payee   base.Address
+	arbiter base.Address
+	amounts []mitumcurrency.Amount
+	expiry  base.Height
+}
+
+func NewCreateEscrowFact(
+	token []byte,
+	sender, payee, arbiter base.Address,
+	amounts []mitumcurrency.Amount,
+	expiry base.Height,
+) CreateEscrowFact {
+	bf := base.NewBaseFact(CreateEscrowFactHint, token)
+	fact := CreateEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		payee:    payee,
+		arbiter:  arbiter,
+		amounts:  amounts,
+		expiry:   expiry,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CreateEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CreateEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CreateEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CreateEscrowFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.amounts))
+	for i := range fact.amounts {
+		bs[i] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.payee.Bytes(),
+		fact.arbiter.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.expiry.Bytes(),
+	)
+}
+
+func (fact CreateEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.payee, fact.arbiter, fact.expiry); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.payee) {
+		return util.ErrInvalid.Errorf("payee is same with sender, %q", fact.sender)
+	}
+
+	if fact.arbiter.Equal(fact.sender) || fact.arbiter.Equal(fact.payee) {
+		return util.ErrInvalid.Errorf("arbiter should be neither sender nor payee, %q", fact.arbiter)
+	}
+
+	return isValidEscrowAmounts(fact.amounts)
+}
+
+func (fact CreateEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CreateEscrowFact) Payee() base.Address {
+	return fact.payee
+}
+
+func (fact CreateEscrowFact) Arbiter() base.Address {
+	return fact.arbiter
+}
+
+func (fact CreateEscrowFact) Amounts() []mitumcurrency.Amount {
+	return fact.amounts
+}
+
+func (fact CreateEscrowFact) Expiry() base.Height {
+	return fact.expiry
+}
+
+func (fact CreateEscrowFact) Escrow() Escrow {
+	return NewEscrow(fact.Hash(), fact.sender, fact.payee, fact.arbiter, fact.amounts, fact.expiry)
+}
+
+func (fact CreateEscrowFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.payee, fact.arbiter}, nil
+}
+
+// CreateEscrow moves the amounts of sender into the escrow state, not to the
+// contract account; the escrow is resolved by ReleaseEscrow or RefundEscrow.
+type CreateEscrow struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewCreateEscrow(fact CreateEscrowFact) (CreateEscrow, error) {
+	return CreateEscrow{BaseOperation: mitumcurrency.NewBaseOperation(CreateEscrowHint, fact)}, nil
+}
+
+func (op *CreateEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
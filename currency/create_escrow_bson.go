@@ -0,0 +1,84 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact CreateEscrowFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   fact.Hint().String(),
+			"sender":  fact.sender,
+			"payee":   fact.payee,
+			"arbiter": fact.arbiter,
+			"amounts": fact.amounts,
+			"expiry":  fact.expiry,
+			"hash":    fact.BaseFact.Hash().String(),
+			"token":   fact.BaseFact.Token(),
+		},
+	)
+}
+
+type CreateEscrowFactBSONUnmarshaler struct {
+	Hint    string      `bson:"_hint"`
+	Sender  string      `bson:"sender"`
+	Payee   string      `bson:"payee"`
+	Arbiter string      `bson:"arbiter"`
+	Amounts bson.Raw    `bson:"amounts"`
+	Expiry  base.Height `bson:"expiry"`
+}
+
+func (fact *CreateEscrowFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CreateEscrowFact")
+
+	var ubf mitumcurrency.BaseFactBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf CreateEscrowFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Payee, uf.Arbiter, uf.Amounts, uf.Expiry)
+}
+
+func (op CreateEscrow) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *CreateEscrow) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CreateEscrow")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
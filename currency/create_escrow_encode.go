@@ -0,0 +1,57 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CreateEscrowFact) unpack(
+	enc encoder.Encoder,
+	sd, pe, ar string,
+	bam []byte,
+	expiry base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CreateEscrowFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(pe, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.payee = a
+	}
+
+	switch a, err := base.DecodeAddress(ar, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.arbiter = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+	fact.amounts = amounts
+	fact.expiry = expiry
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CreateEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender  base.Address           `json:"sender"`
+	Payee   base.Address           `json:"payee"`
+	Arbiter base.Address           `json:"arbiter"`
+	Amounts []mitumcurrency.Amount `json:"amounts"`
+	Expiry  base.Height            `json:"expiry"`
+}
+
+func (fact CreateEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CreateEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Payee:                 fact.payee,
+		Arbiter:               fact.arbiter,
+		Amounts:               fact.amounts,
+		Expiry:                fact.expiry,
+	})
+}
+
+type CreateEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender  string          `json:"sender"`
+	Payee   string          `json:"payee"`
+	Arbiter string          `json:"arbiter"`
+	Amounts json.RawMessage `json:"amounts"`
+	Expiry  base.Height     `json:"expiry"`
+}
+
+func (fact *CreateEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CreateEscrowFact")
+
+	var uf CreateEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Payee, uf.Arbiter, uf.Amounts, uf.Expiry)
+}
+
+type createEscrowMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op CreateEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(createEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CreateEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CreateEscrow")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var createEscrowProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CreateEscrowProcessor)
+	},
+}
+
+func (CreateEscrow) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type CreateEscrowProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewCreateEscrowProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new CreateEscrowProcessor")
+
+		nopp := createEscrowProcessorPool.Get()
+		opp, ok := nopp.(*CreateEscrowProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected CreateEscrowProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *CreateEscrowProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess CreateEscrow")
+
+	fact, ok := op.Fact().(CreateEscrowFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected CreateEscrowFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot create escrow, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.payee), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("payee not found, %q: %w", fact.payee, err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.arbiter), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("arbiter not found, %q: %w", fact.arbiter, err), nil
+	}
+
+	if fact.expiry <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"expiry should be over current height, %d <= %d", fact.expiry, opp.Height()), nil
+	}
+
+	for i := range fact.amounts {
+		cid := fact.amounts[i].Currency()
+		if _, err := existsActiveCurrencyPolicy(cid, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err), nil
+		}
+	}
+
+	if err := checkNotExistsState(StateKeyEscrow(fact.Hash()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("escrow already exists, %q: %w", fact.Hash(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CreateEscrowProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process CreateEscrow")
+
+	fact, ok := op.Fact().(CreateEscrowFact)
+	if !ok {
+		return nil, nil, e(nil, "expected CreateEscrowFact, not %T", op.Fact())
+	}
+
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), []mitumcurrency.AmountsItem{fact})
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fees := RequiredFees(required)
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewEscrowStateMergeValue(StateKeyEscrow(fact.Hash()), NewEscrowStateValue(fact.Escrow())),
+	}
+
+	for cid := range required {
+		v, ok := sb[cid].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[cid].Value()), nil
+		}
+
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[cid][0])))
+		sts = append(sts, NewBalanceStateMergeValue(sb[cid].Key(), stv))
+	}
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *CreateEscrowProcessor) Close() error {
+	createEscrowProcessorPool.Put(opp)
+
+	return nil
+}
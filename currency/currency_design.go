@@ -18,6 +18,9 @@ type CurrencyDesign struct {
 	genesisAccount base.Address
 	policy         CurrencyPolicy
 	aggregate      mitumcurrency.Big
+	burned         mitumcurrency.Big
+	maxSupply      mitumcurrency.Big
+	metadata       CurrencyMetadata
 }
 
 func NewCurrencyDesign(amount mitumcurrency.Amount, genesisAccount base.Address, po CurrencyPolicy) CurrencyDesign {
@@ -27,6 +30,8 @@ func NewCurrencyDesign(amount mitumcurrency.Amount, genesisAccount base.Address,
 		genesisAccount: genesisAccount,
 		policy:         po,
 		aggregate:      amount.Big(),
+		burned:         mitumcurrency.ZeroBig,
+		maxSupply:      mitumcurrency.ZeroBig,
 	}
 }
 
@@ -44,6 +49,19 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("currency balance should be over zero")
 	case !de.aggregate.OverZero():
 		return util.ErrInvalid.Errorf("aggregate should be over zero")
+	case de.burned.Int != nil && !de.burned.OverNil():
+		return util.ErrInvalid.Errorf("burned should not be under zero")
+	case de.maxSupply.Int != nil && !de.maxSupply.OverNil():
+		return util.ErrInvalid.Errorf("max supply should not be under zero")
+	}
+
+	if de.HasMaxSupply() {
+		switch {
+		case de.amount.Big().Compare(de.maxSupply) > 0:
+			return util.ErrInvalid.Errorf("currency balance over max supply, %v > %v", de.amount.Big(), de.maxSupply)
+		case de.aggregate.Compare(de.maxSupply) > 0:
+			return util.ErrInvalid.Errorf("aggregate over max supply, %v > %v", de.aggregate, de.maxSupply)
+		}
 	}
 
 	if de.genesisAccount != nil {
@@ -56,6 +74,12 @@ func (de CurrencyDesign) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid CurrencyPolicy: %w", err)
 	}
 
+	if de.HasMetadata() {
+		if err := de.metadata.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid CurrencyMetadata: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -65,11 +89,29 @@ func (de CurrencyDesign) Bytes() []byte {
 		gb = de.genesisAccount.Bytes()
 	}
 
+	var bb []byte
+	if de.burned.OverZero() {
+		bb = de.burned.Bytes()
+	}
+
+	var mb []byte
+	if de.HasMaxSupply() {
+		mb = de.maxSupply.Bytes()
+	}
+
+	var mdb []byte
+	if de.HasMetadata() {
+		mdb = de.metadata.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		de.amount.Bytes(),
 		gb,
 		de.policy.Bytes(),
 		de.aggregate.Bytes(),
+		bb,
+		mb,
+		mdb,
 	)
 }
 
@@ -104,7 +146,75 @@ func (de CurrencyDesign) AddAggregate(b mitumcurrency.Big) (CurrencyDesign, erro
 		return de, errors.Errorf("new aggregate not over zero")
 	}
 
-	de.aggregate = de.aggregate.Add(b)
+	ag := de.aggregate.Add(b)
+	if de.HasMaxSupply() && ag.Compare(de.maxSupply) > 0 {
+		return de, errors.Errorf("new aggregate over max supply, %v > %v", ag, de.maxSupply)
+	}
+
+	de.aggregate = ag
+
+	return de, nil
+}
+
+// SubAggregate decreases the aggregate by the burned amount and keeps the
+// total of burned amounts.
+func (de CurrencyDesign) SubAggregate(b mitumcurrency.Big) (CurrencyDesign, error) {
+	if !b.OverZero() {
+		return de, errors.Errorf("burn amount not over zero")
+	}
+
+	if de.aggregate.Compare(b) <= 0 {
+		return de, errors.Errorf("aggregate not enough to burn, %v <= %v", de.aggregate, b)
+	}
+
+	de.aggregate = de.aggregate.Sub(b)
+	de.burned = de.Burned().Add(b)
 
 	return de, nil
 }
+
+func (de CurrencyDesign) Burned() mitumcurrency.Big {
+	if de.burned.Int == nil {
+		return mitumcurrency.ZeroBig
+	}
+
+	return de.burned
+}
+
+// MaxSupply returns the upper bound of aggregate; zero means no limit.
+func (de CurrencyDesign) MaxSupply() mitumcurrency.Big {
+	if de.maxSupply.Int == nil {
+		return mitumcurrency.ZeroBig
+	}
+
+	return de.maxSupply
+}
+
+func (de CurrencyDesign) HasMaxSupply() bool {
+	return de.maxSupply.OverZero()
+}
+
+func (de CurrencyDesign) SetMaxSupply(b mitumcurrency.Big) CurrencyDesign {
+	de.maxSupply = b
+
+	return de
+}
+
+func (de CurrencyDesign) Metadata() CurrencyMetadata {
+	return de.metadata
+}
+
+func (de CurrencyDesign) HasMetadata() bool {
+	return !de.metadata.IsEmpty()
+}
+
+// SetMetadata replaces the metadata of currency; empty metadata removes it.
+func (de CurrencyDesign) SetMetadata(md CurrencyMetadata) CurrencyDesign {
+	if md.IsEmpty() {
+		md = CurrencyMetadata{}
+	}
+
+	de.metadata = md
+
+	return de
+}
@@ -9,14 +9,24 @@ import (
 )
 
 func (de CurrencyDesign) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":           de.Hint().String(),
-			"amount":          de.amount,
-			"genesis_account": de.genesisAccount,
-			"policy":          de.policy,
-			"aggregate":       de.aggregate.String(),
-		})
+	m := bson.M{
+		"_hint":           de.Hint().String(),
+		"amount":          de.amount,
+		"genesis_account": de.genesisAccount,
+		"policy":          de.policy,
+		"aggregate":       de.aggregate.String(),
+		"burned":          de.Burned().String(),
+	}
+
+	if de.HasMaxSupply() {
+		m["max_supply"] = de.maxSupply.String()
+	}
+
+	if de.HasMetadata() {
+		m["metadata"] = de.metadata
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CurrencyDesignBSONUnmarshaler struct {
@@ -25,6 +35,9 @@ type CurrencyDesignBSONUnmarshaler struct {
 	GenesisAccount string   `bson:"genesis_account"`
 	Policy         bson.Raw `bson:"policy"`
 	Aggregate      string   `bson:"aggregate"`
+	Burned         string   `bson:"burned"`
+	MaxSupply      string   `bson:"max_supply"`
+	Metadata       bson.Raw `bson:"metadata,omitempty"`
 }
 
 func (de *CurrencyDesign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -40,5 +53,5 @@ func (de *CurrencyDesign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return de.unpack(enc, ht, ude.Amount, ude.GenesisAccount, ude.Policy, ude.Aggregate)
+	return de.unpack(enc, ht, ude.Amount, ude.GenesisAccount, ude.Policy, ude.Aggregate, ude.Burned, ude.MaxSupply, ude.Metadata)
 }
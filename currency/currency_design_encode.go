@@ -8,7 +8,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (de *CurrencyDesign) unpack(enc encoder.Encoder, ht hint.Hint, bam []byte, ga string, bpo []byte, ag string) error {
+func (de *CurrencyDesign) unpack(enc encoder.Encoder, ht hint.Hint, bam []byte, ga string, bpo []byte, ag, bd, ms string, bmd []byte) error {
 	e := util.StringErrorFunc("failed to unmarshal CurrencyDesign")
 
 	de.BaseHinter = hint.NewBaseHinter(ht)
@@ -41,5 +41,32 @@ func (de *CurrencyDesign) unpack(enc encoder.Encoder, ht hint.Hint, bam []byte,
 		de.aggregate = big
 	}
 
+	de.burned = mitumcurrency.ZeroBig
+	if len(bd) > 0 {
+		big, err := mitumcurrency.NewBigFromString(bd)
+		if err != nil {
+			return e(err, "")
+		}
+		de.burned = big
+	}
+
+	de.maxSupply = mitumcurrency.ZeroBig
+	if len(ms) > 0 {
+		big, err := mitumcurrency.NewBigFromString(ms)
+		if err != nil {
+			return e(err, "")
+		}
+		de.maxSupply = big
+	}
+
+	de.metadata = CurrencyMetadata{}
+	if len(bmd) > 0 {
+		var md CurrencyMetadata
+		if err := encoder.Decode(enc, bmd, &md); err != nil {
+			return e(err, "failed to decode currency metadata")
+		}
+		de.metadata = md
+	}
+
 	return nil
 }
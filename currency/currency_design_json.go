@@ -16,15 +16,31 @@ type CurrencyDesignJSONMarshaler struct {
 	GenesisAccount base.Address         `json:"genesis_account"`
 	Policy         CurrencyPolicy       `json:"policy"`
 	Aggregate      string               `json:"aggregate"`
+	Burned         string               `json:"burned"`
+	MaxSupply      string               `json:"max_supply,omitempty"`
+	Metadata       *CurrencyMetadata    `json:"metadata,omitempty"`
 }
 
 func (de CurrencyDesign) MarshalJSON() ([]byte, error) {
+	var maxSupply string
+	if de.HasMaxSupply() {
+		maxSupply = de.maxSupply.String()
+	}
+
+	var metadata *CurrencyMetadata
+	if de.HasMetadata() {
+		metadata = &de.metadata
+	}
+
 	return util.MarshalJSON(CurrencyDesignJSONMarshaler{
 		BaseHinter:     de.BaseHinter,
 		Amount:         de.amount,
 		GenesisAccount: de.genesisAccount,
 		Policy:         de.policy,
 		Aggregate:      de.aggregate.String(),
+		Burned:         de.Burned().String(),
+		MaxSupply:      maxSupply,
+		Metadata:       metadata,
 	})
 }
 
@@ -34,6 +50,9 @@ type CurrencyDesignJSONUnmarshaler struct {
 	GenesisAccount string          `json:"genesis_account"`
 	Policy         json.RawMessage `json:"policy"`
 	Aggregate      string          `json:"aggregate"`
+	Burned         string          `json:"burned"`
+	MaxSupply      string          `json:"max_supply"`
+	Metadata       json.RawMessage `json:"metadata"`
 }
 
 func (de *CurrencyDesign) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -44,5 +63,5 @@ func (de *CurrencyDesign) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return de.unpack(enc, ude.Hint, ude.Amount, ude.GenesisAccount, ude.Policy, ude.Aggregate)
+	return de.unpack(enc, ude.Hint, ude.Amount, ude.GenesisAccount, ude.Policy, ude.Aggregate, ude.Burned, ude.MaxSupply, ude.Metadata)
 }
@@ -0,0 +1,216 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testMaxSupply struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	node     base.Address
+	npriv    base.Privatekey
+	receiver base.Address
+}
+
+func (t *testMaxSupply) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.states.set(
+		StateKeyCurrencyDesign(t.cid),
+		NewCurrencyDesignStateValue(de.SetMaxSupply(de.Aggregate().Add(mitumcurrency.NewBig(100)))),
+	)
+
+	t.node, t.npriv = t.states.setSuffrage()
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	t.receiver = receiver
+}
+
+func (t *testMaxSupply) inflation(big int64) mitumcurrency.SuffrageInflation {
+	op, err := mitumcurrency.NewSuffrageInflation(mitumcurrency.NewSuffrageInflationFact(
+		util.UUID().Bytes(),
+		[]mitumcurrency.SuffrageInflationItem{
+			mitumcurrency.NewSuffrageInflationItem(t.receiver, mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)),
+		},
+	))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	return op
+}
+
+func (t *testMaxSupply) TestInflationUnderMaxSupply() {
+	t.Nil(t.process(NewSuffrageInflationProcessor(base.Threshold(100)), t.inflation(100), t.states))
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(de.MaxSupply(), de.Aggregate())
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testMaxSupply) TestInflationOverMaxSupply() {
+	reason := t.process(NewSuffrageInflationProcessor(base.Threshold(100)), t.inflation(101), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "new aggregate over max supply")
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1000000), de.Aggregate())
+	t.Equal(mitumcurrency.ZeroBig, t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testMaxSupply) TestInflationsInSameProposal() {
+	opr := NewOperationProcessor()
+	opr.GetStateFunc = t.states.getStateFunc
+
+	// NOTE each inflation is under max supply, but both are over max supply
+	t.NoError(opr.checkDuplication(t.inflation(60)))
+
+	err := opr.checkDuplication(t.inflation(60))
+	t.Error(err)
+	t.ErrorContains(err, "duplicate currency id")
+
+	t.Run("with mint", func() {
+		mint, err := NewMint(NewMintFact(
+			util.UUID().Bytes(), base.RandomAddress(""), t.receiver, mitumcurrency.NewAmount(mitumcurrency.NewBig(60), t.cid)))
+		t.NoError(err)
+
+		err = opr.checkDuplication(mint)
+		t.Error(err)
+		t.ErrorContains(err, "duplicate currency id")
+	})
+}
+
+func (t *testMaxSupply) TestInvalidDesign() {
+	de := NewCurrencyDesign(
+		mitumcurrency.NewAmount(mitumcurrency.NewBig(100), t.cid),
+		base.RandomAddress(""),
+		newTestCurrencyPolicy(),
+	).SetMaxSupply(mitumcurrency.NewBig(99))
+
+	err := de.IsValid(nil)
+	t.Error(err)
+	t.ErrorContains(err, "currency balance over max supply")
+}
+
+func TestMaxSupply(t *testing.T) {
+	suite.Run(t, new(testMaxSupply))
+}
+
+func newTestCurrencyEncoder(t *encoder.BaseTestEncode) *jsonenc.Encoder {
+	enc := jsonenc.NewEncoder()
+
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: NilFeeerHint, Instance: NilFeeer{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: FixedFeeerHint, Instance: FixedFeeer{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: RatioFeeerHint, Instance: RatioFeeer{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyPolicyHint, Instance: CurrencyPolicy{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyDesignHint, Instance: CurrencyDesign{}}))
+
+	return enc
+}
+
+func TestCurrencyDesignEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+
+	t.Encode = func() (interface{}, []byte) {
+		de := NewCurrencyDesign(
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(100), mitumcurrency.CurrencyID("SHOWME")),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			newTestCurrencyPolicy(),
+		).SetMaxSupply(mitumcurrency.NewBig(200))
+
+		de, err := de.SubAggregate(mitumcurrency.NewBig(10))
+		t.NoError(err)
+		t.NoError(de.IsValid(nil))
+
+		b, err := enc.Marshal(de)
+		t.NoError(err)
+
+		return de, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyDesign)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		ad, ok := a.(CurrencyDesign)
+		t.True(ok)
+		bd, ok := b.(CurrencyDesign)
+		t.True(ok)
+
+		t.NoError(bd.IsValid(nil))
+		t.Equal(ad.Bytes(), bd.Bytes())
+		t.Equal(ad.Aggregate(), bd.Aggregate())
+		t.Equal(ad.Burned(), bd.Burned())
+		t.Equal(ad.MaxSupply(), bd.MaxSupply())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestCurrencyDesignWithoutMaxSupplyEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+
+	t.Encode = func() (interface{}, []byte) {
+		de := NewCurrencyDesign(
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(100), mitumcurrency.CurrencyID("SHOWME")),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			newTestCurrencyPolicy(),
+		)
+		t.NoError(de.IsValid(nil))
+
+		b, err := enc.Marshal(de)
+		t.NoError(err)
+
+		return de, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyDesign)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		ad, ok := a.(CurrencyDesign)
+		t.True(ok)
+		bd, ok := b.(CurrencyDesign)
+		t.True(ok)
+
+		t.NoError(bd.IsValid(nil))
+		t.Equal(ad.Bytes(), bd.Bytes())
+		t.False(bd.HasMaxSupply())
+	}
+
+	suite.Run(tt, t)
+}
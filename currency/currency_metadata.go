@@ -0,0 +1,118 @@
+package currency // nolint: dupl, revive
+
+import (
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var CurrencyMetadataHint = hint.MustNewHint("mitum-currency-currency-metadata-v0.0.1")
+
+var (
+	MaxCurrencyDecimals          uint = 36
+	MaxCurrencyNameLength             = 64
+	MaxCurrencySymbolLength           = 16
+	MaxCurrencyMetadataURLLength      = 256
+)
+
+// CurrencyMetadata is the informational description of currency for wallets
+// and explorers; it does not affect the amounts, which are always in the
+// smallest unit of currency.
+type CurrencyMetadata struct {
+	hint.BaseHinter
+	decimals uint
+	name     string
+	symbol   string
+	url      string
+}
+
+func NewCurrencyMetadata(decimals uint, name, symbol, u string) CurrencyMetadata {
+	return CurrencyMetadata{
+		BaseHinter: hint.NewBaseHinter(CurrencyMetadataHint),
+		decimals:   decimals,
+		name:       name,
+		symbol:     symbol,
+		url:        u,
+	}
+}
+
+func (md CurrencyMetadata) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.UintToBytes(md.decimals),
+		[]byte(md.name),
+		[]byte(md.symbol),
+		[]byte(md.url),
+	)
+}
+
+func (md CurrencyMetadata) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, md.BaseHinter); err != nil {
+		return util.ErrInvalid.Errorf("invalid currency metadata: %w", err)
+	}
+
+	if md.decimals > MaxCurrencyDecimals {
+		return util.ErrInvalid.Errorf("decimals, %d over max, %d", md.decimals, MaxCurrencyDecimals)
+	}
+
+	if err := isValidCurrencyMetadataString("name", md.name, MaxCurrencyNameLength); err != nil {
+		return err
+	}
+
+	if err := isValidCurrencyMetadataString("symbol", md.symbol, MaxCurrencySymbolLength); err != nil {
+		return err
+	}
+
+	if err := isValidCurrencyMetadataString("url", md.url, MaxCurrencyMetadataURLLength); err != nil {
+		return err
+	}
+
+	if len(md.url) > 0 {
+		u, err := url.ParseRequestURI(md.url)
+		if err != nil {
+			return util.ErrInvalid.Errorf("invalid url of currency metadata, %q: %w", md.url, err)
+		}
+
+		switch u.Scheme {
+		case "http", "https":
+		default:
+			return util.ErrInvalid.Errorf("url of currency metadata should be http or https, %q", md.url)
+		}
+	}
+
+	return nil
+}
+
+func isValidCurrencyMetadataString(name, s string, max int) error {
+	switch n := len(s); {
+	case n > max:
+		return util.ErrInvalid.Errorf("%s of currency metadata, %d over max, %d", name, n, max)
+	case !utf8.ValidString(s):
+		return util.ErrInvalid.Errorf("%s of currency metadata should be valid utf-8", name)
+	default:
+		return nil
+	}
+}
+
+func (md CurrencyMetadata) Decimals() uint {
+	return md.decimals
+}
+
+func (md CurrencyMetadata) Name() string {
+	return md.name
+}
+
+func (md CurrencyMetadata) Symbol() string {
+	return md.symbol
+}
+
+func (md CurrencyMetadata) URL() string {
+	return md.url
+}
+
+// IsEmpty returns true if metadata has nothing; empty metadata is not kept in
+// CurrencyDesign.
+func (md CurrencyMetadata) IsEmpty() bool {
+	return md.decimals == 0 && len(md.name) < 1 && len(md.symbol) < 1 && len(md.url) < 1
+}
@@ -0,0 +1,44 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (md CurrencyMetadata) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    md.Hint().String(),
+			"decimals": md.decimals,
+			"name":     md.name,
+			"symbol":   md.symbol,
+			"url":      md.url,
+		},
+	)
+}
+
+type CurrencyMetadataBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Decimals uint   `bson:"decimals"`
+	Name     string `bson:"name"`
+	Symbol   string `bson:"symbol"`
+	URL      string `bson:"url"`
+}
+
+func (md *CurrencyMetadata) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CurrencyMetadata")
+
+	var umd CurrencyMetadataBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &umd); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(umd.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return md.unpack(enc, ht, umd.Decimals, umd.Name, umd.Symbol, umd.URL)
+}
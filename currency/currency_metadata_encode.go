@@ -0,0 +1,21 @@
+package currency // nolint: dupl, revive
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (md *CurrencyMetadata) unpack(
+	_ encoder.Encoder,
+	ht hint.Hint,
+	decimals uint,
+	name, symbol, u string,
+) error {
+	md.BaseHinter = hint.NewBaseHinter(ht)
+	md.decimals = decimals
+	md.name = name
+	md.symbol = symbol
+	md.url = u
+
+	return nil
+}
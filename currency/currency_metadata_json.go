@@ -0,0 +1,44 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CurrencyMetadataJSONMarshaler struct {
+	hint.BaseHinter
+	Decimals uint   `json:"decimals"`
+	Name     string `json:"name,omitempty"`
+	Symbol   string `json:"symbol,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+func (md CurrencyMetadata) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CurrencyMetadataJSONMarshaler{
+		BaseHinter: md.BaseHinter,
+		Decimals:   md.decimals,
+		Name:       md.name,
+		Symbol:     md.symbol,
+		URL:        md.url,
+	})
+}
+
+type CurrencyMetadataJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Decimals uint      `json:"decimals"`
+	Name     string    `json:"name"`
+	Symbol   string    `json:"symbol"`
+	URL      string    `json:"url"`
+}
+
+func (md *CurrencyMetadata) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CurrencyMetadata")
+
+	var umd CurrencyMetadataJSONUnmarshaler
+	if err := enc.Unmarshal(b, &umd); err != nil {
+		return e(err, "")
+	}
+
+	return md.unpack(enc, umd.Hint, umd.Decimals, umd.Name, umd.Symbol, umd.URL)
+}
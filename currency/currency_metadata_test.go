@@ -0,0 +1,250 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testCurrencyMetadata struct {
+	testProcessorSuite
+	cid    mitumcurrency.CurrencyID
+	states *testStates
+	node   base.Address
+	npriv  base.Privatekey
+}
+
+func (t *testCurrencyMetadata) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	t.node, t.npriv = t.states.setSuffrage()
+}
+
+func (t *testCurrencyMetadata) register(md CurrencyMetadata) {
+	genesis, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+
+	de := NewCurrencyDesign(
+		mitumcurrency.NewAmount(mitumcurrency.NewBig(100), t.cid),
+		genesis,
+		newTestCurrencyPolicy(),
+	).SetMetadata(md)
+
+	op, err := NewCurrencyRegister(NewCurrencyRegisterFact(util.UUID().Bytes(), de))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+	t.NoError(op.IsValid(t.networkID))
+
+	t.Nil(t.process(NewCurrencyRegisterProcessor(base.Threshold(100)), op, t.states))
+}
+
+func (t *testCurrencyMetadata) updater(cid mitumcurrency.CurrencyID, md CurrencyMetadata) CurrencyMetadataUpdater {
+	op, err := NewCurrencyMetadataUpdater(NewCurrencyMetadataUpdaterFact(util.UUID().Bytes(), cid, md))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	return op
+}
+
+func (t *testCurrencyMetadata) metadata() CurrencyMetadata {
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+
+	return de.Metadata()
+}
+
+func (t *testCurrencyMetadata) TestRegister() {
+	md := NewCurrencyMetadata(8, "Show Me", "SHM", "https://showme.org")
+	t.NoError(md.IsValid(nil))
+
+	t.register(md)
+
+	t.Equal(md.Bytes(), t.metadata().Bytes())
+	t.Equal(uint(8), t.metadata().Decimals())
+	t.Equal("SHM", t.metadata().Symbol())
+}
+
+func (t *testCurrencyMetadata) TestUpdate() {
+	t.register(CurrencyMetadata{})
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.False(de.HasMetadata())
+
+	md := NewCurrencyMetadata(18, "Show Me", "SHM", "")
+	t.Nil(t.process(NewCurrencyMetadataUpdaterProcessor(base.Threshold(100)), t.updater(t.cid, md), t.states))
+
+	t.Equal(md.Bytes(), t.metadata().Bytes())
+
+	t.Run("remove by empty metadata", func() {
+		t.Nil(t.process(
+			NewCurrencyMetadataUpdaterProcessor(base.Threshold(100)),
+			t.updater(t.cid, NewCurrencyMetadata(0, "", "", "")),
+			t.states,
+		))
+
+		de, err := t.states.currencyDesign(t.cid)
+		t.NoError(err)
+		t.False(de.HasMetadata())
+	})
+}
+
+func (t *testCurrencyMetadata) TestUnknownCurrency() {
+	t.register(CurrencyMetadata{})
+
+	reason := t.preProcess(
+		NewCurrencyMetadataUpdaterProcessor(base.Threshold(100)),
+		t.updater(mitumcurrency.CurrencyID("FINDME"), NewCurrencyMetadata(8, "", "", "")),
+		t.states,
+	)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "currency not found")
+}
+
+func (t *testCurrencyMetadata) TestNotSignedBySuffrage() {
+	t.register(CurrencyMetadata{})
+
+	op, err := NewCurrencyMetadataUpdater(NewCurrencyMetadataUpdaterFact(
+		util.UUID().Bytes(), t.cid, NewCurrencyMetadata(8, "", "", "")))
+	t.NoError(err)
+	t.NoError(op.NodeSign(base.NewMPrivatekey(), t.networkID, base.RandomAddress("")))
+
+	reason := t.preProcess(NewCurrencyMetadataUpdaterProcessor(base.Threshold(100)), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not enough signs")
+
+	t.True(t.metadata().IsEmpty())
+}
+
+func (t *testCurrencyMetadata) TestInvalid() {
+	t.Run("decimals over max", func() {
+		err := NewCurrencyMetadata(MaxCurrencyDecimals+1, "", "", "").IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "decimals")
+	})
+
+	t.Run("symbol over max", func() {
+		err := NewCurrencyMetadata(8, "", strings.Repeat("a", MaxCurrencySymbolLength+1), "").IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "symbol of currency metadata")
+	})
+
+	t.Run("invalid utf-8 name", func() {
+		err := NewCurrencyMetadata(8, string([]byte{0xff, 0xfe}), "", "").IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "name of currency metadata should be valid utf-8")
+	})
+
+	t.Run("not http url", func() {
+		err := NewCurrencyMetadata(8, "", "", "ftp://showme.org").IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "should be http or https")
+	})
+
+	t.Run("invalid metadata in fact", func() {
+		fact := NewCurrencyMetadataUpdaterFact(util.UUID().Bytes(), t.cid, NewCurrencyMetadata(8, "", "", "showme"))
+
+		err := fact.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "invalid url of currency metadata")
+	})
+}
+
+func TestCurrencyMetadata(t *testing.T) {
+	suite.Run(t, new(testCurrencyMetadata))
+}
+
+func TestCurrencyDesignWithMetadataEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyMetadataHint, Instance: CurrencyMetadata{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		de := NewCurrencyDesign(
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(100), mitumcurrency.CurrencyID("SHOWME")),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			newTestCurrencyPolicy(),
+		).SetMetadata(NewCurrencyMetadata(8, "Show Me", "SHM", "https://showme.org"))
+		t.NoError(de.IsValid(nil))
+
+		b, err := enc.Marshal(de)
+		t.NoError(err)
+
+		return de, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyDesign)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		ad, ok := a.(CurrencyDesign)
+		t.True(ok)
+		bd, ok := b.(CurrencyDesign)
+		t.True(ok)
+
+		t.NoError(bd.IsValid(nil))
+		t.Equal(ad.Bytes(), bd.Bytes())
+		t.True(bd.HasMetadata())
+		t.Equal(ad.Metadata().Bytes(), bd.Metadata().Bytes())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestCurrencyMetadataUpdaterFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyMetadataHint, Instance: CurrencyMetadata{}}))
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyMetadataUpdaterFactHint, Instance: CurrencyMetadataUpdaterFact{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		fact := NewCurrencyMetadataUpdaterFact(
+			util.UUID().Bytes(),
+			mitumcurrency.CurrencyID("SHOWME"),
+			NewCurrencyMetadata(8, "Show Me", "SHM", "https://showme.org"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyMetadataUpdaterFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(CurrencyMetadataUpdaterFact)
+		t.True(ok)
+		bf, ok := b.(CurrencyMetadataUpdaterFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Metadata().Bytes(), bf.Metadata().Bytes())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,82 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CurrencyMetadataUpdaterFactHint = hint.MustNewHint("mitum-currency-currency-metadata-updater-operation-fact-v0.0.1")
+	CurrencyMetadataUpdaterHint     = hint.MustNewHint("mitum-currency-currency-metadata-updater-operation-v0.0.1")
+)
+
+type CurrencyMetadataUpdaterFact struct {
+	base.BaseFact
+	currency mitumcurrency.CurrencyID
+	metadata CurrencyMetadata
+}
+
+func NewCurrencyMetadataUpdaterFact(token []byte, currency mitumcurrency.CurrencyID, metadata CurrencyMetadata) CurrencyMetadataUpdaterFact {
+	fact := CurrencyMetadataUpdaterFact{
+		BaseFact: base.NewBaseFact(CurrencyMetadataUpdaterFactHint, token),
+		currency: currency,
+		metadata: metadata,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CurrencyMetadataUpdaterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CurrencyMetadataUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.currency.Bytes(),
+		fact.metadata.Bytes(),
+	)
+}
+
+func (fact CurrencyMetadataUpdaterFact) IsValid(b []byte) error {
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.currency, fact.metadata); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact CurrencyMetadataUpdaterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CurrencyMetadataUpdaterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CurrencyMetadataUpdaterFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+// Metadata returns the new metadata of currency; empty metadata removes the
+// metadata from currency design.
+func (fact CurrencyMetadataUpdaterFact) Metadata() CurrencyMetadata {
+	return fact.metadata
+}
+
+type CurrencyMetadataUpdater struct {
+	mitumcurrency.BaseNodeOperation
+}
+
+func NewCurrencyMetadataUpdater(fact CurrencyMetadataUpdaterFact) (CurrencyMetadataUpdater, error) {
+	return CurrencyMetadataUpdater{BaseNodeOperation: mitumcurrency.NewBaseNodeOperation(CurrencyMetadataUpdaterHint, fact)}, nil
+}
@@ -0,0 +1,23 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *CurrencyMetadataUpdaterFact) unpack(enc encoder.Encoder, cid string, bmd []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal CurrencyMetadataUpdaterFact")
+
+	if hinter, err := enc.Decode(bmd); err != nil {
+		return e(err, "")
+	} else if md, ok := hinter.(CurrencyMetadata); !ok {
+		return e(util.ErrWrongType.Errorf("expected CurrencyMetadata, not %T", hinter), "")
+	} else {
+		fact.metadata = md
+	}
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type CurrencyMetadataUpdaterFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+	Metadata CurrencyMetadata         `json:"metadata"`
+}
+
+func (fact CurrencyMetadataUpdaterFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CurrencyMetadataUpdaterFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Currency:              fact.currency,
+		Metadata:              fact.metadata,
+	})
+}
+
+type CurrencyMetadataUpdaterFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Currency string          `json:"currency"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+func (fact *CurrencyMetadataUpdaterFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CurrencyMetadataUpdaterFact")
+
+	var uf CurrencyMetadataUpdaterFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Currency, uf.Metadata)
+}
+
+type currencyMetadataUpdaterMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op CurrencyMetadataUpdater) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(currencyMetadataUpdaterMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CurrencyMetadataUpdater) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CurrencyMetadataUpdater")
+
+	var ubo mitumcurrency.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
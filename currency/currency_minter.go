@@ -0,0 +1,104 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var CurrencyMinterHint = hint.MustNewHint("mitum-currency-currency-minter-v0.0.1")
+
+var MaxCurrencyMinters = 10
+
+// CurrencyMinter is the account allowed to mint currency by Mint operation.
+// Zero quota means the minter has no limit; otherwise the total amount minted
+// by the minter can not be over quota.
+type CurrencyMinter struct {
+	hint.BaseHinter
+	account base.Address
+	quota   mitumcurrency.Big
+	minted  mitumcurrency.Big
+}
+
+func NewCurrencyMinter(account base.Address, quota mitumcurrency.Big) CurrencyMinter {
+	return CurrencyMinter{
+		BaseHinter: hint.NewBaseHinter(CurrencyMinterHint),
+		account:    account,
+		quota:      quota,
+		minted:     mitumcurrency.ZeroBig,
+	}
+}
+
+func (mi CurrencyMinter) Bytes() []byte {
+	return util.ConcatBytesSlice(mi.account.Bytes(), mi.Quota().Bytes(), mi.Minted().Bytes())
+}
+
+func (mi CurrencyMinter) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, mi.BaseHinter, mi.account); err != nil {
+		return err
+	}
+
+	if !mi.Quota().OverNil() {
+		return util.ErrInvalid.Errorf("negative quota of minter, %q", mi.account)
+	}
+
+	if !mi.Minted().OverNil() {
+		return util.ErrInvalid.Errorf("negative minted of minter, %q", mi.account)
+	}
+
+	if mi.HasQuota() && mi.minted.Compare(mi.quota) > 0 {
+		return util.ErrInvalid.Errorf("minted over quota of minter, %q; %v > %v", mi.account, mi.minted, mi.quota)
+	}
+
+	return nil
+}
+
+func (mi CurrencyMinter) Account() base.Address {
+	return mi.account
+}
+
+func (mi CurrencyMinter) Quota() mitumcurrency.Big {
+	if mi.quota.Int == nil {
+		return mitumcurrency.ZeroBig
+	}
+
+	return mi.quota
+}
+
+func (mi CurrencyMinter) HasQuota() bool {
+	return mi.Quota().OverZero()
+}
+
+func (mi CurrencyMinter) Minted() mitumcurrency.Big {
+	if mi.minted.Int == nil {
+		return mitumcurrency.ZeroBig
+	}
+
+	return mi.minted
+}
+
+func (mi CurrencyMinter) SetMinted(b mitumcurrency.Big) CurrencyMinter {
+	mi.minted = b
+
+	return mi
+}
+
+// Mint increases the minted amount of minter; the result can not be over
+// quota.
+func (mi CurrencyMinter) Mint(b mitumcurrency.Big) (CurrencyMinter, error) {
+	if !b.OverZero() {
+		return CurrencyMinter{}, errors.Errorf("mint amount should be over zero")
+	}
+
+	minted := mi.Minted().Add(b)
+	if mi.HasQuota() && minted.Compare(mi.quota) > 0 {
+		return CurrencyMinter{}, errors.Errorf(
+			"over quota of minter, %q; %v + %v > %v", mi.account, mi.Minted(), b, mi.quota)
+	}
+
+	mi.minted = minted
+
+	return mi, nil
+}
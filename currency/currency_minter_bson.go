@@ -0,0 +1,42 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (mi CurrencyMinter) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   mi.Hint().String(),
+			"account": mi.account,
+			"quota":   mi.Quota().String(),
+			"minted":  mi.Minted().String(),
+		},
+	)
+}
+
+type CurrencyMinterBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Account string `bson:"account"`
+	Quota   string `bson:"quota"`
+	Minted  string `bson:"minted"`
+}
+
+func (mi *CurrencyMinter) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of CurrencyMinter")
+
+	var umi CurrencyMinterBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &umi); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(umi.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return mi.unpack(enc, ht, umi.Account, umi.Quota, umi.Minted)
+}
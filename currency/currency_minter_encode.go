@@ -0,0 +1,42 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (mi *CurrencyMinter) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	qt string,
+	mt string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal CurrencyMinter")
+
+	mi.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "failed to decode account")
+	default:
+		mi.account = a
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(qt); err != nil {
+		return e(err, "failed to decode quota")
+	} else {
+		mi.quota = big
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(mt); err != nil {
+		return e(err, "failed to decode minted")
+	} else {
+		mi.minted = big
+	}
+
+	return nil
+}
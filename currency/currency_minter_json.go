@@ -0,0 +1,42 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CurrencyMinterJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Quota   string       `json:"quota"`
+	Minted  string       `json:"minted"`
+}
+
+func (mi CurrencyMinter) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CurrencyMinterJSONMarshaler{
+		BaseHinter: mi.BaseHinter,
+		Account:    mi.account,
+		Quota:      mi.Quota().String(),
+		Minted:     mi.Minted().String(),
+	})
+}
+
+type CurrencyMinterJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Account string    `json:"account"`
+	Quota   string    `json:"quota"`
+	Minted  string    `json:"minted"`
+}
+
+func (mi *CurrencyMinter) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of CurrencyMinter")
+
+	var umi CurrencyMinterJSONUnmarshaler
+	if err := enc.Unmarshal(b, &umi); err != nil {
+		return e(err, "")
+	}
+
+	return mi.unpack(enc, umi.Hint, umi.Account, umi.Quota, umi.Minted)
+}
@@ -2,6 +2,7 @@ package currency
 
 import (
 	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
@@ -14,6 +15,9 @@ type CurrencyPolicy struct {
 	hint.BaseHinter
 	newAccountMinBalance mitumcurrency.Big
 	feeer                Feeer
+	minters              []CurrencyMinter
+	operationFeeers      []OperationFeeer
+	paused               bool
 }
 
 func NewCurrencyPolicy(newAccountMinBalance mitumcurrency.Big, feeer Feeer) CurrencyPolicy {
@@ -24,7 +28,35 @@ func NewCurrencyPolicy(newAccountMinBalance mitumcurrency.Big, feeer Feeer) Curr
 }
 
 func (po CurrencyPolicy) Bytes() []byte {
-	return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	if len(po.minters) < 1 && len(po.operationFeeers) < 1 && !po.paused {
+		return util.ConcatBytesSlice(po.newAccountMinBalance.Bytes(), po.feeer.Bytes())
+	}
+
+	bs := make([][]byte, len(po.minters))
+	for i := range po.minters {
+		bs[i] = po.minters[i].Bytes()
+	}
+
+	fs := make([][]byte, len(po.operationFeeers))
+	for i := range po.operationFeeers {
+		fs[i] = po.operationFeeers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		po.newAccountMinBalance.Bytes(),
+		po.feeer.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		util.ConcatBytesSlice(fs...),
+		pausedBytes(po.paused),
+	)
+}
+
+func pausedBytes(paused bool) []byte {
+	if !paused {
+		return nil
+	}
+
+	return []byte{1}
 }
 
 func (po CurrencyPolicy) IsValid([]byte) error {
@@ -36,6 +68,40 @@ func (po CurrencyPolicy) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid currency policy: %w", err)
 	}
 
+	if n := len(po.minters); n > MaxCurrencyMinters {
+		return util.ErrInvalid.Errorf("minters, %d over max, %d", n, MaxCurrencyMinters)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range po.minters {
+		mi := po.minters[i]
+		if err := mi.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid currency policy: %w", err)
+		}
+
+		if _, found := founds[mi.Account().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate minter, %q", mi.Account())
+		}
+		founds[mi.Account().String()] = struct{}{}
+	}
+
+	if n := len(po.operationFeeers); n > MaxOperationFeeers {
+		return util.ErrInvalid.Errorf("operation feeers, %d over max, %d", n, MaxOperationFeeers)
+	}
+
+	facts := map[hint.Type]struct{}{}
+	for i := range po.operationFeeers {
+		of := po.operationFeeers[i]
+		if err := of.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid currency policy: %w", err)
+		}
+
+		if _, found := facts[of.Fact()]; found {
+			return util.ErrInvalid.Errorf("duplicate operation feeer, %q", of.Fact())
+		}
+		facts[of.Fact()] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -46,3 +112,109 @@ func (po CurrencyPolicy) NewAccountMinBalance() mitumcurrency.Big {
 func (po CurrencyPolicy) Feeer() Feeer {
 	return po.feeer
 }
+
+func (po CurrencyPolicy) OperationFeeers() []OperationFeeer {
+	return po.operationFeeers
+}
+
+// FeeerOf returns the feeer for the operation of which fact has the hint; if
+// the operation feeer is not set, the default feeer is returned.
+func (po CurrencyPolicy) FeeerOf(fact hint.Hint) Feeer {
+	for i := range po.operationFeeers {
+		if po.operationFeeers[i].Fact() == fact.Type() {
+			return po.operationFeeers[i].Feeer()
+		}
+	}
+
+	return po.feeer
+}
+
+// Feeers returns the default feeer and the feeers of operations.
+func (po CurrencyPolicy) Feeers() []Feeer {
+	feeers := make([]Feeer, len(po.operationFeeers)+1)
+	feeers[0] = po.feeer
+
+	for i := range po.operationFeeers {
+		feeers[i+1] = po.operationFeeers[i].Feeer()
+	}
+
+	return feeers
+}
+
+// Receivers returns the receivers of all the feeers without duplication.
+func (po CurrencyPolicy) Receivers() []base.Address {
+	var receivers []base.Address // nolint:prealloc
+
+	founds := map[string]struct{}{}
+	for _, feeer := range po.Feeers() {
+		for _, receiver := range FeeerReceivers(feeer) {
+			if _, found := founds[receiver.String()]; found {
+				continue
+			}
+
+			founds[receiver.String()] = struct{}{}
+			receivers = append(receivers, receiver)
+		}
+	}
+
+	return receivers
+}
+
+func (po CurrencyPolicy) SetOperationFeeers(feeers []OperationFeeer) CurrencyPolicy {
+	po.operationFeeers = feeers
+
+	return po
+}
+
+// Paused returns true when the operations which move the currency, like
+// transfers and withdraws, are not allowed.
+func (po CurrencyPolicy) Paused() bool {
+	return po.paused
+}
+
+func (po CurrencyPolicy) SetPaused(paused bool) CurrencyPolicy {
+	po.paused = paused
+
+	return po
+}
+
+func (po CurrencyPolicy) Minters() []CurrencyMinter {
+	return po.minters
+}
+
+func (po CurrencyPolicy) Minter(a base.Address) (CurrencyMinter, bool) {
+	for i := range po.minters {
+		if po.minters[i].Account().Equal(a) {
+			return po.minters[i], true
+		}
+	}
+
+	return CurrencyMinter{}, false
+}
+
+func (po CurrencyPolicy) IsMinter(a base.Address) bool {
+	_, found := po.Minter(a)
+
+	return found
+}
+
+func (po CurrencyPolicy) SetMinters(minters []CurrencyMinter) CurrencyPolicy {
+	po.minters = minters
+
+	return po
+}
+
+// SetMinter replaces the minter which has same account.
+func (po CurrencyPolicy) SetMinter(mi CurrencyMinter) CurrencyPolicy {
+	minters := make([]CurrencyMinter, len(po.minters))
+	copy(minters, po.minters)
+
+	for i := range minters {
+		if minters[i].Account().Equal(mi.Account()) {
+			minters[i] = mi
+		}
+	}
+	po.minters = minters
+
+	return po
+}
@@ -9,18 +9,34 @@ import (
 )
 
 func (po CurrencyPolicy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":                   po.Hint().String(),
-			"new_account_min_balance": po.newAccountMinBalance.String(),
-			"feeer":                   po.feeer,
-		})
+	m := bson.M{
+		"_hint":                   po.Hint().String(),
+		"new_account_min_balance": po.newAccountMinBalance.String(),
+		"feeer":                   po.feeer,
+	}
+
+	if len(po.minters) > 0 {
+		m["minters"] = po.minters
+	}
+
+	if len(po.operationFeeers) > 0 {
+		m["operation_feeers"] = po.operationFeeers
+	}
+
+	if po.paused {
+		m["paused"] = true
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CurrencyPolicyBSONUnmarshaler struct {
 	Hint       string   `bson:"_hint"`
 	MinBalance string   `bson:"new_account_min_balance"`
 	Feeer      bson.Raw `bson:"feeer"`
+	Minters    bson.Raw `bson:"minters,omitempty"`
+	Operations bson.Raw `bson:"operation_feeers,omitempty"`
+	Paused     bool     `bson:"paused,omitempty"`
 }
 
 func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -36,5 +52,5 @@ func (po *CurrencyPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return po.unpack(enc, ht, upo.MinBalance, upo.Feeer)
+	return po.unpack(enc, ht, upo.MinBalance, upo.Feeer, upo.Minters, upo.Operations, upo.Paused)
 }
@@ -7,7 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte) error {
+func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, bfe []byte, bmi []byte, bof []byte, paused bool) error {
 	e := util.StringErrorFunc("failed to unmarshal CurrencyPolicy")
 
 	if big, err := mitumcurrency.NewBigFromString(mn); err != nil {
@@ -25,5 +25,43 @@ func (po *CurrencyPolicy) unpack(enc encoder.Encoder, ht hint.Hint, mn string, b
 	}
 	po.feeer = feeer
 
+	if len(bmi) > 0 {
+		hmi, err := enc.DecodeSlice(bmi)
+		if err != nil {
+			return e(err, "failed to decode minters")
+		}
+
+		minters := make([]CurrencyMinter, len(hmi))
+		for i := range hmi {
+			j, ok := hmi[i].(CurrencyMinter)
+			if !ok {
+				return e(util.ErrWrongType.Errorf("expected CurrencyMinter, not %T", hmi[i]), "")
+			}
+
+			minters[i] = j
+		}
+		po.minters = minters
+	}
+
+	if len(bof) > 0 {
+		hof, err := enc.DecodeSlice(bof)
+		if err != nil {
+			return e(err, "failed to decode operation feeers")
+		}
+
+		feeers := make([]OperationFeeer, len(hof))
+		for i := range hof {
+			j, ok := hof[i].(OperationFeeer)
+			if !ok {
+				return e(util.ErrWrongType.Errorf("expected OperationFeeer, not %T", hof[i]), "")
+			}
+
+			feeers[i] = j
+		}
+		po.operationFeeers = feeers
+	}
+
+	po.paused = paused
+
 	return nil
 }
@@ -10,8 +10,11 @@ import (
 
 type CurrencyPolicyJSONMarshaler struct {
 	hint.BaseHinter
-	MinBalance string `json:"new_account_min_balance"`
-	Feeer      Feeer  `json:"feeer"`
+	MinBalance string           `json:"new_account_min_balance"`
+	Feeer      Feeer            `json:"feeer"`
+	Minters    []CurrencyMinter `json:"minters,omitempty"`
+	Operations []OperationFeeer `json:"operation_feeers,omitempty"`
+	Paused     bool             `json:"paused"`
 }
 
 func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
@@ -19,6 +22,9 @@ func (po CurrencyPolicy) MarshalJSON() ([]byte, error) {
 		BaseHinter: po.BaseHinter,
 		MinBalance: po.newAccountMinBalance.String(),
 		Feeer:      po.feeer,
+		Minters:    po.minters,
+		Operations: po.operationFeeers,
+		Paused:     po.paused,
 	})
 }
 
@@ -26,6 +32,9 @@ type CurrencyPolicyJSONUnmarshaler struct {
 	Hint       hint.Hint       `json:"_hint"`
 	MinBalance string          `json:"new_account_min_balance"`
 	Feeer      json.RawMessage `json:"feeer"`
+	Minters    json.RawMessage `json:"minters"`
+	Operations json.RawMessage `json:"operation_feeers"`
+	Paused     bool            `json:"paused"`
 }
 
 func (po *CurrencyPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -36,5 +45,5 @@ func (po *CurrencyPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e(err, "")
 	}
 
-	return po.unpack(enc, upo.Hint, upo.MinBalance, upo.Feeer)
+	return po.unpack(enc, upo.Hint, upo.MinBalance, upo.Feeer, upo.Minters, upo.Operations, upo.Paused)
 }
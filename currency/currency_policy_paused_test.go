@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testPausedCurrency struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	node     base.Address
+	npriv    base.Privatekey
+	sender   base.Address
+	priv     base.Privatekey
+	receiver base.Address
+	contract base.Address
+}
+
+func (t *testPausedCurrency) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	t.node, t.npriv = t.states.setSuffrage()
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	contract, err := t.states.newContractAccount(sender)
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	t.sender, t.priv, t.receiver, t.contract = sender, privs[0], receiver, contract
+
+	t.states.setCurrency(t.cid, t.policy(false))
+}
+
+func (t *testPausedCurrency) policy(paused bool) CurrencyPolicy {
+	return newTestCurrencyPolicy().SetMinters(
+		[]CurrencyMinter{NewCurrencyMinter(t.sender, mitumcurrency.NewBig(50))},
+	).SetPaused(paused)
+}
+
+func (t *testPausedCurrency) pause(paused bool) {
+	op, err := NewCurrencyPolicyUpdater(NewCurrencyPolicyUpdaterFact(util.UUID().Bytes(), t.cid, t.policy(paused)))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	t.Nil(t.process(NewCurrencyPolicyUpdaterProcessor(base.Threshold(100)), op, t.states))
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(paused, de.Policy().Paused())
+}
+
+func (t *testPausedCurrency) transfers() mitumcurrency.Transfers {
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), t.sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testPausedCurrency) TestPaused() {
+	t.pause(true)
+
+	t.Run("transfers", func() {
+		reason := t.process(NewTransfersProcessor(), t.transfers(), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "currency paused")
+	})
+
+	t.Run("mint", func() {
+		op, err := NewMint(NewMintFact(
+			util.UUID().Bytes(), t.sender, t.receiver, mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)))
+		t.NoError(err)
+		t.NoError(op.HashSign(t.priv, t.networkID))
+
+		reason := t.process(NewMintProcessor(), op, t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "currency paused")
+	})
+
+	t.Run("withdraws", func() {
+		op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), t.sender, []WithdrawsItem{
+			NewWithdrawsItemMultiAmounts(
+				t.contract,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+			),
+		}))
+		t.NoError(err)
+		t.NoError(op.HashSign(t.priv, t.networkID))
+
+		reason := t.process(NewWithdrawsProcessor(), op, t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "currency paused")
+	})
+
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.contract, t.cid))
+	t.True(t.states.balance(t.receiver, t.cid).IsZero())
+
+	t.pause(false)
+
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(), t.states))
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.receiver, t.cid))
+}
+
+func TestPausedCurrency(t *testing.T) {
+	suite.Run(t, new(testPausedCurrency))
+}
+
+func TestCurrencyPolicyUpdaterFactPausedEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyPolicyUpdaterFactHint, Instance: CurrencyPolicyUpdaterFact{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		fact := NewCurrencyPolicyUpdaterFact(
+			util.UUID().Bytes(),
+			mitumcurrency.CurrencyID("SHOWME"),
+			newTestCurrencyPolicy().SetPaused(true),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyPolicyUpdaterFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(CurrencyPolicyUpdaterFact)
+		t.True(ok)
+		bf, ok := b.(CurrencyPolicyUpdaterFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Policy().Bytes(), bf.Policy().Bytes())
+		t.True(bf.Policy().Paused())
+	}
+
+	suite.Run(tt, t)
+}
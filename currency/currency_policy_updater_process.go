@@ -96,9 +96,16 @@ func (opp *CurrencyPolicyUpdaterProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
 	}
 
-	if receiver := fact.policy.Feeer().Receiver(); receiver != nil {
+	for _, receiver := range fact.policy.Receivers() {
 		if err := checkExistsState(mitumcurrency.StateKeyAccount(receiver), getStateFunc); err != nil {
-			return ctx, base.NewBaseOperationProcessReasonError("feeer receiver not found, %q: %w", fact.policy.Feeer().Receiver(), err), nil
+			return ctx, base.NewBaseOperationProcessReasonError("feeer receiver not found, %q: %w", receiver, err), nil
+		}
+	}
+
+	for i := range fact.policy.Minters() {
+		minter := fact.policy.Minters()[i].Account()
+		if err := checkExistsState(mitumcurrency.StateKeyAccount(minter), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("minter not found, %q: %w", minter, err), nil
 		}
 	}
 
@@ -128,7 +135,22 @@ func (opp *CurrencyPolicyUpdaterProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("failed to get currency design value, %q: %w", fact.currency, err), nil
 	}
 
-	de.policy = fact.policy
+	// NOTE minted amount of the minter which remains in the new policy is
+	// kept, so the quota can not be reset by updating policy.
+	policy := fact.policy
+	for i := range policy.Minters() {
+		nmi := policy.Minters()[i]
+		if omi, found := de.policy.Minter(nmi.Account()); found {
+			nmi = nmi.SetMinted(omi.Minted())
+			if err := nmi.IsValid(nil); err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("invalid minter, %q: %w", nmi.Account(), err), nil
+			}
+
+			policy = policy.SetMinter(nmi)
+		}
+	}
+
+	de.policy = policy
 
 	c := NewCurrencyDesignStateMergeValue(
 		st.Key(),
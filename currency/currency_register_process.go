@@ -105,16 +105,32 @@ func (opp *CurrencyRegisterProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be genesis account of currency, %q: %w", item.genesisAccount, err), nil
 	}
 
-	if receiver := item.Policy().Feeer().Receiver(); receiver != nil {
+	for _, receiver := range item.Policy().Receivers() {
 		if err := checkExistsState(mitumcurrency.StateKeyAccount(receiver), getStateFunc); err != nil {
 			return ctx, base.NewBaseOperationProcessReasonError("feeer receiver not found, %q: %w", receiver, err), nil
 		}
+	}
+
+	// NOTE the other receivers of split feeer can be contract accounts, like
+	// treasury.
+	for _, feeer := range item.Policy().Feeers() {
+		receiver := feeer.Receiver()
+		if receiver == nil {
+			continue
+		}
 
 		if err := checkNotExistsState(StateKeyContractAccount(receiver), getStateFunc); err != nil {
 			return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be fee receiver, %q: %w", receiver, err), nil
 		}
 	}
 
+	for i := range item.Policy().Minters() {
+		minter := item.Policy().Minters()[i].Account()
+		if err := checkExistsState(mitumcurrency.StateKeyAccount(minter), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("minter not found, %q: %w", minter, err), nil
+		}
+	}
+
 	if err := checkNotExistsState(mitumcurrency.StateKeyBalance(item.genesisAccount, item.Currency()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("account balance already exists, %q: %w", mitumcurrency.StateKeyBalance(item.genesisAccount, item.Currency()), err), nil
 	}
@@ -138,7 +154,7 @@ func (opp *CurrencyRegisterProcessor) Process(
 	item := fact.currency
 
 	ba := mitumcurrency.NewBalanceStateValue(item.amount)
-	sts[0] = mitumcurrency.NewBalanceStateMergeValue(
+	sts[0] = NewBalanceStateMergeValue(
 		mitumcurrency.StateKeyBalance(item.genesisAccount, item.Currency()),
 		ba,
 	)
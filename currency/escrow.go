@@ -0,0 +1,196 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var EscrowHint = hint.MustNewHint("mitum-currency-escrow-v0.0.1")
+
+var MaxEscrowAmounts = 10
+
+type EscrowStatus string
+
+const (
+	EscrowPending  = EscrowStatus("pending")
+	EscrowReleased = EscrowStatus("released")
+	EscrowRefunded = EscrowStatus("refunded")
+)
+
+func (s EscrowStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s EscrowStatus) IsValid([]byte) error {
+	switch s {
+	case EscrowPending, EscrowReleased, EscrowRefunded:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown escrow status, %q", s)
+	}
+}
+
+// Escrow is the amounts held by CreateEscrow until it is resolved. The arbiter
+// can release the amounts to payee or refund them to payer at any time; the
+// payer also can resolve it after the expiry height. Escrow is identified by
+// the fact hash of CreateEscrow.
+type Escrow struct {
+	hint.BaseHinter
+	id      util.Hash
+	payer   base.Address
+	payee   base.Address
+	arbiter base.Address
+	amounts []mitumcurrency.Amount
+	expiry  base.Height
+	status  EscrowStatus
+}
+
+func NewEscrow(
+	id util.Hash,
+	payer, payee, arbiter base.Address,
+	amounts []mitumcurrency.Amount,
+	expiry base.Height,
+) Escrow {
+	return Escrow{
+		BaseHinter: hint.NewBaseHinter(EscrowHint),
+		id:         id,
+		payer:      payer,
+		payee:      payee,
+		arbiter:    arbiter,
+		amounts:    amounts,
+		expiry:     expiry,
+		status:     EscrowPending,
+	}
+}
+
+func (es Escrow) Bytes() []byte {
+	bs := make([][]byte, len(es.amounts))
+	for i := range es.amounts {
+		bs[i] = es.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		es.id.Bytes(),
+		es.payer.Bytes(),
+		es.payee.Bytes(),
+		es.arbiter.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		es.expiry.Bytes(),
+		es.status.Bytes(),
+	)
+}
+
+func (es Escrow) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		es.BaseHinter,
+		es.id,
+		es.payer,
+		es.payee,
+		es.arbiter,
+		es.expiry,
+		es.status,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid escrow: %w", err)
+	}
+
+	if es.payer.Equal(es.payee) {
+		return util.ErrInvalid.Errorf("payee is same with payer, %q", es.payer)
+	}
+
+	if es.arbiter.Equal(es.payer) || es.arbiter.Equal(es.payee) {
+		return util.ErrInvalid.Errorf("arbiter should be neither payer nor payee, %q", es.arbiter)
+	}
+
+	return isValidEscrowAmounts(es.amounts)
+}
+
+func (es Escrow) ID() util.Hash {
+	return es.id
+}
+
+func (es Escrow) Payer() base.Address {
+	return es.payer
+}
+
+func (es Escrow) Payee() base.Address {
+	return es.payee
+}
+
+func (es Escrow) Arbiter() base.Address {
+	return es.arbiter
+}
+
+func (es Escrow) Amounts() []mitumcurrency.Amount {
+	return es.amounts
+}
+
+func (es Escrow) Expiry() base.Height {
+	return es.expiry
+}
+
+func (es Escrow) Status() EscrowStatus {
+	return es.status
+}
+
+func (es Escrow) IsPending() bool {
+	return es.status == EscrowPending
+}
+
+// IsExpired returns true when the block height is over the expiry height.
+func (es Escrow) IsExpired(height base.Height) bool {
+	return height > es.expiry
+}
+
+// CanResolve checks whether the account can release or refund the escrow at
+// the block height.
+func (es Escrow) CanResolve(a base.Address, height base.Height) error {
+	switch {
+	case !es.IsPending():
+		return util.ErrInvalid.Errorf("escrow already %s", es.status)
+	case es.arbiter.Equal(a):
+		return nil
+	case es.payer.Equal(a):
+		if !es.IsExpired(height) {
+			return util.ErrInvalid.Errorf("payer can not resolve escrow before expiry, %d <= %d", height, es.expiry)
+		}
+
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("only arbiter or payer after expiry can resolve escrow, %q", a)
+	}
+}
+
+func (es Escrow) Resolve(status EscrowStatus) Escrow {
+	es.status = status
+
+	return es
+}
+
+func isValidEscrowAmounts(amounts []mitumcurrency.Amount) error {
+	if n := len(amounts); n < 1 {
+		return util.ErrInvalid.Errorf("empty amounts")
+	} else if n > MaxEscrowAmounts {
+		return util.ErrInvalid.Errorf("amounts, %d over max, %d", n, MaxEscrowAmounts)
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range amounts {
+		am := amounts[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount should be over zero")
+		}
+
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
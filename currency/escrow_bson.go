@@ -0,0 +1,55 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (es Escrow) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   es.Hint().String(),
+			"id":      es.id.String(),
+			"payer":   es.payer,
+			"payee":   es.payee,
+			"arbiter": es.arbiter,
+			"amounts": es.amounts,
+			"expiry":  es.expiry,
+			"status":  es.status,
+		},
+	)
+}
+
+type EscrowBSONUnmarshaler struct {
+	Hint    string      `bson:"_hint"`
+	ID      string      `bson:"id"`
+	Payer   string      `bson:"payer"`
+	Payee   string      `bson:"payee"`
+	Arbiter string      `bson:"arbiter"`
+	Amounts bson.Raw    `bson:"amounts"`
+	Expiry  base.Height `bson:"expiry"`
+	Status  string      `bson:"status"`
+}
+
+func (es *Escrow) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of Escrow")
+
+	var ues EscrowBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &ues); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(ues.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return es.unpack(
+		enc, ht, valuehash.NewBytesFromString(ues.ID),
+		ues.Payer, ues.Payee, ues.Arbiter, ues.Amounts, ues.Expiry, ues.Status,
+	)
+}
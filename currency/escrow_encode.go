@@ -0,0 +1,66 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (es *Escrow) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id util.Hash,
+	py, pe, ar string,
+	bam []byte,
+	expiry base.Height,
+	status string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal Escrow")
+
+	es.BaseHinter = hint.NewBaseHinter(ht)
+	es.id = id
+
+	switch a, err := base.DecodeAddress(py, enc); {
+	case err != nil:
+		return e(err, "failed to decode payer")
+	default:
+		es.payer = a
+	}
+
+	switch a, err := base.DecodeAddress(pe, enc); {
+	case err != nil:
+		return e(err, "failed to decode payee")
+	default:
+		es.payee = a
+	}
+
+	switch a, err := base.DecodeAddress(ar, enc); {
+	case err != nil:
+		return e(err, "failed to decode arbiter")
+	default:
+		es.arbiter = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+	es.amounts = amounts
+
+	es.expiry = expiry
+	es.status = EscrowStatus(status)
+
+	return nil
+}
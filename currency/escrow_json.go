@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type EscrowJSONMarshaler struct {
+	hint.BaseHinter
+	ID      util.Hash              `json:"id"`
+	Payer   base.Address           `json:"payer"`
+	Payee   base.Address           `json:"payee"`
+	Arbiter base.Address           `json:"arbiter"`
+	Amounts []mitumcurrency.Amount `json:"amounts"`
+	Expiry  base.Height            `json:"expiry"`
+	Status  EscrowStatus           `json:"status"`
+}
+
+func (es Escrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EscrowJSONMarshaler{
+		BaseHinter: es.BaseHinter,
+		ID:         es.id,
+		Payer:      es.payer,
+		Payee:      es.payee,
+		Arbiter:    es.arbiter,
+		Amounts:    es.amounts,
+		Expiry:     es.expiry,
+		Status:     es.status,
+	})
+}
+
+type EscrowJSONUnmarshaler struct {
+	Hint    hint.Hint             `json:"_hint"`
+	ID      valuehash.HashDecoder `json:"id"`
+	Payer   string                `json:"payer"`
+	Payee   string                `json:"payee"`
+	Arbiter string                `json:"arbiter"`
+	Amounts json.RawMessage       `json:"amounts"`
+	Expiry  base.Height           `json:"expiry"`
+	Status  string                `json:"status"`
+}
+
+func (es *Escrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Escrow")
+
+	var ues EscrowJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ues); err != nil {
+		return e(err, "")
+	}
+
+	return es.unpack(enc, ues.Hint, ues.ID.Hash(), ues.Payer, ues.Payee, ues.Arbiter, ues.Amounts, ues.Expiry, ues.Status)
+}
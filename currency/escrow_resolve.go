@@ -0,0 +1,117 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+// EscrowResolveFact is the fact of the operation which resolves the escrow,
+// ReleaseEscrow and RefundEscrow.
+type EscrowResolveFact interface {
+	Sender() base.Address
+	Escrow() util.Hash
+}
+
+func checkResolveEscrow(
+	op base.Operation,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) (Escrow, base.OperationProcessReasonError) {
+	fact, ok := op.Fact().(EscrowResolveFact)
+	if !ok {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("expected EscrowResolveFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err)
+	}
+
+	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("invalid signing: %w", err)
+	}
+
+	st, err := existsState(StateKeyEscrow(fact.Escrow()), "key of escrow", getStateFunc)
+	if err != nil {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("escrow not found, %q: %w", fact.Escrow(), err)
+	}
+
+	es, err := StateEscrowValue(st)
+	if err != nil {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("failed to get escrow value, %q: %w", fact.Escrow(), err)
+	}
+
+	if err := es.CanResolve(fact.Sender(), height); err != nil {
+		return Escrow{}, base.NewBaseOperationProcessReasonError("failed to resolve escrow, %q: %w", fact.Escrow(), err)
+	}
+
+	return es, nil
+}
+
+// resolveEscrow moves the whole escrowed amounts to the receiver and closes
+// the escrow with the status. The fee is paid by the sender from its own
+// balance, so the escrowed amounts can be resolved whatever the fee is.
+func resolveEscrow(
+	fact hint.Hint,
+	es Escrow,
+	sender base.Address,
+	receiver base.Address,
+	status EscrowStatus,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	sts := []base.StateMergeValue{
+		NewEscrowStateMergeValue(StateKeyEscrow(es.ID()), NewEscrowStateValue(es.Resolve(status))),
+	}
+
+	required := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+	for i := range es.Amounts() {
+		am := es.Amounts()[i]
+
+		policy, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		fee, err := policy.FeeerOf(fact).Fee(am.Big())
+		if err != nil {
+			return nil, err
+		}
+
+		if fee.OverZero() {
+			required[am.Currency()] = [2]mitumcurrency.Big{fee, fee}
+		}
+
+		sts = append(sts, NewBalanceStateMergeValue(
+			mitumcurrency.StateKeyBalance(receiver, am.Currency()),
+			NewAddBalanceStateValue(am),
+		))
+	}
+
+	if len(required) < 1 {
+		return sts, nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(sender, required, getStateFunc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check enough balance for fee")
+	}
+
+	for cid := range required {
+		v, ok := sb[cid].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, errors.Errorf("expected BalanceStateValue, not %T", sb[cid].Value())
+		}
+
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[cid][0])))
+		sts = append(sts, NewBalanceStateMergeValue(sb[cid].Key(), stv))
+	}
+
+	fsts, err := CollectFees(fact, RequiredFees(required), getStateFunc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to collect fee")
+	}
+
+	return append(sts, fsts...), nil
+}
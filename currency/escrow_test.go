@@ -0,0 +1,310 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/stretchr/testify/suite"
+)
+
+type testEscrow struct {
+	testProcessorSuite
+	cid     mitumcurrency.CurrencyID
+	states  *testStates
+	payer   base.Address
+	priv    base.Privatekey
+	payee   base.Address
+	epriv   base.Privatekey
+	arbiter base.Address
+	apriv   base.Privatekey
+}
+
+func (t *testEscrow) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	payer, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(payer, t.cid, 100)
+
+	payee, eprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(payee, t.cid, 0)
+
+	arbiter, aprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+
+	t.payer, t.priv = payer, privs[0]
+	t.payee, t.epriv = payee, eprivs[0]
+	t.arbiter, t.apriv = arbiter, aprivs[0]
+}
+
+func (t *testEscrow) create(big int64, expiry base.Height) CreateEscrow {
+	op, err := NewCreateEscrow(NewCreateEscrowFact(
+		util.UUID().Bytes(),
+		t.payer, t.payee, t.arbiter,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		expiry,
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testEscrow) release(sender base.Address, priv base.Privatekey, id util.Hash) ReleaseEscrow {
+	op, err := NewReleaseEscrow(NewReleaseEscrowFact(util.UUID().Bytes(), sender, id))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testEscrow) refund(sender base.Address, priv base.Privatekey, id util.Hash) RefundEscrow {
+	op, err := NewRefundEscrow(NewRefundEscrowFact(util.UUID().Bytes(), sender, id))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testEscrow) escrow(id util.Hash) Escrow {
+	st, found, err := t.states.getStateFunc(StateKeyEscrow(id))
+	t.NoError(err)
+	t.True(found)
+
+	es, err := StateEscrowValue(st)
+	t.NoError(err)
+
+	return es
+}
+
+func (t *testEscrow) TestRelease() {
+	op := t.create(30, 40)
+	id := op.Fact().Hash()
+
+	t.Nil(t.process(NewCreateEscrowProcessor(), op, t.states))
+
+	t.Equal(mitumcurrency.NewBig(70), t.states.balance(t.payer, t.cid))
+	t.True(t.escrow(id).IsPending())
+
+	t.Run("by payee", func() {
+		reason := t.preProcess(NewReleaseEscrowProcessor(), t.release(t.payee, t.epriv, id), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "only arbiter or payer after expiry can resolve escrow")
+	})
+
+	t.Run("by payer before expiry", func() {
+		reason := t.preProcess(NewReleaseEscrowProcessor(), t.release(t.payer, t.priv, id), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "payer can not resolve escrow before expiry")
+	})
+
+	t.Nil(t.process(NewReleaseEscrowProcessor(), t.release(t.arbiter, t.apriv, id), t.states))
+
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.payee, t.cid))
+	t.Equal(mitumcurrency.NewBig(70), t.states.balance(t.payer, t.cid))
+	t.Equal(EscrowReleased, t.escrow(id).Status())
+
+	t.Run("resolved already", func() {
+		reason := t.preProcess(NewRefundEscrowProcessor(), t.refund(t.arbiter, t.apriv, id), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "escrow already released")
+	})
+}
+
+func (t *testEscrow) TestRefundAfterExpiry() {
+	op := t.create(30, 40)
+	id := op.Fact().Hash()
+
+	t.Nil(t.process(NewCreateEscrowProcessor(), op, t.states))
+
+	t.states.height = base.Height(41)
+
+	t.Nil(t.process(NewRefundEscrowProcessor(), t.refund(t.payer, t.priv, id), t.states))
+
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.payer, t.cid))
+	t.True(t.states.balance(t.payee, t.cid).IsZero())
+	t.Equal(EscrowRefunded, t.escrow(id).Status())
+}
+
+func (t *testEscrow) TestFeeRaised() {
+	op := t.create(30, 40)
+	id := op.Fact().Hash()
+
+	t.Nil(t.process(NewCreateEscrowProcessor(), op, t.states))
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	// NOTE the fee is raised over the escrowed amount after escrow created
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(40), mitumcurrency.ZeroBig)))
+
+	t.states.setBalance(t.arbiter, t.cid, 39)
+
+	reason := t.process(NewReleaseEscrowProcessor(), t.release(t.arbiter, t.apriv, id), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not enough balance")
+	t.True(t.escrow(id).IsPending())
+
+	t.states.setBalance(t.arbiter, t.cid, 50)
+
+	t.Nil(t.process(NewReleaseEscrowProcessor(), t.release(t.arbiter, t.apriv, id), t.states))
+
+	// NOTE the fee is paid by arbiter, not from the escrowed amount
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.payee, t.cid))
+	t.Equal(mitumcurrency.NewBig(70), t.states.balance(t.payer, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.arbiter, t.cid))
+	t.Equal(mitumcurrency.NewBig(40), t.states.balance(feeReceiver, t.cid))
+	t.Equal(EscrowReleased, t.escrow(id).Status())
+}
+
+func (t *testEscrow) TestRefundWithFee() {
+	op := t.create(30, 40)
+	id := op.Fact().Hash()
+
+	t.Nil(t.process(NewCreateEscrowProcessor(), op, t.states))
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(5), mitumcurrency.ZeroBig)))
+
+	t.states.height = base.Height(41)
+
+	t.Nil(t.process(NewRefundEscrowProcessor(), t.refund(t.payer, t.priv, id), t.states))
+
+	t.Equal(mitumcurrency.NewBig(95), t.states.balance(t.payer, t.cid))
+	t.Equal(mitumcurrency.NewBig(5), t.states.balance(feeReceiver, t.cid))
+	t.Equal(EscrowRefunded, t.escrow(id).Status())
+}
+
+func (t *testEscrow) TestExpired() {
+	reason := t.preProcess(NewCreateEscrowProcessor(), t.create(30, 33), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "expiry should be over current height")
+}
+
+func (t *testEscrow) TestUnknownEscrow() {
+	reason := t.preProcess(NewReleaseEscrowProcessor(), t.release(t.arbiter, t.apriv, valuehash.RandomSHA256()), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "escrow not found")
+}
+
+func TestEscrow(t *testing.T) {
+	suite.Run(t, new(testEscrow))
+}
+
+func TestCreateEscrowFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: CreateEscrowFactHint, Instance: CreateEscrowFact{}}))
+
+		fact := NewCreateEscrowFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+			base.Height(40),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CreateEscrowFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(CreateEscrowFact)
+		t.True(ok)
+		bf, ok := b.(CreateEscrowFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Escrow().Bytes(), bf.Escrow().Bytes())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestEscrowStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: EscrowHint, Instance: Escrow{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: EscrowStateValueHint, Instance: EscrowStateValue{}}))
+
+		es := NewEscrow(
+			valuehash.RandomSHA256(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+			base.Height(40),
+		).Resolve(EscrowReleased)
+
+		sv := NewEscrowStateValue(es)
+		t.NoError(sv.IsValid(nil))
+
+		b, err := enc.Marshal(sv)
+		t.NoError(err)
+
+		return sv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(EscrowStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(EscrowStateValue)
+		t.True(ok)
+		bv, ok := b.(EscrowStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.Equal(av.HashBytes(), bv.HashBytes())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,84 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ExchangeRateHint = hint.MustNewHint("mitum-currency-exchange-rate-v0.0.1")
+
+// ExchangeRate is the rate to pay the fee of currency, from with the other
+// currency, to; the amount of from is exchanged to amount * numerator /
+// denominator of to.
+type ExchangeRate struct {
+	hint.BaseHinter
+	from        mitumcurrency.CurrencyID
+	to          mitumcurrency.CurrencyID
+	numerator   mitumcurrency.Big
+	denominator mitumcurrency.Big
+}
+
+func NewExchangeRate(
+	from, to mitumcurrency.CurrencyID,
+	numerator, denominator mitumcurrency.Big,
+) ExchangeRate {
+	return ExchangeRate{
+		BaseHinter:  hint.NewBaseHinter(ExchangeRateHint),
+		from:        from,
+		to:          to,
+		numerator:   numerator,
+		denominator: denominator,
+	}
+}
+
+func (er ExchangeRate) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		er.from.Bytes(),
+		er.to.Bytes(),
+		er.numerator.Bytes(),
+		er.denominator.Bytes(),
+	)
+}
+
+func (er ExchangeRate) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, er.BaseHinter, er.from, er.to); err != nil {
+		return err
+	}
+
+	if er.from == er.to {
+		return util.ErrInvalid.Errorf("same currency of exchange rate, %q", er.from)
+	}
+
+	if !er.numerator.OverZero() {
+		return util.ErrInvalid.Errorf("numerator of exchange rate should be over zero")
+	}
+
+	if !er.denominator.OverZero() {
+		return util.ErrInvalid.Errorf("denominator of exchange rate should be over zero")
+	}
+
+	return nil
+}
+
+func (er ExchangeRate) From() mitumcurrency.CurrencyID {
+	return er.from
+}
+
+func (er ExchangeRate) To() mitumcurrency.CurrencyID {
+	return er.to
+}
+
+func (er ExchangeRate) Numerator() mitumcurrency.Big {
+	return er.numerator
+}
+
+func (er ExchangeRate) Denominator() mitumcurrency.Big {
+	return er.denominator
+}
+
+// Exchange returns the amount of to currency for the amount of from currency;
+// the remainder is rounded up not to lose fee.
+func (er ExchangeRate) Exchange(a mitumcurrency.Big) mitumcurrency.Big {
+	return a.Mul(er.numerator).Add(er.denominator).Sub(mitumcurrency.NewBig(1)).Div(er.denominator)
+}
@@ -0,0 +1,44 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (er ExchangeRate) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       er.Hint().String(),
+			"from":        er.from,
+			"to":          er.to,
+			"numerator":   er.numerator.String(),
+			"denominator": er.denominator.String(),
+		},
+	)
+}
+
+type ExchangeRateBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	From        string `bson:"from"`
+	To          string `bson:"to"`
+	Numerator   string `bson:"numerator"`
+	Denominator string `bson:"denominator"`
+}
+
+func (er *ExchangeRate) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ExchangeRate")
+
+	var uer ExchangeRateBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uer); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uer.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return er.unpack(ht, uer.From, uer.To, uer.Numerator, uer.Denominator)
+}
@@ -0,0 +1,33 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (er *ExchangeRate) unpack(
+	ht hint.Hint,
+	from, to string,
+	nu, de string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal ExchangeRate")
+
+	er.BaseHinter = hint.NewBaseHinter(ht)
+	er.from = mitumcurrency.CurrencyID(from)
+	er.to = mitumcurrency.CurrencyID(to)
+
+	if big, err := mitumcurrency.NewBigFromString(nu); err != nil {
+		return e(err, "failed to decode numerator")
+	} else {
+		er.numerator = big
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(de); err != nil {
+		return e(err, "failed to decode denominator")
+	} else {
+		er.denominator = big
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ExchangeRateJSONMarshaler struct {
+	hint.BaseHinter
+	From        mitumcurrency.CurrencyID `json:"from"`
+	To          mitumcurrency.CurrencyID `json:"to"`
+	Numerator   string                   `json:"numerator"`
+	Denominator string                   `json:"denominator"`
+}
+
+func (er ExchangeRate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExchangeRateJSONMarshaler{
+		BaseHinter:  er.BaseHinter,
+		From:        er.from,
+		To:          er.to,
+		Numerator:   er.numerator.String(),
+		Denominator: er.denominator.String(),
+	})
+}
+
+type ExchangeRateJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	From        string    `json:"from"`
+	To          string    `json:"to"`
+	Numerator   string    `json:"numerator"`
+	Denominator string    `json:"denominator"`
+}
+
+func (er *ExchangeRate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExchangeRate")
+
+	var uer ExchangeRateJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uer); err != nil {
+		return e(err, "")
+	}
+
+	return er.unpack(uer.Hint, uer.From, uer.To, uer.Numerator, uer.Denominator)
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ExchangeRateUpdaterFactHint = hint.MustNewHint("mitum-currency-exchange-rate-updater-operation-fact-v0.0.1")
+	ExchangeRateUpdaterHint     = hint.MustNewHint("mitum-currency-exchange-rate-updater-operation-v0.0.1")
+)
+
+type ExchangeRateUpdaterFact struct {
+	base.BaseFact
+	rate ExchangeRate
+}
+
+func NewExchangeRateUpdaterFact(token []byte, rate ExchangeRate) ExchangeRateUpdaterFact {
+	fact := ExchangeRateUpdaterFact{
+		BaseFact: base.NewBaseFact(ExchangeRateUpdaterFactHint, token),
+		rate:     rate,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ExchangeRateUpdaterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ExchangeRateUpdaterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.rate.Bytes(),
+	)
+}
+
+func (fact ExchangeRateUpdaterFact) IsValid(b []byte) error {
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.rate); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact ExchangeRateUpdaterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ExchangeRateUpdaterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ExchangeRateUpdaterFact) Rate() ExchangeRate {
+	return fact.rate
+}
+
+type ExchangeRateUpdater struct {
+	mitumcurrency.BaseNodeOperation
+}
+
+func NewExchangeRateUpdater(fact ExchangeRateUpdaterFact) (ExchangeRateUpdater, error) {
+	return ExchangeRateUpdater{BaseNodeOperation: mitumcurrency.NewBaseNodeOperation(ExchangeRateUpdaterHint, fact)}, nil
+}
@@ -0,0 +1,20 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ExchangeRateUpdaterFact) unpack(enc encoder.Encoder, bra []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal ExchangeRateUpdaterFact")
+
+	if hinter, err := enc.Decode(bra); err != nil {
+		return e(err, "")
+	} else if rate, ok := hinter.(ExchangeRate); !ok {
+		return e(util.ErrWrongType.Errorf("expected ExchangeRate, not %T", hinter), "")
+	} else {
+		fact.rate = rate
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ExchangeRateUpdaterFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Rate ExchangeRate `json:"rate"`
+}
+
+func (fact ExchangeRateUpdaterFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExchangeRateUpdaterFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Rate:                  fact.rate,
+	})
+}
+
+type ExchangeRateUpdaterFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Rate json.RawMessage `json:"rate"`
+}
+
+func (fact *ExchangeRateUpdaterFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExchangeRateUpdaterFact")
+
+	var uf ExchangeRateUpdaterFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Rate)
+}
+
+type exchangeRateUpdaterMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ExchangeRateUpdater) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(exchangeRateUpdaterMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ExchangeRateUpdater) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExchangeRateUpdater")
+
+	var ubo mitumcurrency.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var exchangeRateUpdaterProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ExchangeRateUpdaterProcessor)
+	},
+}
+
+type ExchangeRateUpdaterProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewExchangeRateUpdaterProcessor(threshold base.Threshold) GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ExchangeRateUpdaterProcessor")
+
+		nopp := exchangeRateUpdaterProcessorPool.Get()
+		opp, ok := nopp.(*ExchangeRateUpdaterProcessor)
+		if !ok {
+			return nil, e(nil, "expected ExchangeRateUpdaterProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e(err, "")
+		case !found, i == nil:
+			return nil, e(isaac.ErrStopProcessingRetry.Errorf("empty state"), "")
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"), "")
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *ExchangeRateUpdaterProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ExchangeRateUpdater")
+
+	nop, ok := op.(ExchangeRateUpdater)
+	if !ok {
+		return ctx, nil, e(nil, "expected ExchangeRateUpdater, not %T", op)
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not enough signs: %w", err), nil
+	}
+
+	fact, ok := op.Fact().(ExchangeRateUpdaterFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ExchangeRateUpdaterFact, not %T", op.Fact())
+	}
+
+	rate := fact.rate
+	if err := checkExistsState(StateKeyCurrencyDesign(rate.From()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", rate.From(), err), nil
+	}
+
+	if err := checkExistsState(StateKeyCurrencyDesign(rate.To()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", rate.To(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ExchangeRateUpdaterProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ExchangeRateUpdater")
+
+	fact, ok := op.Fact().(ExchangeRateUpdaterFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ExchangeRateUpdaterFact, not %T", op.Fact())
+	}
+
+	sts := make([]base.StateMergeValue, 1)
+
+	sts[0] = NewExchangeRateStateMergeValue(
+		StateKeyExchangeRate(fact.rate.From(), fact.rate.To()),
+		NewExchangeRateStateValue(fact.rate),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *ExchangeRateUpdaterProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	exchangeRateUpdaterProcessorPool.Put(opp)
+
+	return nil
+}
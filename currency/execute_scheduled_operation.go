@@ -0,0 +1,95 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ExecuteScheduledOperationFactHint = hint.MustNewHint("mitum-currency-execute-scheduled-operation-operation-fact-v0.0.1")
+	ExecuteScheduledOperationHint     = hint.MustNewHint("mitum-currency-execute-scheduled-operation-operation-v0.0.1")
+)
+
+type ExecuteScheduledOperationFact struct {
+	base.BaseFact
+	sender   base.Address
+	schedule util.Hash
+}
+
+func NewExecuteScheduledOperationFact(token []byte, sender base.Address, schedule util.Hash) ExecuteScheduledOperationFact {
+	bf := base.NewBaseFact(ExecuteScheduledOperationFactHint, token)
+	fact := ExecuteScheduledOperationFact{
+		BaseFact: bf,
+		sender:   sender,
+		schedule: schedule,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ExecuteScheduledOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ExecuteScheduledOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ExecuteScheduledOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ExecuteScheduledOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.schedule.Bytes(),
+	)
+}
+
+func (fact ExecuteScheduledOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.schedule)
+}
+
+func (fact ExecuteScheduledOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ExecuteScheduledOperationFact) Schedule() util.Hash {
+	return fact.schedule
+}
+
+func (fact ExecuteScheduledOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// ExecuteScheduledOperation processes the scheduled operation at or after the
+// target height; any account can send it, and the sender pays no fee since the
+// fee is reserved by ScheduleOperation.
+type ExecuteScheduledOperation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewExecuteScheduledOperation(fact ExecuteScheduledOperationFact) (ExecuteScheduledOperation, error) {
+	return ExecuteScheduledOperation{BaseOperation: mitumcurrency.NewBaseOperation(ExecuteScheduledOperationHint, fact)}, nil
+}
+
+func (op *ExecuteScheduledOperation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ExecuteScheduledOperationFact) unpack(enc encoder.Encoder, sd string, schedule util.Hash) error {
+	e := util.StringErrorFunc("failed to unmarshal ExecuteScheduledOperationFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	fact.schedule = schedule
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ExecuteScheduledOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address `json:"sender"`
+	Schedule util.Hash    `json:"schedule"`
+}
+
+func (fact ExecuteScheduledOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExecuteScheduledOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Schedule:              fact.schedule,
+	})
+}
+
+type ExecuteScheduledOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string                `json:"sender"`
+	Schedule valuehash.HashDecoder `json:"schedule"`
+}
+
+func (fact *ExecuteScheduledOperationFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExecuteScheduledOperationFact")
+
+	var uf ExecuteScheduledOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Schedule.Hash())
+}
+
+type executeScheduledOperationMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ExecuteScheduledOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(executeScheduledOperationMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ExecuteScheduledOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExecuteScheduledOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var executeScheduledOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ExecuteScheduledOperationProcessor)
+	},
+}
+
+func (ExecuteScheduledOperation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ExecuteScheduledOperationProcessor struct {
+	*base.BaseOperationProcessor
+	getNewProcessorByHint GetNewProcessorByHint
+}
+
+func NewExecuteScheduledOperationProcessor(getNewProcessorByHint GetNewProcessorByHint) GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ExecuteScheduledOperationProcessor")
+
+		nopp := executeScheduledOperationProcessorPool.Get()
+		opp, ok := nopp.(*ExecuteScheduledOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ExecuteScheduledOperationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getNewProcessorByHint = getNewProcessorByHint
+
+		return opp, nil
+	}
+}
+
+func (opp *ExecuteScheduledOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ExecuteScheduledOperation")
+
+	fact, ok := op.Fact().(ExecuteScheduledOperationFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ExecuteScheduledOperationFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	so, rerr := checkScheduledOperation(fact.schedule, getStateFunc)
+	if rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if !so.IsDue(opp.Height()) {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"scheduled operation not yet due, %q; %d < %d", fact.schedule, opp.Height(), so.Height()), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ExecuteScheduledOperationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ExecuteScheduledOperation")
+
+	fact, ok := op.Fact().(ExecuteScheduledOperationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ExecuteScheduledOperationFact, not %T", op.Fact())
+	}
+
+	so, rerr := checkScheduledOperation(fact.schedule, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	sts, rerr, err := executeScheduledOperation(ctx, so, opp.getNewProcessorByHint, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, nil, e(err, "")
+	}
+
+	return sts, rerr, nil
+}
+
+func (opp *ExecuteScheduledOperationProcessor) Close() error {
+	opp.getNewProcessorByHint = nil
+	executeScheduledOperationProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+// FeeExchangeFact is the fact which pays the fee in the other currency,
+// FeeCurrency; empty FeeCurrency means the fee is paid in the currency of
+// amount.
+type FeeExchangeFact interface {
+	FeeCurrency() mitumcurrency.CurrencyID
+}
+
+// ExchangeItemsFee moves the fees of required, which comes from
+// CalculateItemsFee, to the fee currency by the exchange rate state. The fee
+// under the ExchangeMin of feeer is exchanged as ExchangeMin.
+func ExchangeItemsFee(
+	getStateFunc base.GetStateFunc,
+	fact hint.Hint,
+	required map[mitumcurrency.CurrencyID][2]mitumcurrency.Big,
+	feeCurrency mitumcurrency.CurrencyID,
+) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
+	if len(feeCurrency) < 1 {
+		return required, nil
+	}
+
+	if _, err := existsActiveCurrencyPolicy(feeCurrency, getStateFunc); err != nil {
+		return nil, err
+	}
+
+	exchanged := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+	fee := mitumcurrency.ZeroBig
+
+	for cid := range required {
+		rq := required[cid]
+
+		if cid == feeCurrency || !rq[1].OverZero() {
+			exchanged[cid] = rq
+
+			continue
+		}
+
+		policy, err := existsActiveCurrencyPolicy(cid, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		k := rq[1]
+		if em := policy.FeeerOf(fact).ExchangeMin(); em.OverZero() && k.Compare(em) < 0 {
+			k = em
+		}
+
+		rate, err := existsExchangeRate(cid, feeCurrency, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		fee = fee.Add(rate.Exchange(k))
+		exchanged[cid] = [2]mitumcurrency.Big{rq[0].Sub(rq[1]), mitumcurrency.ZeroBig}
+	}
+
+	if fee.OverZero() {
+		rq := [2]mitumcurrency.Big{mitumcurrency.ZeroBig, mitumcurrency.ZeroBig}
+		if k, found := exchanged[feeCurrency]; found {
+			rq = k
+		}
+
+		exchanged[feeCurrency] = [2]mitumcurrency.Big{rq[0].Add(fee), rq[1].Add(fee)}
+	}
+
+	return exchanged, nil
+}
+
+func existsExchangeRate(from, to mitumcurrency.CurrencyID, getStateFunc base.GetStateFunc) (ExchangeRate, error) {
+	switch st, found, err := getStateFunc(StateKeyExchangeRate(from, to)); {
+	case err != nil:
+		return ExchangeRate{}, err
+	case !found:
+		return ExchangeRate{}, errors.Errorf("exchange rate not found, %q -> %q", from, to)
+	default:
+		return StateExchangeRateValue(st)
+	}
+}
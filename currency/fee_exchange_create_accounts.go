@@ -0,0 +1,166 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FeeExchangeCreateAccountsFactHint = hint.MustNewHint("mitum-currency-fee-exchange-create-accounts-operation-fact-v0.0.1")
+	FeeExchangeCreateAccountsHint     = hint.MustNewHint("mitum-currency-fee-exchange-create-accounts-operation-v0.0.1")
+)
+
+type FeeExchangeCreateAccountsFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []mitumcurrency.CreateAccountsItem
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewFeeExchangeCreateAccountsFact(
+	token []byte,
+	sender base.Address,
+	items []mitumcurrency.CreateAccountsItem,
+	feeCurrency mitumcurrency.CurrencyID,
+) FeeExchangeCreateAccountsFact {
+	bf := base.NewBaseFact(FeeExchangeCreateAccountsFactHint, token)
+	fact := FeeExchangeCreateAccountsFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FeeExchangeCreateAccountsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FeeExchangeCreateAccountsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FeeExchangeCreateAccountsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FeeExchangeCreateAccountsFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feeCurrency.Bytes(),
+	)
+}
+
+func (fact FeeExchangeCreateAccountsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxCreateAccountsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxCreateAccountsItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feeCurrency); err != nil {
+		return err
+	}
+
+	foundKeys := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Keys().Hash().String()
+		if _, found := foundKeys[k]; found {
+			return util.ErrInvalid.Errorf("duplicate account Keys found, %s", k)
+		}
+
+		switch a, err := it.Address(); {
+		case err != nil:
+			return err
+		case fact.sender.Equal(a):
+			return util.ErrInvalid.Errorf("target address is same with sender, %q", fact.sender)
+		default:
+			foundKeys[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact FeeExchangeCreateAccountsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FeeExchangeCreateAccountsFact) Items() []mitumcurrency.CreateAccountsItem {
+	return fact.items
+}
+
+func (fact FeeExchangeCreateAccountsFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact FeeExchangeCreateAccountsFact) Targets() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items))
+	for i := range fact.items {
+		a, err := fact.items[i].Address()
+		if err != nil {
+			return nil, err
+		}
+		as[i] = a
+	}
+
+	return as, nil
+}
+
+func (fact FeeExchangeCreateAccountsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+
+	tas, err := fact.Targets()
+	if err != nil {
+		return nil, err
+	}
+	copy(as, tas)
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+// FeeExchangeCreateAccounts is CreateAccounts which pays the fee in the other
+// currency by the exchange rate.
+type FeeExchangeCreateAccounts struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewFeeExchangeCreateAccounts(fact FeeExchangeCreateAccountsFact) (FeeExchangeCreateAccounts, error) {
+	return FeeExchangeCreateAccounts{BaseOperation: mitumcurrency.NewBaseOperation(FeeExchangeCreateAccountsHint, fact)}, nil
+}
+
+func (op *FeeExchangeCreateAccounts) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
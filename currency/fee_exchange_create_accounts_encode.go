@@ -0,0 +1,38 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *FeeExchangeCreateAccountsFact) unpack(enc encoder.Encoder, sd string, bit []byte, fc string) error {
+	e := util.StringErrorFunc("failed to unmarshal FeeExchangeCreateAccountsFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return e(err, "")
+	}
+
+	items := make([]mitumcurrency.CreateAccountsItem, len(hit))
+	for i := range hit {
+		j, ok := hit[i].(mitumcurrency.CreateAccountsItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected CreateAccountsItem, not %T", hit[i]), "")
+		}
+
+		items[i] = j
+	}
+	fact.items = items
+	fact.feeCurrency = mitumcurrency.CurrencyID(fc)
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type FeeExchangeCreateAccountsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address                       `json:"sender"`
+	Items       []mitumcurrency.CreateAccountsItem `json:"items"`
+	FeeCurrency mitumcurrency.CurrencyID           `json:"fee_currency"`
+}
+
+func (fact FeeExchangeCreateAccountsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeExchangeCreateAccountsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+		FeeCurrency:           fact.feeCurrency,
+	})
+}
+
+type FeeExchangeCreateAccountsFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeeCurrency string          `json:"fee_currency"`
+}
+
+func (fact *FeeExchangeCreateAccountsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FeeExchangeCreateAccountsFact")
+
+	var uf FeeExchangeCreateAccountsFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeeCurrency)
+}
+
+type feeExchangeCreateAccountsMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op FeeExchangeCreateAccounts) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(feeExchangeCreateAccountsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *FeeExchangeCreateAccounts) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FeeExchangeCreateAccounts")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
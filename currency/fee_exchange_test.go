@@ -0,0 +1,240 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testFeeExchange struct {
+	testProcessorSuite
+	cid         mitumcurrency.CurrencyID
+	feeCID      mitumcurrency.CurrencyID
+	states      *testStates
+	sender      base.Address
+	priv        base.Privatekey
+	receiver    base.Address
+	feeReceiver base.Address
+}
+
+func (t *testFeeExchange) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.feeCID = mitumcurrency.CurrencyID("FINDME")
+	t.states = newTestStates(base.Height(33))
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+	t.states.setBalance(sender, t.feeCID, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+	t.states.setBalance(feeReceiver, t.feeCID, 0)
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(10), mitumcurrency.ZeroBig)))
+	t.states.setCurrency(t.feeCID, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(1), mitumcurrency.ZeroBig)))
+
+	t.sender, t.priv, t.receiver, t.feeReceiver = sender, privs[0], receiver, feeReceiver
+}
+
+func (t *testFeeExchange) transfers(big int64) FeeExchangeTransfers {
+	op, err := NewFeeExchangeTransfers(NewFeeExchangeTransfersFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.TransfersItem{
+			mitumcurrency.NewTransfersItemMultiAmounts(
+				t.receiver,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+			),
+		},
+		t.feeCID,
+	))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testFeeExchange) TestUpdateExchangeRate() {
+	node, npriv := t.states.setSuffrage()
+
+	op, err := NewExchangeRateUpdater(NewExchangeRateUpdaterFact(
+		util.UUID().Bytes(),
+		NewExchangeRate(t.cid, t.feeCID, mitumcurrency.NewBig(1), mitumcurrency.NewBig(2)),
+	))
+	t.NoError(err)
+	t.NoError(op.NodeSign(npriv, t.networkID, node))
+
+	t.Nil(t.process(NewExchangeRateUpdaterProcessor(base.Threshold(100)), op, t.states))
+
+	st, found, err := t.states.getStateFunc(StateKeyExchangeRate(t.cid, t.feeCID))
+	t.NoError(err)
+	t.True(found)
+
+	rate, err := StateExchangeRateValue(st)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(5), rate.Exchange(mitumcurrency.NewBig(10)))
+
+	t.Run("unknown currency", func() {
+		op, err := NewExchangeRateUpdater(NewExchangeRateUpdaterFact(
+			util.UUID().Bytes(),
+			NewExchangeRate(t.cid, mitumcurrency.CurrencyID("KILLME"), mitumcurrency.NewBig(1), mitumcurrency.NewBig(2)),
+		))
+		t.NoError(err)
+		t.NoError(op.NodeSign(npriv, t.networkID, node))
+
+		reason := t.preProcess(NewExchangeRateUpdaterProcessor(base.Threshold(100)), op, t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "currency not found")
+	})
+}
+
+func (t *testFeeExchange) TestTransfers() {
+	t.states.set(
+		StateKeyExchangeRate(t.cid, t.feeCID),
+		NewExchangeRateStateValue(NewExchangeRate(t.cid, t.feeCID, mitumcurrency.NewBig(1), mitumcurrency.NewBig(2))),
+	)
+
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(30), t.states))
+
+	// NOTE the fee, 10 of SHOWME is paid by 5 of FINDME
+	t.Equal(mitumcurrency.NewBig(70), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(95), t.states.balance(t.sender, t.feeCID))
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.receiver, t.cid))
+	t.True(t.states.balance(t.feeReceiver, t.cid).IsZero())
+	t.Equal(mitumcurrency.NewBig(5), t.states.balance(t.feeReceiver, t.feeCID))
+}
+
+func (t *testFeeExchange) TestExchangeRateNotFound() {
+	reason := t.process(NewTransfersProcessor(), t.transfers(30), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "exchange rate not found")
+
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.feeCID))
+}
+
+func TestFeeExchange(t *testing.T) {
+	suite.Run(t, new(testFeeExchange))
+}
+
+func TestFeeExchangeTransfersFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: mitumcurrency.TransfersItemMultiAmountsHint, Instance: mitumcurrency.TransfersItemMultiAmounts{},
+		}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: FeeExchangeTransfersFactHint, Instance: FeeExchangeTransfersFact{}}))
+
+		fact := NewFeeExchangeTransfersFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.TransfersItem{
+				mitumcurrency.NewTransfersItemMultiAmounts(
+					mitumcurrency.NewAddress(util.UUID().String()),
+					[]mitumcurrency.Amount{
+						mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+					},
+				),
+			},
+			mitumcurrency.CurrencyID("FINDME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(FeeExchangeTransfersFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(FeeExchangeTransfersFact)
+		t.True(ok)
+		bf, ok := b.(FeeExchangeTransfersFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.FeeCurrency(), bf.FeeCurrency())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestExchangeRateUpdaterFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: ExchangeRateHint, Instance: ExchangeRate{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: ExchangeRateUpdaterFactHint, Instance: ExchangeRateUpdaterFact{}}))
+
+		fact := NewExchangeRateUpdaterFact(
+			util.UUID().Bytes(),
+			NewExchangeRate(
+				mitumcurrency.CurrencyID("SHOWME"),
+				mitumcurrency.CurrencyID("FINDME"),
+				mitumcurrency.NewBig(3),
+				mitumcurrency.NewBig(7),
+			),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ExchangeRateUpdaterFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ExchangeRateUpdaterFact)
+		t.True(ok)
+		bf, ok := b.(ExchangeRateUpdaterFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Rate().Bytes(), bf.Rate().Bytes())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,146 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FeeExchangeTransfersFactHint = hint.MustNewHint("mitum-currency-fee-exchange-transfers-operation-fact-v0.0.1")
+	FeeExchangeTransfersHint     = hint.MustNewHint("mitum-currency-fee-exchange-transfers-operation-v0.0.1")
+)
+
+type FeeExchangeTransfersFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []mitumcurrency.TransfersItem
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewFeeExchangeTransfersFact(
+	token []byte,
+	sender base.Address,
+	items []mitumcurrency.TransfersItem,
+	feeCurrency mitumcurrency.CurrencyID,
+) FeeExchangeTransfersFact {
+	bf := base.NewBaseFact(FeeExchangeTransfersFactHint, token)
+	fact := FeeExchangeTransfersFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FeeExchangeTransfersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FeeExchangeTransfersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FeeExchangeTransfersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FeeExchangeTransfersFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feeCurrency.Bytes(),
+	)
+}
+
+func (fact FeeExchangeTransfersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feeCurrency); err != nil {
+		return err
+	}
+
+	foundReceivers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Receiver().String()
+		switch _, found := foundReceivers[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate receiver found, %s", it.Receiver())
+		case fact.sender.Equal(it.Receiver()):
+			return util.ErrInvalid.Errorf("receiver is same with sender, %q", fact.sender)
+		default:
+			foundReceivers[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact FeeExchangeTransfersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FeeExchangeTransfersFact) Items() []mitumcurrency.TransfersItem {
+	return fact.items
+}
+
+func (fact FeeExchangeTransfersFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact FeeExchangeTransfersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+	for i := range fact.items {
+		as[i] = fact.items[i].Receiver()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+// FeeExchangeTransfers is Transfers which pays the fee in the other currency
+// by the exchange rate.
+type FeeExchangeTransfers struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewFeeExchangeTransfers(fact FeeExchangeTransfersFact) (FeeExchangeTransfers, error) {
+	return FeeExchangeTransfers{BaseOperation: mitumcurrency.NewBaseOperation(FeeExchangeTransfersHint, fact)}, nil
+}
+
+func (op *FeeExchangeTransfers) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type FeeExchangeTransfersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address                  `json:"sender"`
+	Items       []mitumcurrency.TransfersItem `json:"items"`
+	FeeCurrency mitumcurrency.CurrencyID      `json:"fee_currency"`
+}
+
+func (fact FeeExchangeTransfersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeExchangeTransfersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+		FeeCurrency:           fact.feeCurrency,
+	})
+}
+
+type FeeExchangeTransfersFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeeCurrency string          `json:"fee_currency"`
+}
+
+func (fact *FeeExchangeTransfersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FeeExchangeTransfersFact")
+
+	var uf FeeExchangeTransfersFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeeCurrency)
+}
+
+type feeExchangeTransfersMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op FeeExchangeTransfers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(feeExchangeTransfersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *FeeExchangeTransfers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FeeExchangeTransfers")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FeeExchangeWithdrawsFactHint = hint.MustNewHint("mitum-currency-fee-exchange-withdraws-operation-fact-v0.0.1")
+	FeeExchangeWithdrawsHint     = hint.MustNewHint("mitum-currency-fee-exchange-withdraws-operation-v0.0.1")
+)
+
+type FeeExchangeWithdrawsFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []WithdrawsItem
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewFeeExchangeWithdrawsFact(
+	token []byte,
+	sender base.Address,
+	items []WithdrawsItem,
+	feeCurrency mitumcurrency.CurrencyID,
+) FeeExchangeWithdrawsFact {
+	bf := base.NewBaseFact(FeeExchangeWithdrawsFactHint, token)
+	fact := FeeExchangeWithdrawsFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FeeExchangeWithdrawsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FeeExchangeWithdrawsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FeeExchangeWithdrawsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FeeExchangeWithdrawsFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feeCurrency.Bytes(),
+	)
+}
+
+func (fact FeeExchangeWithdrawsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxWithdrawsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxWithdrawsItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feeCurrency); err != nil {
+		return err
+	}
+
+	foundTargets := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Target().String()
+		switch _, found := foundTargets[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate target found, %s", it.Target())
+		case fact.sender.Equal(it.Target()):
+			return util.ErrInvalid.Errorf("target is same with sender, %q", fact.sender)
+		default:
+			foundTargets[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact FeeExchangeWithdrawsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact FeeExchangeWithdrawsFact) Items() []WithdrawsItem {
+	return fact.items
+}
+
+func (fact FeeExchangeWithdrawsFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact FeeExchangeWithdrawsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+	for i := range fact.items {
+		as[i] = fact.items[i].Target()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+// FeeExchangeWithdraws is Withdraws which pays the fee in the other currency
+// by the exchange rate.
+type FeeExchangeWithdraws struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewFeeExchangeWithdraws(fact FeeExchangeWithdrawsFact) (FeeExchangeWithdraws, error) {
+	return FeeExchangeWithdraws{BaseOperation: mitumcurrency.NewBaseOperation(FeeExchangeWithdrawsHint, fact)}, nil
+}
+
+func (op *FeeExchangeWithdraws) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
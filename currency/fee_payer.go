@@ -0,0 +1,95 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// FeePayerFact is the fact of which fee is paid by FeePayer, the sponsoring
+// account, instead of the sender; nil FeePayer means the fee is paid by the
+// sender.
+type FeePayerFact interface {
+	FeePayer() base.Address
+}
+
+func feePayerOf(fact base.Fact) base.Address {
+	if i, ok := fact.(FeePayerFact); ok {
+		return i.FeePayer()
+	}
+
+	return nil
+}
+
+// checkSenderSigns checks the signs of sender; if the fact has the fee payer,
+// the operation also should be signed by the fee payer.
+func checkSenderSigns(op base.Operation, sender base.Address, getStateFunc base.GetStateFunc) error {
+	if err := checkFactSignsByState(sender, op.Signs(), getStateFunc); err != nil {
+		return err
+	}
+
+	feePayer := feePayerOf(op.Fact())
+	if feePayer == nil {
+		return nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(feePayer), getStateFunc); err != nil {
+		return base.NewBaseOperationProcessReasonError("fee payer not found, %q: %w", feePayer, err)
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(feePayer), getStateFunc); err != nil {
+		return base.NewBaseOperationProcessReasonError("contract account cannot be fee payer, %q: %w", feePayer, err)
+	}
+
+	if err := checkFactSignsByState(feePayer, op.Signs(), getStateFunc); err != nil {
+		return base.NewBaseOperationProcessReasonError("invalid signing of fee payer, %q: %w", feePayer, err)
+	}
+
+	return nil
+}
+
+// PayFeeByFeePayer moves the fees of required, which comes from
+// CalculateItemsFee, to the fee payer. It returns the required of sender
+// without fees and the balance states of fee payer charged by the fees.
+func PayFeeByFeePayer(
+	feePayer base.Address,
+	required map[mitumcurrency.CurrencyID][2]mitumcurrency.Big,
+	getStateFunc base.GetStateFunc,
+) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, []base.StateMergeValue, error) {
+	if feePayer == nil {
+		return required, nil, nil
+	}
+
+	sr := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+	fr := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+
+	for cid := range required {
+		rq := required[cid]
+
+		if rq[1].OverZero() {
+			fr[cid] = [2]mitumcurrency.Big{rq[1], rq[1]}
+		}
+
+		if k := rq[0].Sub(rq[1]); k.OverZero() {
+			sr[cid] = [2]mitumcurrency.Big{k, mitumcurrency.ZeroBig}
+		}
+	}
+
+	fb, err := CheckEnoughDebitBalance(feePayer, fr, getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sts := make([]base.StateMergeValue, 0, len(fr))
+	for cid := range fr {
+		v, ok := fb[cid].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, errors.Errorf("expected BalanceStateValue, not %T", fb[cid].Value())
+		}
+
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fr[cid][0])))
+		sts = append(sts, NewBalanceStateMergeValue(fb[cid].Key(), stv))
+	}
+
+	return sr, sts, nil
+}
@@ -0,0 +1,166 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testFeePayer struct {
+	testProcessorSuite
+	cid         mitumcurrency.CurrencyID
+	states      *testStates
+	sender      base.Address
+	priv        base.Privatekey
+	feePayer    base.Address
+	fpriv       base.Privatekey
+	receiver    base.Address
+	feeReceiver base.Address
+}
+
+func (t *testFeePayer) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 30)
+
+	feePayer, fprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feePayer, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(10), mitumcurrency.ZeroBig)))
+
+	t.sender, t.priv, t.feePayer, t.fpriv = sender, privs[0], feePayer, fprivs[0]
+	t.receiver, t.feeReceiver = receiver, feeReceiver
+}
+
+func (t *testFeePayer) transfers(big int64, privs ...base.Privatekey) SponsoredTransfers {
+	op, err := NewSponsoredTransfers(NewSponsoredTransfersFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.TransfersItem{
+			mitumcurrency.NewTransfersItemMultiAmounts(
+				t.receiver,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+			),
+		},
+		t.feePayer,
+		"",
+	))
+	t.NoError(err)
+
+	for i := range privs {
+		t.NoError(op.HashSign(privs[i], t.networkID))
+	}
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testFeePayer) TestTransfers() {
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(30, t.priv, t.fpriv), t.states))
+
+	// NOTE the sender pays only the amount and the fee payer pays the fee
+	t.True(t.states.balance(t.sender, t.cid).IsZero())
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.feePayer, t.cid))
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.receiver, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.feeReceiver, t.cid))
+}
+
+func (t *testFeePayer) TestNotSignedByFeePayer() {
+	reason := t.preProcess(NewTransfersProcessor(), t.transfers(30, t.priv), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "invalid signing of fee payer")
+}
+
+func (t *testFeePayer) TestNotEnoughBalanceOfFeePayer() {
+	t.states.setBalance(t.feePayer, t.cid, 9)
+
+	reason := t.process(NewTransfersProcessor(), t.transfers(30, t.priv, t.fpriv), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "failed to pay fee by fee payer")
+
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(9), t.states.balance(t.feePayer, t.cid))
+}
+
+func TestFeePayer(t *testing.T) {
+	suite.Run(t, new(testFeePayer))
+}
+
+func TestSponsoredTransfersFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: mitumcurrency.TransfersItemMultiAmountsHint, Instance: mitumcurrency.TransfersItemMultiAmounts{},
+		}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: SponsoredTransfersFactHint, Instance: SponsoredTransfersFact{}}))
+
+		fact := NewSponsoredTransfersFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.TransfersItem{
+				mitumcurrency.NewTransfersItemMultiAmounts(
+					mitumcurrency.NewAddress(util.UUID().String()),
+					[]mitumcurrency.Amount{
+						mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+					},
+				),
+			},
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.CurrencyID("FINDME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(SponsoredTransfersFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(SponsoredTransfersFact)
+		t.True(ok)
+		bf, ok := b.(SponsoredTransfersFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.True(af.FeePayer().Equal(bf.FeePayer()))
+		t.Equal(af.FeeCurrency(), bf.FeeCurrency())
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,84 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+// RequiredFees returns the fees of required, which comes from
+// CalculateItemsFee.
+func RequiredFees(
+	required map[mitumcurrency.CurrencyID][2]mitumcurrency.Big,
+) map[mitumcurrency.CurrencyID]mitumcurrency.Big {
+	fees := map[mitumcurrency.CurrencyID]mitumcurrency.Big{}
+
+	for cid := range required {
+		if rq := required[cid]; rq[1].OverZero() {
+			fees[cid] = rq[1]
+		}
+	}
+
+	return fees
+}
+
+// CollectFee credits the fee to the receiver of feeer of currency for the
+// operation, of which fact has the fact hint.
+func CollectFee(
+	fact hint.Hint,
+	cid mitumcurrency.CurrencyID,
+	fee mitumcurrency.Big,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	return CollectFees(fact, map[mitumcurrency.CurrencyID]mitumcurrency.Big{cid: fee}, getStateFunc)
+}
+
+// CollectFees credits the fees to the receivers of feeers and adds them to the
+// fee states of currencies; the fee of SplitFeeer is split across its
+// receivers. The receiver balance and the fee state are merged
+// by adding, so the fees of the operations in the same block are all kept.
+func CollectFees(
+	fact hint.Hint,
+	fees map[mitumcurrency.CurrencyID]mitumcurrency.Big,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for cid := range fees {
+		fee := fees[cid]
+		if !fee.OverZero() {
+			continue
+		}
+
+		policy, err := existsCurrencyPolicy(cid, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		switch feeer := policy.FeeerOf(fact).(type) {
+		case SplitFeeer:
+			shares := feeer.Split(fee)
+			for i := range feeer.Receivers() {
+				if !shares[i].OverZero() {
+					continue
+				}
+
+				sts = append(sts, NewBalanceStateMergeValue(
+					mitumcurrency.StateKeyBalance(feeer.Receivers()[i].Receiver(), cid),
+					NewAddBalanceStateValue(mitumcurrency.NewAmount(shares[i], cid)),
+				))
+			}
+		default:
+			if receiver := feeer.Receiver(); receiver != nil {
+				sts = append(sts, NewBalanceStateMergeValue(
+					mitumcurrency.StateKeyBalance(receiver, cid),
+					NewAddBalanceStateValue(mitumcurrency.NewAmount(fee, cid)),
+				))
+			}
+		}
+
+		sts = append(sts, NewFeeStateMergeValue(StateKeyFee(cid), NewFeeStateValue(cid, fee, fee)))
+	}
+
+	return sts, nil
+}
@@ -0,0 +1,165 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testFeeReceiver struct {
+	testProcessorSuite
+	cid         mitumcurrency.CurrencyID
+	states      *testStates
+	sender      base.Address
+	priv        base.Privatekey
+	receiver    base.Address
+	feeReceiver base.Address
+}
+
+func (t *testFeeReceiver) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(feeReceiver, mitumcurrency.NewBig(10), mitumcurrency.ZeroBig)))
+
+	t.sender, t.priv, t.receiver, t.feeReceiver = sender, privs[0], receiver, feeReceiver
+}
+
+func (t *testFeeReceiver) transfers(big int64) mitumcurrency.Transfers {
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), t.sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testFeeReceiver) fee() FeeStateValue {
+	st, found, err := t.states.getStateFunc(StateKeyFee(t.cid))
+	t.NoError(err)
+	t.True(found)
+
+	fv, err := StateFeeValue(st)
+	t.NoError(err)
+
+	return fv
+}
+
+func (t *testFeeReceiver) TestCollectFee() {
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(30), t.states))
+
+	t.Equal(mitumcurrency.NewBig(60), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.receiver, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.feeReceiver, t.cid))
+
+	// NOTE the fee is credited, so the aggregate is not changed
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1000000), de.Aggregate())
+
+	fv := t.fee()
+	t.Equal(mitumcurrency.NewBig(10), fv.Fee())
+	t.Equal(mitumcurrency.NewBig(10), fv.Total())
+
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(20), t.states))
+
+	t.Equal(mitumcurrency.NewBig(20), t.states.balance(t.feeReceiver, t.cid))
+
+	fv = t.fee()
+	t.Equal(mitumcurrency.NewBig(10), fv.Fee())
+	t.Equal(mitumcurrency.NewBig(20), fv.Total())
+}
+
+func (t *testFeeReceiver) TestFeesInSameBlock() {
+	var sts []base.StateMergeValue
+
+	for i := 0; i < 3; i++ {
+		s, err := CollectFee(mitumcurrency.TransfersFactHint, t.cid, mitumcurrency.NewBig(10), t.states.getStateFunc)
+		t.NoError(err)
+
+		sts = append(sts, s...)
+	}
+
+	t.NoError(t.states.merge(sts))
+
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.feeReceiver, t.cid))
+
+	fv := t.fee()
+	t.Equal(mitumcurrency.NewBig(30), fv.Fee())
+	t.Equal(mitumcurrency.NewBig(30), fv.Total())
+}
+
+func (t *testFeeReceiver) TestInvalidFeeStateValue() {
+	err := NewFeeStateValue(t.cid, mitumcurrency.NewBig(10), mitumcurrency.NewBig(9)).IsValid(nil)
+	t.Error(err)
+	t.ErrorContains(err, "total is less than fee")
+}
+
+func TestFeeReceiver(t *testing.T) {
+	suite.Run(t, new(testFeeReceiver))
+}
+
+func TestFeeStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: FeeStateValueHint, Instance: FeeStateValue{}}))
+
+		fv := NewFeeStateValue(mitumcurrency.CurrencyID("SHOWME"), mitumcurrency.NewBig(10), mitumcurrency.NewBig(33))
+		t.NoError(fv.IsValid(nil))
+
+		b, err := enc.Marshal(fv)
+		t.NoError(err)
+
+		return fv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(FeeStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(FeeStateValue)
+		t.True(ok)
+		bf, ok := b.(FeeStateValue)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+		t.Equal(af.HashBytes(), bf.HashBytes())
+		t.Equal(af.Currency(), bf.Currency())
+		t.Equal(af.Fee(), bf.Fee())
+		t.Equal(af.Total(), bf.Total())
+	}
+
+	suite.Run(tt, t)
+}
@@ -11,19 +11,30 @@ import (
 )
 
 const (
-	FeeerNil   = "nil"
-	FeeerFixed = "fixed"
-	FeeerRatio = "ratio"
+	FeeerNil    = "nil"
+	FeeerFixed  = "fixed"
+	FeeerRatio  = "ratio"
+	FeeerTiered = "tiered"
+	FeeerSplit  = "split"
 )
 
 var (
-	NilFeeerHint   = hint.MustNewHint("mitum-currency-nil-feeer-v0.0.1")
-	FixedFeeerHint = hint.MustNewHint("mitum-currency-fixed-feeer-v0.0.1")
-	RatioFeeerHint = hint.MustNewHint("mitum-currency-ratio-feeer-v0.0.1")
+	NilFeeerHint    = hint.MustNewHint("mitum-currency-nil-feeer-v0.0.1")
+	FixedFeeerHint  = hint.MustNewHint("mitum-currency-fixed-feeer-v0.0.1")
+	RatioFeeerHint  = hint.MustNewHint("mitum-currency-ratio-feeer-v0.0.1")
+	TieredFeeerHint = hint.MustNewHint("mitum-currency-tiered-feeer-v0.0.1")
+	SplitFeeerHint  = hint.MustNewHint("mitum-currency-split-feeer-v0.0.1")
 )
 
 var UnlimitedMaxFeeAmount = mitumcurrency.NewBig(-1)
 
+var MaxFeeTiers = 10
+
+var (
+	MaxFeeReceivers      = 10
+	FeeReceiverWeightSum = uint64(100)
+)
+
 type Feeer interface {
 	util.IsValider
 	hint.Hinter
@@ -235,6 +246,347 @@ func (fa RatioFeeer) isOne() bool {
 	return fa.ratio == 1
 }
 
+// FeeTier is the bracket of TieredFeeer; it is applied to the amount which
+// is equal or over min and under the min of next tier. The fee of tier is
+// fixed amount plus the amount multiplied by ratio.
+type FeeTier struct {
+	min    mitumcurrency.Big
+	amount mitumcurrency.Big
+	ratio  float64 // 0 >=, or <= 1.0
+}
+
+func NewFeeTier(min, amount mitumcurrency.Big, ratio float64) FeeTier {
+	return FeeTier{min: min, amount: amount, ratio: ratio}
+}
+
+func (ft FeeTier) Bytes() []byte {
+	var rb bytes.Buffer
+	_ = binary.Write(&rb, binary.BigEndian, ft.ratio)
+
+	return util.ConcatBytesSlice(ft.min.Bytes(), ft.amount.Bytes(), rb.Bytes())
+}
+
+func (ft FeeTier) IsValid([]byte) error {
+	if !ft.min.OverNil() {
+		return util.ErrInvalid.Errorf("fee tier min under zero")
+	}
+
+	if !ft.amount.OverNil() {
+		return util.ErrInvalid.Errorf("fee tier amount under zero")
+	}
+
+	if ft.ratio < 0 || ft.ratio > 1 {
+		return util.ErrInvalid.Errorf("invalid fee tier ratio, %v; it should be 0 >=, <= 1", ft.ratio)
+	}
+
+	return nil
+}
+
+func (ft FeeTier) Min() mitumcurrency.Big {
+	return ft.min
+}
+
+func (ft FeeTier) Amount() mitumcurrency.Big {
+	return ft.amount
+}
+
+func (ft FeeTier) Ratio() float64 {
+	return ft.ratio
+}
+
+func (ft FeeTier) Fee(a mitumcurrency.Big) mitumcurrency.Big {
+	if ft.ratio == 0 || a.IsZero() {
+		return ft.amount
+	}
+
+	return ft.amount.Add(a.MulFloat64(ft.ratio))
+}
+
+type TieredFeeer struct {
+	hint.BaseHinter
+	receiver    base.Address
+	tiers       []FeeTier
+	exchangeMin mitumcurrency.Big
+}
+
+func NewTieredFeeer(receiver base.Address, tiers []FeeTier, exchangeMin mitumcurrency.Big) TieredFeeer {
+	return TieredFeeer{
+		BaseHinter:  hint.NewBaseHinter(TieredFeeerHint),
+		receiver:    receiver,
+		tiers:       tiers,
+		exchangeMin: exchangeMin,
+	}
+}
+
+func (TieredFeeer) Type() string {
+	return FeeerTiered
+}
+
+func (fa TieredFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.tiers))
+	for i := range fa.tiers {
+		bs[i] = fa.tiers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(fa.receiver.Bytes(), util.ConcatBytesSlice(bs...), fa.exchangeMin.Bytes())
+}
+
+func (fa TieredFeeer) Receiver() base.Address {
+	return fa.receiver
+}
+
+func (fa TieredFeeer) Tiers() []FeeTier {
+	return fa.tiers
+}
+
+func (fa TieredFeeer) Min() mitumcurrency.Big {
+	return fa.tiers[0].amount
+}
+
+func (fa TieredFeeer) ExchangeMin() mitumcurrency.Big {
+	return fa.exchangeMin
+}
+
+func (fa TieredFeeer) Fee(a mitumcurrency.Big) (mitumcurrency.Big, error) {
+	tier := fa.tiers[0]
+	for i := range fa.tiers[1:] {
+		if a.Compare(fa.tiers[i+1].min) < 0 {
+			break
+		}
+
+		tier = fa.tiers[i+1]
+	}
+
+	return tier.Fee(a), nil
+}
+
+func (fa TieredFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fa.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid receiver for tiered feeer: %w", err)
+	}
+
+	if n := len(fa.tiers); n < 1 {
+		return util.ErrInvalid.Errorf("empty tiers of tiered feeer")
+	} else if n > MaxFeeTiers {
+		return util.ErrInvalid.Errorf("tiers of tiered feeer, %d over max, %d", n, MaxFeeTiers)
+	}
+
+	for i := range fa.tiers {
+		if err := fa.tiers[i].IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid tier of tiered feeer: %w", err)
+		}
+
+		if i == 0 {
+			if !fa.tiers[i].min.IsZero() {
+				return util.ErrInvalid.Errorf("min of first tier should be zero")
+			}
+
+			continue
+		}
+
+		if fa.tiers[i].min.Compare(fa.tiers[i-1].min) <= 0 {
+			return util.ErrInvalid.Errorf("tiers should be sorted by min without duplication")
+		}
+	}
+
+	if !fa.exchangeMin.OverNil() {
+		return util.ErrInvalid.Errorf("tiered feeer exchange min amount under zero")
+	}
+
+	return nil
+}
+
+// FeeReceiver is the receiver of SplitFeeer; it takes the share of fee by
+// weight out of FeeReceiverWeightSum.
+type FeeReceiver struct {
+	receiver base.Address
+	weight   uint64
+}
+
+func NewFeeReceiver(receiver base.Address, weight uint64) FeeReceiver {
+	return FeeReceiver{receiver: receiver, weight: weight}
+}
+
+func (fr FeeReceiver) Bytes() []byte {
+	return util.ConcatBytesSlice(fr.receiver.Bytes(), util.Uint64ToBytes(fr.weight))
+}
+
+func (fr FeeReceiver) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, fr.receiver); err != nil {
+		return util.ErrInvalid.Errorf("invalid fee receiver: %w", err)
+	}
+
+	if fr.weight < 1 {
+		return util.ErrInvalid.Errorf("zero weight of fee receiver, %q", fr.receiver)
+	}
+
+	return nil
+}
+
+func (fr FeeReceiver) Receiver() base.Address {
+	return fr.receiver
+}
+
+func (fr FeeReceiver) Weight() uint64 {
+	return fr.weight
+}
+
+// SplitFeeer calculates fee by the fixed, ratio or tiered feeer and splits it
+// across the receivers by weight. The first receiver should be the receiver of
+// feeer and takes the remainder of split.
+type SplitFeeer struct {
+	hint.BaseHinter
+	feeer     Feeer
+	receivers []FeeReceiver
+}
+
+func NewSplitFeeer(feeer Feeer, receivers []FeeReceiver) SplitFeeer {
+	return SplitFeeer{
+		BaseHinter: hint.NewBaseHinter(SplitFeeerHint),
+		feeer:      feeer,
+		receivers:  receivers,
+	}
+}
+
+func (SplitFeeer) Type() string {
+	return FeeerSplit
+}
+
+func (fa SplitFeeer) Bytes() []byte {
+	bs := make([][]byte, len(fa.receivers))
+	for i := range fa.receivers {
+		bs[i] = fa.receivers[i].Bytes()
+	}
+
+	var fb []byte
+	if fa.feeer != nil {
+		fb = fa.feeer.Bytes()
+	}
+
+	return util.ConcatBytesSlice(fb, util.ConcatBytesSlice(bs...))
+}
+
+func (fa SplitFeeer) Feeer() Feeer {
+	return fa.feeer
+}
+
+func (fa SplitFeeer) Receiver() base.Address {
+	return fa.receivers[0].receiver
+}
+
+func (fa SplitFeeer) Receivers() []FeeReceiver {
+	return fa.receivers
+}
+
+func (fa SplitFeeer) Min() mitumcurrency.Big {
+	return fa.feeer.Min()
+}
+
+func (fa SplitFeeer) ExchangeMin() mitumcurrency.Big {
+	return fa.feeer.ExchangeMin()
+}
+
+func (fa SplitFeeer) Fee(a mitumcurrency.Big) (mitumcurrency.Big, error) {
+	return fa.feeer.Fee(a)
+}
+
+// Split returns the shares of fee for each receiver in the order of receivers.
+func (fa SplitFeeer) Split(fee mitumcurrency.Big) []mitumcurrency.Big {
+	shares := make([]mitumcurrency.Big, len(fa.receivers))
+	total := mitumcurrency.NewBig(int64(FeeReceiverWeightSum))
+
+	remain := fee
+	for i := range fa.receivers[1:] {
+		share := fee.Mul(mitumcurrency.NewBig(int64(fa.receivers[i+1].weight))).Div(total)
+		shares[i+1] = share
+		remain = remain.Sub(share)
+	}
+
+	shares[0] = remain
+
+	return shares
+}
+
+func (fa SplitFeeer) IsValid([]byte) error {
+	if err := fa.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if fa.feeer == nil {
+		return util.ErrInvalid.Errorf("empty feeer of split feeer")
+	}
+
+	switch t := fa.feeer.Type(); t {
+	case FeeerFixed, FeeerRatio, FeeerTiered:
+	default:
+		return util.ErrInvalid.Errorf("invalid feeer type of split feeer, %q", t)
+	}
+
+	if err := fa.feeer.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid feeer of split feeer: %w", err)
+	}
+
+	if n := len(fa.receivers); n < 1 {
+		return util.ErrInvalid.Errorf("empty receivers of split feeer")
+	} else if n > MaxFeeReceivers {
+		return util.ErrInvalid.Errorf("receivers of split feeer, %d over max, %d", n, MaxFeeReceivers)
+	}
+
+	founds := map[string]struct{}{}
+
+	var sum uint64
+	for i := range fa.receivers {
+		r := fa.receivers[i]
+		if err := r.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid receiver of split feeer: %w", err)
+		}
+
+		if _, found := founds[r.receiver.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate receiver of split feeer, %q", r.receiver)
+		}
+
+		founds[r.receiver.String()] = struct{}{}
+
+		sum += r.weight
+	}
+
+	if sum != FeeReceiverWeightSum {
+		return util.ErrInvalid.Errorf("sum of receiver weights of split feeer, %d should be %d", sum, FeeReceiverWeightSum)
+	}
+
+	if !fa.receivers[0].receiver.Equal(fa.feeer.Receiver()) {
+		return util.ErrInvalid.Errorf(
+			"first receiver of split feeer, %q should be the receiver of feeer, %q",
+			fa.receivers[0].receiver, fa.feeer.Receiver(),
+		)
+	}
+
+	return nil
+}
+
+// FeeerReceivers returns the receivers of feeer; SplitFeeer returns all
+// of its receivers.
+func FeeerReceivers(feeer Feeer) []base.Address {
+	if i, ok := feeer.(SplitFeeer); ok {
+		rs := make([]base.Address, len(i.receivers))
+		for j := range i.receivers {
+			rs[j] = i.receivers[j].receiver
+		}
+
+		return rs
+	}
+
+	if receiver := feeer.Receiver(); receiver != nil {
+		return []base.Address{receiver}
+	}
+
+	return nil
+}
+
 func NewFeeToken(feeer Feeer, height base.Height) []byte {
 	return util.ConcatBytesSlice(feeer.Bytes(), height.Bytes())
 }
@@ -101,3 +101,88 @@ func (fa *RatioFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return fa.unpack(enc, ht, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max, ufa.ExchangeMinAmount)
 }
+
+func (ft FeeTier) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"min":    ft.min.String(),
+			"amount": ft.amount.String(),
+			"ratio":  ft.ratio,
+		},
+	)
+}
+
+func (fa TieredFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":               fa.Hint().String(),
+			"receiver":            fa.receiver,
+			"tiers":               fa.tiers,
+			"exchange_min_amount": fa.exchangeMin.String(),
+		},
+	)
+}
+
+type TieredFeeerBSONUnpacker struct {
+	Hint              string            `bson:"_hint"`
+	Receiver          string            `bson:"receiver"`
+	Tiers             []FeeTierUnpacker `bson:"tiers"`
+	ExchangeMinAmount string            `bson:"exchange_min_amount"`
+}
+
+func (fa *TieredFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of TieredFeeer")
+
+	var ufa TieredFeeerBSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return fa.unpack(enc, ht, ufa.Receiver, ufa.Tiers, ufa.ExchangeMinAmount)
+}
+
+func (fr FeeReceiver) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"receiver": fr.receiver,
+			"weight":   fr.weight,
+		},
+	)
+}
+
+func (fa SplitFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     fa.Hint().String(),
+			"feeer":     fa.feeer,
+			"receivers": fa.receivers,
+		},
+	)
+}
+
+type SplitFeeerBSONUnpacker struct {
+	Hint      string                `bson:"_hint"`
+	Feeer     bson.Raw              `bson:"feeer"`
+	Receivers []FeeReceiverUnpacker `bson:"receivers"`
+}
+
+func (fa *SplitFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SplitFeeer")
+
+	var ufa SplitFeeerBSONUnpacker
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(ufa.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return fa.unpack(enc, ht, ufa.Feeer, ufa.Receivers)
+}
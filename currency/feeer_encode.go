@@ -75,3 +75,99 @@ func (fa *RatioFeeer) unpack(
 
 	return nil
 }
+
+type FeeTierUnpacker struct {
+	Min    string  `json:"min" bson:"min"`
+	Amount string  `json:"amount" bson:"amount"`
+	Ratio  float64 `json:"ratio" bson:"ratio"`
+}
+
+func (ft *FeeTier) unpack(min, am string, ratio float64) error {
+	e := util.StringErrorFunc("failed to unmarshal FeeTier")
+
+	if min, err := mitumcurrency.NewBigFromString(min); err != nil {
+		return e(err, "")
+	} else {
+		ft.min = min
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(am); err != nil {
+		return e(err, "")
+	} else {
+		ft.amount = big
+	}
+
+	ft.ratio = ratio
+
+	return nil
+}
+
+func (fa *TieredFeeer) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	rc string,
+	uts []FeeTierUnpacker,
+	em string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal TieredFeeer")
+
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fa.receiver = a
+	}
+
+	tiers := make([]FeeTier, len(uts))
+	for i := range uts {
+		if err := tiers[i].unpack(uts[i].Min, uts[i].Amount, uts[i].Ratio); err != nil {
+			return e(err, "")
+		}
+	}
+	fa.tiers = tiers
+
+	if exm, err := mitumcurrency.NewBigFromString(em); err != nil {
+		return e(err, "")
+	} else {
+		fa.exchangeMin = exm
+	}
+
+	return nil
+}
+
+type FeeReceiverUnpacker struct {
+	Receiver string `json:"receiver" bson:"receiver"`
+	Weight   uint64 `json:"weight" bson:"weight"`
+}
+
+func (fa *SplitFeeer) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	bfe []byte,
+	urs []FeeReceiverUnpacker,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal SplitFeeer")
+
+	fa.BaseHinter = hint.NewBaseHinter(ht)
+
+	var feeer Feeer
+	if err := encoder.Decode(enc, bfe, &feeer); err != nil {
+		return e(err, "failed to decode feeer")
+	}
+	fa.feeer = feeer
+
+	receivers := make([]FeeReceiver, len(urs))
+	for i := range urs {
+		a, err := base.DecodeAddress(urs[i].Receiver, enc)
+		if err != nil {
+			return e(err, "")
+		}
+
+		receivers[i] = NewFeeReceiver(a, urs[i].Weight)
+	}
+	fa.receivers = receivers
+
+	return nil
+}
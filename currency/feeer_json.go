@@ -1,6 +1,8 @@
 package currency
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -99,3 +101,94 @@ func (fa *RatioFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	return fa.unpack(enc, ufa.Hint, ufa.Receiver, ufa.Ratio, ufa.Min, ufa.Max, ufa.ExchangeMinAmount)
 }
+
+type FeeTierJSONMarshaler struct {
+	Min    string  `json:"min"`
+	Amount string  `json:"amount"`
+	Ratio  float64 `json:"ratio"`
+}
+
+func (ft FeeTier) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeTierJSONMarshaler{
+		Min:    ft.min.String(),
+		Amount: ft.amount.String(),
+		Ratio:  ft.ratio,
+	})
+}
+
+type TieredFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver          base.Address `json:"receiver"`
+	Tiers             []FeeTier    `json:"tiers"`
+	ExchangeMinAmount string       `json:"exchange_min_amount"`
+}
+
+func (fa TieredFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TieredFeeerJSONMarshaler{
+		BaseHinter:        fa.BaseHinter,
+		Receiver:          fa.receiver,
+		Tiers:             fa.tiers,
+		ExchangeMinAmount: fa.exchangeMin.String(),
+	})
+}
+
+type TieredFeeerJSONUnmarshaler struct {
+	Hint              hint.Hint         `json:"_hint"`
+	Receiver          string            `json:"receiver"`
+	Tiers             []FeeTierUnpacker `json:"tiers"`
+	ExchangeMinAmount string            `json:"exchange_min_amount"`
+}
+
+func (fa *TieredFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of TieredFeeer")
+
+	var ufa TieredFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e(err, "")
+	}
+
+	return fa.unpack(enc, ufa.Hint, ufa.Receiver, ufa.Tiers, ufa.ExchangeMinAmount)
+}
+
+type FeeReceiverJSONMarshaler struct {
+	Receiver base.Address `json:"receiver"`
+	Weight   uint64       `json:"weight"`
+}
+
+func (fr FeeReceiver) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeReceiverJSONMarshaler{
+		Receiver: fr.receiver,
+		Weight:   fr.weight,
+	})
+}
+
+type SplitFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Feeer     Feeer         `json:"feeer"`
+	Receivers []FeeReceiver `json:"receivers"`
+}
+
+func (fa SplitFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SplitFeeerJSONMarshaler{
+		BaseHinter: fa.BaseHinter,
+		Feeer:      fa.feeer,
+		Receivers:  fa.receivers,
+	})
+}
+
+type SplitFeeerJSONUnmarshaler struct {
+	Hint      hint.Hint             `json:"_hint"`
+	Feeer     json.RawMessage       `json:"feeer"`
+	Receivers []FeeReceiverUnpacker `json:"receivers"`
+}
+
+func (fa *SplitFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SplitFeeer")
+
+	var ufa SplitFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ufa); err != nil {
+		return e(err, "")
+	}
+
+	return fa.unpack(enc, ufa.Hint, ufa.Feeer, ufa.Receivers)
+}
@@ -0,0 +1,116 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// FreezeFact is the fact of the operation which updates the freeze state of
+// accounts, FreezeAccounts and UnfreezeAccounts.
+type FreezeFact interface {
+	Accounts() []base.Address
+	Currency() mitumcurrency.CurrencyID
+}
+
+func isValidFreezeAccounts(accounts []base.Address, currency mitumcurrency.CurrencyID) error {
+	if n := len(accounts); n < 1 {
+		return util.ErrInvalid.Errorf("empty accounts")
+	} else if n > MaxFreezeAccounts {
+		return util.ErrInvalid.Errorf("accounts over allowed, %d > %d", n, MaxFreezeAccounts)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range accounts {
+		if err := accounts[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		k := accounts[i].String()
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicate account found, %q", k)
+		}
+		founds[k] = struct{}{}
+	}
+
+	if len(currency) > 0 {
+		if err := currency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isFrozen checks the freeze state of account in the currency; the account
+// can be frozen for all the currencies or for the currency.
+func isFrozen(a base.Address, cid mitumcurrency.CurrencyID, getStateFunc base.GetStateFunc) (bool, error) {
+	keys := []string{StateKeyFreeze(a, "")}
+	if len(cid) > 0 {
+		keys = append(keys, StateKeyFreeze(a, cid))
+	}
+
+	for i := range keys {
+		switch st, found, err := getStateFunc(keys[i]); {
+		case err != nil:
+			return false, err
+		case !found:
+			continue
+		default:
+			fv, err := StateFreezeValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			if fv.Frozen() {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// checkNotFrozen rejects the debit from the frozen account.
+func checkNotFrozen(a base.Address, cid mitumcurrency.CurrencyID, getStateFunc base.GetStateFunc) error {
+	switch frozen, err := isFrozen(a, cid, getStateFunc); {
+	case err != nil:
+		return err
+	case frozen:
+		return errors.Errorf("account frozen, %q in %q", a, cid)
+	default:
+		return nil
+	}
+}
+
+func checkFreezeFact(fact FreezeFact, getStateFunc base.GetStateFunc) base.OperationProcessReasonError {
+	if cid := fact.Currency(); len(cid) > 0 {
+		if err := checkExistsState(StateKeyCurrencyDesign(cid), getStateFunc); err != nil {
+			return base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err)
+		}
+	}
+
+	for i := range fact.Accounts() {
+		a := fact.Accounts()[i]
+		if err := checkExistsState(mitumcurrency.StateKeyAccount(a), getStateFunc); err != nil {
+			return base.NewBaseOperationProcessReasonError("account not found, %q: %w", a, err)
+		}
+	}
+
+	return nil
+}
+
+func freezeStates(fact FreezeFact, frozen bool) []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, len(fact.Accounts()))
+	for i := range fact.Accounts() {
+		a := fact.Accounts()[i]
+
+		sts[i] = NewFreezeStateMergeValue(
+			StateKeyFreeze(a, fact.Currency()),
+			NewFreezeStateValue(a, fact.Currency(), frozen),
+		)
+	}
+
+	return sts
+}
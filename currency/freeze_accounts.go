@@ -0,0 +1,91 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	FreezeAccountsFactHint = hint.MustNewHint("mitum-currency-freeze-accounts-operation-fact-v0.0.1")
+	FreezeAccountsHint     = hint.MustNewHint("mitum-currency-freeze-accounts-operation-v0.0.1")
+)
+
+var MaxFreezeAccounts = 100
+
+type FreezeAccountsFact struct {
+	base.BaseFact
+	accounts []base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewFreezeAccountsFact(token []byte, accounts []base.Address, currency mitumcurrency.CurrencyID) FreezeAccountsFact {
+	fact := FreezeAccountsFact{
+		BaseFact: base.NewBaseFact(FreezeAccountsFactHint, token),
+		accounts: accounts,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact FreezeAccountsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact FreezeAccountsFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.accounts))
+	for i := range fact.accounts {
+		bs[i] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact FreezeAccountsFact) IsValid(b []byte) error {
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isValidFreezeAccounts(fact.accounts, fact.currency); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact FreezeAccountsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact FreezeAccountsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact FreezeAccountsFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+// Currency returns the frozen currency; empty currency means all the
+// currencies of accounts.
+func (fact FreezeAccountsFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+// FreezeAccounts freezes the accounts by suffrage; the debits from the frozen
+// accounts are rejected, but the credits are allowed.
+type FreezeAccounts struct {
+	mitumcurrency.BaseNodeOperation
+}
+
+func NewFreezeAccounts(fact FreezeAccountsFact) (FreezeAccounts, error) {
+	return FreezeAccounts{BaseNodeOperation: mitumcurrency.NewBaseNodeOperation(FreezeAccountsHint, fact)}, nil
+}
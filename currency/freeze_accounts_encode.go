@@ -0,0 +1,36 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *FreezeAccountsFact) unpack(enc encoder.Encoder, acs []string, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal FreezeAccountsFact")
+
+	accounts, err := decodeFreezeAccounts(enc, acs)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.accounts = accounts
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
+
+func decodeFreezeAccounts(enc encoder.Encoder, acs []string) ([]base.Address, error) {
+	accounts := make([]base.Address, len(acs))
+	for i := range acs {
+		a, err := base.DecodeAddress(acs[i], enc)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[i] = a
+	}
+
+	return accounts, nil
+}
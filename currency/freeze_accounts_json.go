@@ -0,0 +1,64 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type FreezeAccountsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Accounts []base.Address           `json:"accounts"`
+	Currency mitumcurrency.CurrencyID `json:"currency,omitempty"`
+}
+
+func (fact FreezeAccountsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FreezeAccountsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Accounts:              fact.accounts,
+		Currency:              fact.currency,
+	})
+}
+
+type FreezeAccountsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Accounts []string `json:"accounts"`
+	Currency string   `json:"currency"`
+}
+
+func (fact *FreezeAccountsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FreezeAccountsFact")
+
+	var uf FreezeAccountsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Accounts, uf.Currency)
+}
+
+type freezeAccountsMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op FreezeAccounts) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(freezeAccountsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *FreezeAccounts) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FreezeAccounts")
+
+	var ubo mitumcurrency.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var freezeAccountsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(FreezeAccountsProcessor)
+	},
+}
+
+func (FreezeAccounts) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type FreezeAccountsProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewFreezeAccountsProcessor(threshold base.Threshold) GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new FreezeAccountsProcessor")
+
+		nopp := freezeAccountsProcessorPool.Get()
+		opp, ok := nopp.(*FreezeAccountsProcessor)
+		if !ok {
+			return nil, e(nil, "expected FreezeAccountsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e(err, "")
+		case !found, i == nil:
+			return nil, e(isaac.ErrStopProcessingRetry.Errorf("empty state"), "")
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"), "")
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *FreezeAccountsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess FreezeAccounts")
+
+	nop, ok := op.(FreezeAccounts)
+	if !ok {
+		return ctx, nil, e(nil, "expected FreezeAccounts, not %T", op)
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not enough signs: %w", err), nil
+	}
+
+	fact, ok := op.Fact().(FreezeAccountsFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected FreezeAccountsFact, not %T", op.Fact())
+	}
+
+	if err := checkFreezeFact(fact, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *FreezeAccountsProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process FreezeAccounts")
+
+	fact, ok := op.Fact().(FreezeAccountsFact)
+	if !ok {
+		return nil, nil, e(nil, "expected FreezeAccountsFact, not %T", op.Fact())
+	}
+
+	return freezeStates(fact, true), nil, nil
+}
+
+func (opp *FreezeAccountsProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	freezeAccountsProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testFreeze struct {
+	testProcessorSuite
+	cid    mitumcurrency.CurrencyID
+	other  mitumcurrency.CurrencyID
+	states *testStates
+	node   base.Address
+	npriv  base.Privatekey
+	frozen base.Address
+	fpriv  base.Privatekey
+	sender base.Address
+	priv   base.Privatekey
+}
+
+func (t *testFreeze) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.other = mitumcurrency.CurrencyID("FINDME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+	t.states.setCurrency(t.other, newTestCurrencyPolicy())
+
+	t.node, t.npriv = t.states.setSuffrage()
+
+	frozen, fprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(frozen, t.cid, 100)
+	t.states.setBalance(frozen, t.other, 100)
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+	t.states.setBalance(sender, t.other, 100)
+
+	t.frozen, t.fpriv, t.sender, t.priv = frozen, fprivs[0], sender, privs[0]
+}
+
+func (t *testFreeze) freeze(cid mitumcurrency.CurrencyID) FreezeAccounts {
+	op, err := NewFreezeAccounts(NewFreezeAccountsFact(util.UUID().Bytes(), []base.Address{t.frozen}, cid))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	return op
+}
+
+func (t *testFreeze) unfreeze(cid mitumcurrency.CurrencyID) UnfreezeAccounts {
+	op, err := NewUnfreezeAccounts(NewUnfreezeAccountsFact(util.UUID().Bytes(), []base.Address{t.frozen}, cid))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	return op
+}
+
+func (t *testFreeze) transfers(
+	sender base.Address, priv base.Privatekey, receiver base.Address, cid mitumcurrency.CurrencyID,
+) mitumcurrency.Transfers {
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+
+	return op
+}
+
+func (t *testFreeze) TestFreezeAllCurrencies() {
+	t.Nil(t.process(NewFreezeAccountsProcessor(base.Threshold(100)), t.freeze(""), t.states))
+
+	for _, cid := range []mitumcurrency.CurrencyID{t.cid, t.other} {
+		reason := t.process(NewTransfersProcessor(), t.transfers(t.frozen, t.fpriv, t.sender, cid), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "account frozen")
+	}
+
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.frozen, t.cid))
+
+	t.Run("credit to frozen account", func() {
+		t.Nil(t.process(NewTransfersProcessor(), t.transfers(t.sender, t.priv, t.frozen, t.cid), t.states))
+		t.Equal(mitumcurrency.NewBig(110), t.states.balance(t.frozen, t.cid))
+	})
+
+	t.Nil(t.process(NewUnfreezeAccountsProcessor(base.Threshold(100)), t.unfreeze(""), t.states))
+
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(t.frozen, t.fpriv, t.sender, t.cid), t.states))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.frozen, t.cid))
+}
+
+func (t *testFreeze) TestFreezeCurrency() {
+	t.Nil(t.process(NewFreezeAccountsProcessor(base.Threshold(100)), t.freeze(t.cid), t.states))
+
+	reason := t.process(NewTransfersProcessor(), t.transfers(t.frozen, t.fpriv, t.sender, t.cid), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "account frozen")
+
+	t.Nil(t.process(NewTransfersProcessor(), t.transfers(t.frozen, t.fpriv, t.sender, t.other), t.states))
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.frozen, t.other))
+}
+
+func (t *testFreeze) TestWithdrawsFee() {
+	contract, err := t.states.newContractAccount(t.frozen)
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	withdraws := func() Withdraws {
+		op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), t.frozen, []WithdrawsItem{
+			NewWithdrawsItemMultiAmounts(
+				contract,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+			),
+		}))
+		t.NoError(err)
+		t.NoError(op.HashSign(t.fpriv, t.networkID))
+
+		return op
+	}
+
+	t.Nil(t.process(NewFreezeAccountsProcessor(base.Threshold(100)), t.freeze(t.cid), t.states))
+
+	// NOTE the frozen account can withdraw without fee
+	t.Nil(t.process(NewWithdrawsProcessor(), withdraws(), t.states))
+	t.Equal(mitumcurrency.NewBig(110), t.states.balance(t.frozen, t.cid))
+
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(t.sender, mitumcurrency.NewBig(1), mitumcurrency.ZeroBig)))
+
+	reason := t.process(NewWithdrawsProcessor(), withdraws(), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "account frozen")
+
+	t.Equal(mitumcurrency.NewBig(110), t.states.balance(t.frozen, t.cid))
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(contract, t.cid))
+}
+
+func (t *testFreeze) TestNotSignedBySuffrage() {
+	op, err := NewFreezeAccounts(NewFreezeAccountsFact(util.UUID().Bytes(), []base.Address{t.frozen}, ""))
+	t.NoError(err)
+	t.NoError(op.NodeSign(base.NewMPrivatekey(), t.networkID, base.RandomAddress("")))
+
+	reason := t.preProcess(NewFreezeAccountsProcessor(base.Threshold(100)), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not enough signs")
+}
+
+func (t *testFreeze) TestUnknownAccount() {
+	op, err := NewFreezeAccounts(NewFreezeAccountsFact(util.UUID().Bytes(), []base.Address{base.RandomAddress("")}, ""))
+	t.NoError(err)
+	t.NoError(op.NodeSign(t.npriv, t.networkID, t.node))
+
+	reason := t.preProcess(NewFreezeAccountsProcessor(base.Threshold(100)), op, t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "account not found")
+}
+
+func TestFreeze(t *testing.T) {
+	suite.Run(t, new(testFreeze))
+}
+
+func TestFreezeAccountsFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: FreezeAccountsFactHint, Instance: FreezeAccountsFact{}}))
+
+		fact := NewFreezeAccountsFact(
+			util.UUID().Bytes(),
+			[]base.Address{
+				mitumcurrency.NewAddress(util.UUID().String()),
+				mitumcurrency.NewAddress(util.UUID().String()),
+			},
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(FreezeAccountsFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(FreezeAccountsFact)
+		t.True(ok)
+		bf, ok := b.(FreezeAccountsFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Currency(), bf.Currency())
+		t.Equal(len(af.Accounts()), len(bf.Accounts()))
+
+		for i := range af.Accounts() {
+			t.True(af.Accounts()[i].Equal(bf.Accounts()[i]))
+		}
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestFreezeStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: FreezeStateValueHint, Instance: FreezeStateValue{}}))
+
+		fv := NewFreezeStateValue(mitumcurrency.NewAddress(util.UUID().String()), mitumcurrency.CurrencyID("SHOWME"), true)
+		t.NoError(fv.IsValid(nil))
+
+		b, err := enc.Marshal(fv)
+		t.NoError(err)
+
+		return fv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(FreezeStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(FreezeStateValue)
+		t.True(ok)
+		bv, ok := b.(FreezeStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.Equal(av.HashBytes(), bv.HashBytes())
+		t.True(av.Account().Equal(bv.Account()))
+		t.Equal(av.Currency(), bv.Currency())
+		t.Equal(av.Frozen(), bv.Frozen())
+	}
+
+	suite.Run(tt, t)
+}
@@ -54,7 +54,7 @@ func (op GenesisCurrencies) Process(
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("account balance already exists, %q: %w", newAddress, err), nil
 		}
-		gas[c.Currency()] = mitumcurrency.NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(c.Currency())))
+		gas[c.Currency()] = NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(c.Currency())))
 	}
 
 	var smvs []base.StateMergeValue
@@ -71,7 +71,7 @@ func (op GenesisCurrencies) Process(
 			return nil, nil, e(nil, "expected BalanceStateValue, not %T", gas[c.Currency()].Value())
 		}
 
-		gst := mitumcurrency.NewBalanceStateMergeValue(gas[c.Currency()].Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(c.amount.Big()))))
+		gst := NewBalanceStateMergeValue(gas[c.Currency()].Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(c.amount.Big()))))
 		dst := NewCurrencyDesignStateMergeValue(sts[c.Currency()].Key(), NewCurrencyDesignStateValue(c))
 		smvs = append(smvs, gst, dst)
 
@@ -108,7 +108,7 @@ func createZeroAccount(
 		return nil, err
 	}
 
-	sts[1] = mitumcurrency.NewBalanceStateMergeValue(bst.Key(), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(cid)))
+	sts[1] = NewBalanceStateMergeValue(bst.Key(), mitumcurrency.NewBalanceStateValue(mitumcurrency.NewZeroAmount(cid)))
 
 	return sts, nil
 }
@@ -0,0 +1,138 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	GrantContractAccountOperatorFactHint = hint.MustNewHint("mitum-currency-grant-contract-account-operator-operation-fact-v0.0.1")
+	GrantContractAccountOperatorHint     = hint.MustNewHint("mitum-currency-grant-contract-account-operator-operation-v0.0.1")
+)
+
+type GrantContractAccountOperatorFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	operator   base.Address
+	allowances []mitumcurrency.Amount
+	currency   mitumcurrency.CurrencyID
+}
+
+func NewGrantContractAccountOperatorFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	operator base.Address,
+	allowances []mitumcurrency.Amount,
+	currency mitumcurrency.CurrencyID,
+) GrantContractAccountOperatorFact {
+	bf := base.NewBaseFact(GrantContractAccountOperatorFactHint, token)
+	fact := GrantContractAccountOperatorFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		operator:   operator,
+		allowances: allowances,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantContractAccountOperatorFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantContractAccountOperatorFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantContractAccountOperatorFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantContractAccountOperatorFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.allowances))
+	for i := range fact.allowances {
+		bs[i] = fact.allowances[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.operator.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact GrantContractAccountOperatorFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.operator, fact.currency); err != nil {
+		return err
+	}
+
+	if err := fact.Operator().IsValid(nil); err != nil {
+		return err
+	}
+
+	switch {
+	case fact.sender.Equal(fact.contract):
+		return util.ErrInvalid.Errorf("contract account is same with sender, %q", fact.sender)
+	case fact.sender.Equal(fact.operator):
+		return util.ErrInvalid.Errorf("operator is same with sender, %q", fact.sender)
+	case fact.contract.Equal(fact.operator):
+		return util.ErrInvalid.Errorf("operator is same with contract account, %q", fact.contract)
+	}
+
+	return nil
+}
+
+func (fact GrantContractAccountOperatorFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact GrantContractAccountOperatorFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GrantContractAccountOperatorFact) Operator() ContractAccountOperator {
+	return NewContractAccountOperator(fact.operator, fact.allowances)
+}
+
+func (fact GrantContractAccountOperatorFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantContractAccountOperatorFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract, fact.operator}, nil
+}
+
+type GrantContractAccountOperator struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewGrantContractAccountOperator(fact GrantContractAccountOperatorFact) (GrantContractAccountOperator, error) {
+	return GrantContractAccountOperator{BaseOperation: mitumcurrency.NewBaseOperation(GrantContractAccountOperatorHint, fact)}, nil
+}
+
+func (op *GrantContractAccountOperator) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
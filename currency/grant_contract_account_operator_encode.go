@@ -0,0 +1,53 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *GrantContractAccountOperatorFact) unpack(enc encoder.Encoder, sd, ct, op string, bam []byte, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal GrantContractAccountOperatorFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(op, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.operator = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+	fact.allowances = amounts
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
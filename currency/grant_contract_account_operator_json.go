@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type GrantContractAccountOperatorFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	Operator   base.Address             `json:"operator"`
+	Allowances []mitumcurrency.Amount   `json:"allowances"`
+	Currency   mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact GrantContractAccountOperatorFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GrantContractAccountOperatorFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Operator:              fact.operator,
+		Allowances:            fact.allowances,
+		Currency:              fact.currency,
+	})
+}
+
+type GrantContractAccountOperatorFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	Operator   string          `json:"operator"`
+	Allowances json.RawMessage `json:"allowances"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *GrantContractAccountOperatorFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of GrantContractAccountOperatorFact")
+
+	var uf GrantContractAccountOperatorFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Operator, uf.Allowances, uf.Currency)
+}
+
+type grantContractAccountOperatorMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op GrantContractAccountOperator) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(grantContractAccountOperatorMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *GrantContractAccountOperator) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of GrantContractAccountOperator")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var KeyRotationHint = hint.MustNewHint("mitum-currency-key-rotation-v0.0.1")
+
+type KeyRotationStatus string
+
+const (
+	KeyRotationPending   = KeyRotationStatus("pending")
+	KeyRotationRotated   = KeyRotationStatus("rotated")
+	KeyRotationCancelled = KeyRotationStatus("cancelled")
+)
+
+func (s KeyRotationStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s KeyRotationStatus) IsValid([]byte) error {
+	switch s {
+	case KeyRotationPending, KeyRotationRotated, KeyRotationCancelled:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown key rotation status, %q", s)
+	}
+}
+
+// KeyRotation is the key update of account which has the key rotation delay.
+// The new keys replace the account keys by KeyUpdater with the same keys at
+// or after the ready height; until then, the account can cancel the rotation
+// with the current keys by CancelKeyRotation.
+type KeyRotation struct {
+	hint.BaseHinter
+	account base.Address
+	keys    mitumcurrency.AccountKeys
+	ready   base.Height
+	status  KeyRotationStatus
+}
+
+func NewKeyRotation(account base.Address, keys mitumcurrency.AccountKeys, ready base.Height) KeyRotation {
+	return KeyRotation{
+		BaseHinter: hint.NewBaseHinter(KeyRotationHint),
+		account:    account,
+		keys:       keys,
+		ready:      ready,
+		status:     KeyRotationPending,
+	}
+}
+
+func (kr KeyRotation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		kr.account.Bytes(),
+		kr.keys.Bytes(),
+		kr.ready.Bytes(),
+		kr.status.Bytes(),
+	)
+}
+
+func (kr KeyRotation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		kr.BaseHinter,
+		kr.account,
+		kr.keys,
+		kr.ready,
+		kr.status,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid key rotation: %w", err)
+	}
+
+	return nil
+}
+
+func (kr KeyRotation) Account() base.Address {
+	return kr.account
+}
+
+func (kr KeyRotation) Keys() mitumcurrency.AccountKeys {
+	return kr.keys
+}
+
+// Ready returns the height from which the keys can replace the account keys.
+func (kr KeyRotation) Ready() base.Height {
+	return kr.ready
+}
+
+func (kr KeyRotation) Status() KeyRotationStatus {
+	return kr.status
+}
+
+func (kr KeyRotation) IsPending() bool {
+	return kr.status == KeyRotationPending
+}
+
+func (kr KeyRotation) IsReady(height base.Height) bool {
+	return height >= kr.ready
+}
+
+func (kr KeyRotation) Rotated() KeyRotation {
+	kr.status = KeyRotationRotated
+
+	return kr
+}
+
+func (kr KeyRotation) Cancelled() KeyRotation {
+	kr.status = KeyRotationCancelled
+
+	return kr
+}
+
+// pendingKeyRotation returns the pending key rotation of account.
+func pendingKeyRotation(a base.Address, getStateFunc base.GetStateFunc) (KeyRotation, bool, error) {
+	switch st, found, err := getStateFunc(StateKeyKeyRotation(a)); {
+	case err != nil:
+		return KeyRotation{}, false, err
+	case !found:
+		return KeyRotation{}, false, nil
+	default:
+		kr, err := StateKeyRotationValue(st)
+		if err != nil {
+			return KeyRotation{}, false, err
+		}
+
+		return kr, kr.IsPending(), nil
+	}
+}
+
+// keyRotationDelay returns the key rotation delay of account at the height;
+// 0 means the account keys are updated immediately.
+func keyRotationDelay(a base.Address, height base.Height, getStateFunc base.GetStateFunc) (base.Height, error) {
+	switch st, found, err := getStateFunc(StateKeyKeyRotationDelay(a)); {
+	case err != nil:
+		return 0, err
+	case !found:
+		return 0, nil
+	default:
+		dv, err := StateKeyRotationDelayValue(st)
+		if err != nil {
+			return 0, err
+		}
+
+		return dv.Delay(height), nil
+	}
+}
@@ -0,0 +1,45 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (kr KeyRotation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   kr.Hint().String(),
+			"account": kr.account,
+			"keys":    kr.keys,
+			"ready":   kr.ready,
+			"status":  kr.status,
+		},
+	)
+}
+
+type KeyRotationBSONUnmarshaler struct {
+	Hint    string      `bson:"_hint"`
+	Account string      `bson:"account"`
+	Keys    bson.Raw    `bson:"keys"`
+	Ready   base.Height `bson:"ready"`
+	Status  string      `bson:"status"`
+}
+
+func (kr *KeyRotation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of KeyRotation")
+
+	var ukr KeyRotationBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &ukr); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(ukr.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return kr.unpack(enc, ht, ukr.Account, ukr.Keys, ukr.Ready, ukr.Status)
+}
@@ -0,0 +1,42 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (kr *KeyRotation) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	bks []byte,
+	ready base.Height,
+	status string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal KeyRotation")
+
+	kr.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		kr.account = a
+	}
+
+	if hinter, err := enc.Decode(bks); err != nil {
+		return e(err, "")
+	} else if k, ok := hinter.(mitumcurrency.AccountKeys); !ok {
+		return e(util.ErrWrongType.Errorf("expected AccountKeys, not %T", hinter), "")
+	} else {
+		kr.keys = k
+	}
+
+	kr.ready = ready
+	kr.status = KeyRotationStatus(status)
+
+	return nil
+}
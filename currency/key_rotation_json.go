@@ -0,0 +1,48 @@
+package currency // nolint: dupl, revive
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type KeyRotationJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address              `json:"account"`
+	Keys    mitumcurrency.AccountKeys `json:"keys"`
+	Ready   base.Height               `json:"ready"`
+	Status  KeyRotationStatus         `json:"status"`
+}
+
+func (kr KeyRotation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KeyRotationJSONMarshaler{
+		BaseHinter: kr.BaseHinter,
+		Account:    kr.account,
+		Keys:       kr.keys,
+		Ready:      kr.ready,
+		Status:     kr.status,
+	})
+}
+
+type KeyRotationJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Account string          `json:"account"`
+	Keys    json.RawMessage `json:"keys"`
+	Ready   base.Height     `json:"ready"`
+	Status  string          `json:"status"`
+}
+
+func (kr *KeyRotation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of KeyRotation")
+
+	var ukr KeyRotationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ukr); err != nil {
+		return e(err, "")
+	}
+
+	return kr.unpack(enc, ukr.Hint, ukr.Account, ukr.Keys, ukr.Ready, ukr.Status)
+}
@@ -0,0 +1,306 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testKeyRotation struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	account  base.Address
+	priv     base.Privatekey
+	guardian base.Address
+	gpriv    base.Privatekey
+}
+
+func (t *testKeyRotation) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	account, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(account, t.cid, 100)
+
+	guardian, gprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(guardian, t.cid, 100)
+
+	t.account, t.priv = account, privs[0]
+	t.guardian, t.gpriv = guardian, gprivs[0]
+}
+
+func (t *testKeyRotation) newKeys() mitumcurrency.AccountKeys {
+	k, err := mitumcurrency.NewBaseAccountKey(base.NewMPrivatekey().Publickey(), 100)
+	t.NoError(err)
+
+	keys, err := mitumcurrency.NewBaseAccountKeys([]mitumcurrency.AccountKey{k}, 100)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testKeyRotation) keys(a base.Address) mitumcurrency.AccountKeys {
+	st, found, err := t.states.getStateFunc(mitumcurrency.StateKeyAccount(a))
+	t.NoError(err)
+	t.True(found)
+
+	keys, err := mitumcurrency.StateKeysValue(st)
+	t.NoError(err)
+
+	return keys
+}
+
+func (t *testKeyRotation) setDelay(delay base.Height) {
+	op, err := NewSetKeyRotationDelay(NewSetKeyRotationDelayFact(util.UUID().Bytes(), t.account, delay, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	t.Nil(t.process(NewSetKeyRotationDelayProcessor(), op, t.states))
+}
+
+func (t *testKeyRotation) keyUpdater(keys mitumcurrency.AccountKeys) mitumcurrency.KeyUpdater {
+	op, err := mitumcurrency.NewKeyUpdater(mitumcurrency.NewKeyUpdaterFact(util.UUID().Bytes(), t.account, keys, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testKeyRotation) cancel() CancelKeyRotation {
+	op, err := NewCancelKeyRotation(NewCancelKeyRotationFact(util.UUID().Bytes(), t.account, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testKeyRotation) setGuardians(delay base.Height) SetRecoveryGuardians {
+	op, err := NewSetRecoveryGuardians(NewSetRecoveryGuardiansFact(
+		util.UUID().Bytes(), t.account, []base.Address{t.guardian}, 1, delay, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testKeyRotation) recover(keys mitumcurrency.AccountKeys) RecoverAccount {
+	op, err := NewRecoverAccount(NewRecoverAccountFact(util.UUID().Bytes(), t.guardian, t.account, keys, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.gpriv, t.networkID))
+
+	return op
+}
+
+func (t *testKeyRotation) keyRotation() KeyRotation {
+	st, found, err := t.states.getStateFunc(StateKeyKeyRotation(t.account))
+	t.NoError(err)
+	t.True(found)
+
+	kr, err := StateKeyRotationValue(st)
+	t.NoError(err)
+
+	return kr
+}
+
+func (t *testKeyRotation) guardians() RecoveryGuardiansStateValue {
+	st, found, err := t.states.getStateFunc(StateKeyRecoveryGuardians(t.account))
+	t.NoError(err)
+	t.True(found)
+
+	gv, err := StateRecoveryGuardiansValue(st)
+	t.NoError(err)
+
+	return gv
+}
+
+func (t *testKeyRotation) TestWithoutDelay() {
+	keys := t.newKeys()
+
+	t.Nil(t.process(NewKeyUpdaterProcessor(), t.keyUpdater(keys), t.states))
+	t.True(t.keys(t.account).Equal(keys))
+}
+
+func (t *testKeyRotation) TestRotate() {
+	t.setDelay(10)
+
+	keys := t.newKeys()
+	old := t.keys(t.account)
+
+	t.Nil(t.process(NewKeyUpdaterProcessor(), t.keyUpdater(keys), t.states))
+
+	kr := t.keyRotation()
+	t.True(kr.IsPending())
+	t.Equal(t.states.height+10, kr.Ready())
+	t.True(t.keys(t.account).Equal(old))
+
+	t.Run("not yet ready", func() {
+		reason := t.preProcess(NewKeyUpdaterProcessor(), t.keyUpdater(keys), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "not yet ready")
+	})
+
+	t.Run("other keys", func() {
+		reason := t.preProcess(NewKeyUpdaterProcessor(), t.keyUpdater(t.newKeys()), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "pending key rotation with other keys exists")
+	})
+
+	t.states.height = kr.Ready()
+
+	t.Nil(t.process(NewKeyUpdaterProcessor(), t.keyUpdater(keys), t.states))
+
+	t.Equal(KeyRotationRotated, t.keyRotation().Status())
+	t.True(t.keys(t.account).Equal(keys))
+}
+
+func (t *testKeyRotation) TestCancel() {
+	t.setDelay(10)
+
+	old := t.keys(t.account)
+
+	t.Nil(t.process(NewKeyUpdaterProcessor(), t.keyUpdater(t.newKeys()), t.states))
+	t.Nil(t.process(NewCancelKeyRotationProcessor(), t.cancel(), t.states))
+
+	t.Equal(KeyRotationCancelled, t.keyRotation().Status())
+	t.True(t.keys(t.account).Equal(old))
+
+	reason := t.preProcess(NewCancelKeyRotationProcessor(), t.cancel(), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "pending key rotation not found")
+}
+
+func (t *testKeyRotation) TestRecoveryDelayUnderKeyRotationDelay() {
+	t.setDelay(10)
+
+	// NOTE the stolen key can not set the guardians, which recover the account
+	// earlier than the key rotation.
+	reason := t.preProcess(NewSetRecoveryGuardiansProcessor(), t.setGuardians(1), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "recovery delay under key rotation delay")
+}
+
+func (t *testKeyRotation) TestPendingGuardians() {
+	t.setDelay(10)
+
+	t.Nil(t.process(NewSetRecoveryGuardiansProcessor(), t.setGuardians(10), t.states))
+
+	gv := t.guardians()
+	t.True(gv.IsPending(t.states.height))
+	t.Equal(t.states.height+10, gv.Ready())
+	t.False(gv.At(t.states.height).IsGuardian(t.guardian))
+	t.True(gv.At(gv.Ready()).IsGuardian(t.guardian))
+
+	reason := t.preProcess(NewRecoverAccountProcessor(), t.recover(t.newKeys()), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "sender is not guardian")
+
+	t.Run("recover after ready", func() {
+		states := newTestStates(gv.Ready())
+		for k := range t.states.states {
+			states.states[k] = t.states.states[k]
+		}
+
+		t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(t.newKeys()), states))
+
+		st, found, err := states.getStateFunc(StateKeyAccountRecovery(t.account))
+		t.NoError(err)
+		t.True(found)
+
+		ar, err := StateAccountRecoveryValue(st)
+		t.NoError(err)
+		t.True(ar.IsApproved())
+		t.Equal(gv.Ready()+10, ar.Ready())
+	})
+
+	t.Nil(t.process(NewCancelKeyRotationProcessor(), t.cancel(), t.states))
+
+	gv = t.guardians()
+	t.False(gv.IsPending(t.states.height))
+	t.Empty(gv.Guardians())
+}
+
+func (t *testKeyRotation) TestRecoveryDelayWithIncreasedKeyRotationDelay() {
+	t.Nil(t.process(NewSetRecoveryGuardiansProcessor(), t.setGuardians(1), t.states))
+	t.False(t.guardians().IsPending(t.states.height))
+
+	t.setDelay(10)
+
+	t.Nil(t.process(NewRecoverAccountProcessor(), t.recover(t.newKeys()), t.states))
+
+	st, found, err := t.states.getStateFunc(StateKeyAccountRecovery(t.account))
+	t.NoError(err)
+	t.True(found)
+
+	ar, err := StateAccountRecoveryValue(st)
+	t.NoError(err)
+	t.Equal(t.states.height+10, ar.Ready())
+}
+
+func TestKeyRotation(t *testing.T) {
+	suite.Run(t, new(testKeyRotation))
+}
+
+func TestRecoveryGuardiansStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: RecoveryGuardiansStateValueHint, Instance: RecoveryGuardiansStateValue{},
+		}))
+
+		account := mitumcurrency.NewAddress(util.UUID().String())
+
+		previous := NewRecoveryGuardiansStateValue(
+			account, []base.Address{mitumcurrency.NewAddress(util.UUID().String())}, 1, 3)
+		gv := NewRecoveryGuardiansStateValue(
+			account, []base.Address{mitumcurrency.NewAddress(util.UUID().String())}, 1, 10).Pending(previous, 33)
+		t.NoError(gv.IsValid(nil))
+
+		b, err := enc.Marshal(gv)
+		t.NoError(err)
+
+		return gv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(RecoveryGuardiansStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(RecoveryGuardiansStateValue)
+		t.True(ok)
+		bv, ok := b.(RecoveryGuardiansStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.Equal(av.HashBytes(), bv.HashBytes())
+
+		ap, _ := av.Previous()
+		bp, found := bv.Previous()
+		t.True(found)
+		t.Equal(ap.HashBytes(), bp.HashBytes())
+		t.Equal(av.Ready(), bv.Ready())
+	}
+
+	suite.Run(tt, t)
+}
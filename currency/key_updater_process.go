@@ -78,6 +78,10 @@ func (opp *KeyUpdaterProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
+	if _, _, rerr := opp.checkRotation(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -92,6 +96,11 @@ func (opp *KeyUpdaterProcessor) Process( // nolint:dupl
 		return nil, nil, e(nil, "expected KeyUpdaterFact, not %T", op.Fact())
 	}
 
+	kr, update, rerr := opp.checkRotation(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
 	st, err := existsState(mitumcurrency.StateKeyAccount(fact.Target()), "key of target account", getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("target not found, %q: %w", fact.Target(), err), nil
@@ -102,16 +111,22 @@ func (opp *KeyUpdaterProcessor) Process( // nolint:dupl
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
 	}
-	fee, err := policy.Feeer().Fee(mitumcurrency.ZeroBig)
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
 	}
 
+	if fee.OverZero() {
+		if err := checkNotFrozen(fact.Target(), fact.Currency(), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+		}
+	}
+
 	st, err = existsState(mitumcurrency.StateKeyBalance(fact.Target(), fact.Currency()), "key of target balance", getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("target balance not found, %q: %w", fact.Target(), err), nil
 	}
-	sb := mitumcurrency.NewBalanceStateMergeValue(st.Key(), st.Value())
+	sb := NewBalanceStateMergeValue(st.Key(), st.Value())
 
 	switch b, err := mitumcurrency.StateBalanceValue(st); {
 	case err != nil:
@@ -126,13 +141,25 @@ func (opp *KeyUpdaterProcessor) Process( // nolint:dupl
 	if !ok {
 		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
 	}
-	sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(sb.Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+	sts = append(sts, NewBalanceStateMergeValue(sb.Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	if kr.Account() != nil {
+		sts = append(sts, NewKeyRotationStateMergeValue(StateKeyKeyRotation(fact.Target()), NewKeyRotationStateValue(kr)))
+	}
+
+	if update {
+		a, err := mitumcurrency.NewAccountFromKeys(fact.Keys())
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to create new account from keys"), nil
+		}
+		sts = append(sts, mitumcurrency.NewAccountStateMergeValue(sa.Key(), mitumcurrency.NewAccountStateValue(a)))
+	}
 
-	a, err := mitumcurrency.NewAccountFromKeys(fact.Keys())
+	fsts, err := CollectFee(factHint(op.Fact()), fact.Currency(), fee, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to create new account from keys"), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
 	}
-	sts = append(sts, mitumcurrency.NewAccountStateMergeValue(sa.Key(), mitumcurrency.NewAccountStateValue(a)))
+	sts = append(sts, fsts...)
 
 	return sts, nil, nil
 }
@@ -142,3 +169,39 @@ func (opp *KeyUpdaterProcessor) Close() error {
 
 	return nil
 }
+
+// checkRotation returns the key rotation of target and whether the keys of
+// target are updated by KeyUpdater. Without the key rotation delay, the keys
+// are updated immediately; with the delay, the new keys are pending until the
+// ready height and KeyUpdater with the same keys rotates them at or after the
+// ready height.
+func (opp *KeyUpdaterProcessor) checkRotation(
+	fact mitumcurrency.KeyUpdaterFact,
+	getStateFunc base.GetStateFunc,
+) (KeyRotation, bool, base.OperationProcessReasonError) {
+	switch kr, found, err := pendingKeyRotation(fact.Target(), getStateFunc); {
+	case err != nil:
+		return KeyRotation{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation, %q: %w", fact.Target(), err)
+	case !found:
+	case !kr.Keys().Equal(fact.Keys()):
+		return KeyRotation{}, false, base.NewBaseOperationProcessReasonError(
+			"pending key rotation with other keys exists, %q", fact.Target())
+	case !kr.IsReady(opp.Height()):
+		return KeyRotation{}, false, base.NewBaseOperationProcessReasonError(
+			"key rotation not yet ready, %q; %d < %d", fact.Target(), opp.Height(), kr.Ready())
+	default:
+		return kr.Rotated(), true, nil
+	}
+
+	delay, err := keyRotationDelay(fact.Target(), opp.Height(), getStateFunc)
+	switch {
+	case err != nil:
+		return KeyRotation{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation delay, %q: %w", fact.Target(), err)
+	case delay < 1:
+		return KeyRotation{}, true, nil
+	default:
+		return NewKeyRotation(fact.Target(), fact.Keys(), opp.Height()+delay), false, nil
+	}
+}
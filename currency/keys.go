@@ -106,12 +106,14 @@ func (ks ContractAccountKeys) Equal(b mitumcurrency.AccountKeys) bool {
 	return true
 }
 
+// checkThreshold sums the weights of the keys which signed; the signs by the
+// other accounts, like the co-owners or the fee payer, are skipped.
 func checkThreshold(fs []base.Sign, keys mitumcurrency.AccountKeys) error {
 	var sum uint
 	for i := range fs {
 		ky, found := keys.Key(fs[i].Signer())
 		if !found {
-			return errors.Errorf("unknown key found, %s", fs[i].Signer())
+			continue
 		}
 		sum += ky.Weight()
 	}
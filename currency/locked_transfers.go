@@ -0,0 +1,139 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	LockedTransfersFactHint = hint.MustNewHint("mitum-currency-locked-transfers-operation-fact-v0.0.1")
+	LockedTransfersHint     = hint.MustNewHint("mitum-currency-locked-transfers-operation-v0.0.1")
+)
+
+type LockedTransfersFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []LockedTransfersItem
+}
+
+func NewLockedTransfersFact(
+	token []byte,
+	sender base.Address,
+	items []LockedTransfersItem,
+) LockedTransfersFact {
+	bf := base.NewBaseFact(LockedTransfersFactHint, token)
+	fact := LockedTransfersFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact LockedTransfersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact LockedTransfersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact LockedTransfersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact LockedTransfersFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+	)
+}
+
+func (fact LockedTransfersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	foundReceivers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Receiver().String()
+		switch _, found := foundReceivers[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate receiver found, %s", it.Receiver())
+		case fact.sender.Equal(it.Receiver()):
+			return util.ErrInvalid.Errorf("receiver is same with sender, %q", fact.sender)
+		default:
+			foundReceivers[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact LockedTransfersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact LockedTransfersFact) Items() []LockedTransfersItem {
+	return fact.items
+}
+
+func (fact LockedTransfersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+	for i := range fact.items {
+		as[i] = fact.items[i].Receiver()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+// LockedTransfers transfers the amounts into the vesting locks of receivers
+// instead of the balances; the released amounts are moved to the balance by
+// ClaimVested.
+type LockedTransfers struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewLockedTransfers(fact LockedTransfersFact) (LockedTransfers, error) {
+	return LockedTransfers{BaseOperation: mitumcurrency.NewBaseOperation(LockedTransfersHint, fact)}, nil
+}
+
+func (op *LockedTransfers) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
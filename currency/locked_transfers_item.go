@@ -0,0 +1,100 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var LockedTransfersItemHint = hint.MustNewHint("mitum-currency-locked-transfers-item-v0.0.1")
+
+// LockedTransfersItem locks the amounts for the receiver; the amounts are
+// released from start to end height, see VestingLock.
+type LockedTransfersItem struct {
+	hint.BaseHinter
+	receiver base.Address
+	amounts  []mitumcurrency.Amount
+	start    base.Height
+	end      base.Height
+}
+
+func NewLockedTransfersItem(
+	receiver base.Address,
+	amounts []mitumcurrency.Amount,
+	start, end base.Height,
+) LockedTransfersItem {
+	return LockedTransfersItem{
+		BaseHinter: hint.NewBaseHinter(LockedTransfersItemHint),
+		receiver:   receiver,
+		amounts:    amounts,
+		start:      start,
+		end:        end,
+	}
+}
+
+func (it LockedTransfersItem) Bytes() []byte {
+	bs := make([][]byte, len(it.amounts)+3)
+	bs[0] = it.receiver.Bytes()
+	bs[1] = it.start.Bytes()
+	bs[2] = it.end.Bytes()
+
+	for i := range it.amounts {
+		bs[i+3] = it.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it LockedTransfersItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.receiver, it.start, it.end); err != nil {
+		return err
+	}
+
+	if n := len(it.amounts); n == 0 {
+		return util.ErrInvalid.Errorf("empty amounts")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("amounts, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if it.start > it.end {
+		return util.ErrInvalid.Errorf("start over end, %d > %d", it.start, it.end)
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range it.amounts {
+		am := it.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount should be over zero")
+		}
+	}
+
+	return nil
+}
+
+func (it LockedTransfersItem) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it LockedTransfersItem) Amounts() []mitumcurrency.Amount {
+	return it.amounts
+}
+
+func (it LockedTransfersItem) Start() base.Height {
+	return it.start
+}
+
+func (it LockedTransfersItem) End() base.Height {
+	return it.end
+}
+
+func (it LockedTransfersItem) Lock(amount mitumcurrency.Amount) VestingLock {
+	return NewVestingLock(amount.Big(), it.start, it.end)
+}
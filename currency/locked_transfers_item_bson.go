@@ -0,0 +1,45 @@
+package currency // nolint:dupl
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it LockedTransfersItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"receiver": it.receiver,
+			"amounts":  it.amounts,
+			"start":    it.start,
+			"end":      it.end,
+		},
+	)
+}
+
+type LockedTransfersItemBSONUnmarshaler struct {
+	Hint     string      `bson:"_hint"`
+	Receiver string      `bson:"receiver"`
+	Amounts  bson.Raw    `bson:"amounts"`
+	Start    base.Height `bson:"start"`
+	End      base.Height `bson:"end"`
+}
+
+func (it *LockedTransfersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of LockedTransfersItem")
+
+	var uit LockedTransfersItemBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uit.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return it.unpack(enc, ht, uit.Receiver, uit.Amounts, uit.Start, uit.End)
+}
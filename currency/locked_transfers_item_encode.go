@@ -0,0 +1,49 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *LockedTransfersItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	rc string,
+	bam []byte,
+	start, end base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal LockedTransfersItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		it.receiver = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+
+	it.amounts = amounts
+	it.start = start
+	it.end = end
+
+	return nil
+}
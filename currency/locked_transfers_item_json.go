@@ -0,0 +1,48 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type LockedTransfersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address           `json:"receiver"`
+	Amounts  []mitumcurrency.Amount `json:"amounts"`
+	Start    base.Height            `json:"start"`
+	End      base.Height            `json:"end"`
+}
+
+func (it LockedTransfersItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockedTransfersItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Receiver:   it.receiver,
+		Amounts:    it.amounts,
+		Start:      it.start,
+		End:        it.end,
+	})
+}
+
+type LockedTransfersItemJSONUnMarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Receiver string          `json:"receiver"`
+	Amounts  json.RawMessage `json:"amounts"`
+	Start    base.Height     `json:"start"`
+	End      base.Height     `json:"end"`
+}
+
+func (it *LockedTransfersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of LockedTransfersItem")
+
+	var uit LockedTransfersItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e(err, "")
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Receiver, uit.Amounts, uit.Start, uit.End)
+}
@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type LockedTransfersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address          `json:"sender"`
+	Items  []LockedTransfersItem `json:"items"`
+}
+
+func (fact LockedTransfersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockedTransfersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type LockedTransfersFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *LockedTransfersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of LockedTransfersFact")
+
+	var uf LockedTransfersFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items)
+}
+
+type lockedTransfersMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op LockedTransfers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(lockedTransfersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *LockedTransfers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of LockedTransfers")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var lockedTransfersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(LockedTransfersProcessor)
+	},
+}
+
+func (LockedTransfers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type LockedTransfersProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewLockedTransfersProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new LockedTransfersProcessor")
+
+		nopp := lockedTransfersProcessorPool.Get()
+		opp, ok := nopp.(*LockedTransfersProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected LockedTransfersProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *LockedTransfersProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess LockedTransfers")
+
+	fact, ok := op.Fact().(LockedTransfersFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected LockedTransfersFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot transfer amounts, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for i := range fact.items {
+		it := fact.items[i]
+
+		if err := checkExistsState(mitumcurrency.StateKeyAccount(it.Receiver()), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("receiver not found, %q: %w", it.Receiver(), err), nil
+		}
+
+		if err := checkNotExistsState(StateKeyContractAccount(it.Receiver()), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("contract account cannot receive locked amounts, %q: %w", it.Receiver(), err), nil
+		}
+
+		for j := range it.Amounts() {
+			am := it.Amounts()[j]
+
+			policy, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
+			if err != nil {
+				return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", am.Currency(), err), nil
+			}
+
+			// NOTE the lock amount should be over the minimum balance of new
+			// account, so the vesting locks of receiver can not be filled by dust
+			if am.Big().Compare(policy.NewAccountMinBalance()) < 0 {
+				return ctx, base.NewBaseOperationProcessReasonError(
+					"lock amount should be over minimum balance, %v < %v", am.Big(), policy.NewAccountMinBalance()), nil
+			}
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *LockedTransfersProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process LockedTransfers")
+
+	fact, ok := op.Fact().(LockedTransfersFact)
+	if !ok {
+		return nil, nil, e(nil, "expected LockedTransfersFact, not %T", op.Fact())
+	}
+
+	items := make([]mitumcurrency.AmountsItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fees := RequiredFees(required)
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for i := range fact.items {
+		it := fact.items[i]
+
+		for j := range it.Amounts() {
+			am := it.Amounts()[j]
+			k := StateKeyLock(it.Receiver(), am.Currency())
+
+			switch st, found, err := getStateFunc(k); {
+			case err != nil:
+				return nil, base.NewBaseOperationProcessReasonError("failed to find lock state, %q: %w", k, err), nil
+			case found:
+				lv, err := StateLockValue(st)
+				if err != nil {
+					return nil, base.NewBaseOperationProcessReasonError("failed to get lock value, %q: %w", k, err), nil
+				}
+
+				if n := len(lv.Locks()); n >= MaxVestingLocks {
+					return nil, base.NewBaseOperationProcessReasonError(
+						"vesting locks of receiver, %d over max, %d; %q", n, MaxVestingLocks, it.Receiver()), nil
+				}
+			}
+
+			sts = append(sts, NewLockStateMergeValue(
+				k,
+				NewAddLockStateValue(am.Currency(), []VestingLock{it.Lock(am)}),
+			))
+		}
+	}
+
+	for cid := range required {
+		v, ok := sb[cid].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[cid].Value()), nil
+		}
+
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[cid][0])))
+		sts = append(sts, NewBalanceStateMergeValue(sb[cid].Key(), stv))
+	}
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *LockedTransfersProcessor) Close() error {
+	lockedTransfersProcessorPool.Put(opp)
+
+	return nil
+}
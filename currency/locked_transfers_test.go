@@ -0,0 +1,300 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testLockedTransfers struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	sender   base.Address
+	priv     base.Privatekey
+	receiver base.Address
+	rpriv    base.Privatekey
+}
+
+func (t *testLockedTransfers) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 200)
+
+	receiver, rprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+
+	t.sender, t.priv, t.receiver, t.rpriv = sender, privs[0], receiver, rprivs[0]
+}
+
+func (t *testLockedTransfers) lock(big int64, start, end base.Height) LockedTransfers {
+	return t.lockFrom(t.sender, t.priv, big, start, end)
+}
+
+func (t *testLockedTransfers) lockFrom(
+	sender base.Address, priv base.Privatekey, big int64, start, end base.Height,
+) LockedTransfers {
+	op, err := NewLockedTransfers(NewLockedTransfersFact(util.UUID().Bytes(), sender, []LockedTransfersItem{
+		NewLockedTransfersItem(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+			start, end,
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testLockedTransfers) claim() ClaimVested {
+	op, err := NewClaimVested(NewClaimVestedFact(util.UUID().Bytes(), t.receiver, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.rpriv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testLockedTransfers) locks() LockStateValue {
+	st, found, err := t.states.getStateFunc(StateKeyLock(t.receiver, t.cid))
+	t.NoError(err)
+	t.True(found)
+
+	lv, err := StateLockValue(st)
+	t.NoError(err)
+
+	return lv
+}
+
+func (t *testLockedTransfers) TestLinear() {
+	t.Nil(t.process(NewLockedTransfersProcessor(), t.lock(100, 40, 50), t.states))
+
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+	t.True(t.states.balance(t.receiver, t.cid).IsZero())
+
+	lv := t.locks()
+	t.Equal(1, len(lv.Locks()))
+	t.Equal(mitumcurrency.NewBig(100), lv.Locked(t.states.height))
+
+	t.Run("before start", func() {
+		reason := t.process(NewClaimVestedProcessor(), t.claim(), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "nothing to claim")
+	})
+
+	t.states.height = base.Height(45)
+
+	t.Nil(t.process(NewClaimVestedProcessor(), t.claim(), t.states))
+	t.Equal(mitumcurrency.NewBig(50), t.states.balance(t.receiver, t.cid))
+
+	lv = t.locks()
+	t.Equal(1, len(lv.Locks()))
+	t.Equal(mitumcurrency.NewBig(50), lv.Locks()[0].Claimed())
+
+	t.Run("claimed already", func() {
+		reason := t.process(NewClaimVestedProcessor(), t.claim(), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "nothing to claim")
+	})
+
+	t.states.height = base.Height(50)
+
+	t.Nil(t.process(NewClaimVestedProcessor(), t.claim(), t.states))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.receiver, t.cid))
+	t.Equal(0, len(t.locks().Locks()))
+}
+
+func (t *testLockedTransfers) TestCliff() {
+	vl := NewVestingLock(mitumcurrency.NewBig(100), 40, 40)
+	t.NoError(vl.IsValid(nil))
+	t.True(vl.IsCliff())
+
+	t.True(vl.Released(39).IsZero())
+	t.Equal(mitumcurrency.NewBig(100), vl.Released(40))
+
+	t.Nil(t.process(NewLockedTransfersProcessor(), t.lock(100, 40, 40), t.states))
+
+	t.states.height = base.Height(39)
+
+	reason := t.process(NewClaimVestedProcessor(), t.claim(), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "nothing to claim")
+
+	t.states.height = base.Height(40)
+
+	t.Nil(t.process(NewClaimVestedProcessor(), t.claim(), t.states))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testLockedTransfers) TestVestingLockNotFound() {
+	reason := t.preProcess(NewClaimVestedProcessor(), t.claim(), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "vesting lock not found")
+}
+
+func (t *testLockedTransfers) TestUnderMinimumBalance() {
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(mitumcurrency.NewBig(10), NewNilFeeer()))
+
+	reason := t.preProcess(NewLockedTransfersProcessor(), t.lock(9, 40, 50), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "lock amount should be over minimum balance")
+
+	t.Nil(t.process(NewLockedTransfersProcessor(), t.lock(10, 40, 50), t.states))
+	t.Equal(1, len(t.locks().Locks()))
+}
+
+func (t *testLockedTransfers) TestLocksInSameProposal() {
+	other, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(other, t.cid, 200)
+
+	opr := NewOperationProcessor()
+	opr.GetStateFunc = t.states.getStateFunc
+
+	// NOTE the number of locks is checked before proposal, so the locks of
+	// same receiver from the different senders are not allowed in proposal
+	t.NoError(opr.checkDuplication(t.lock(10, 40, 50)))
+
+	err = opr.checkDuplication(t.lockFrom(other, privs[0], 10, 40, 50))
+	t.Error(err)
+	t.ErrorContains(err, "duplicate vesting lock")
+}
+
+func (t *testLockedTransfers) TestMaxVestingLocks() {
+	for i := 0; i < MaxVestingLocks; i++ {
+		t.Nil(t.process(NewLockedTransfersProcessor(), t.lock(1, 40, 50), t.states))
+	}
+
+	t.Equal(MaxVestingLocks, len(t.locks().Locks()))
+
+	reason := t.process(NewLockedTransfersProcessor(), t.lock(1, 40, 50), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "over max")
+}
+
+func TestLockedTransfers(t *testing.T) {
+	suite.Run(t, new(testLockedTransfers))
+}
+
+func TestLockedTransfersFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: LockedTransfersItemHint, Instance: LockedTransfersItem{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: LockedTransfersFactHint, Instance: LockedTransfersFact{}}))
+
+		fact := NewLockedTransfersFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]LockedTransfersItem{
+				NewLockedTransfersItem(
+					mitumcurrency.NewAddress(util.UUID().String()),
+					[]mitumcurrency.Amount{
+						mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+					},
+					40, 50,
+				),
+			},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(LockedTransfersFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(LockedTransfersFact)
+		t.True(ok)
+		bf, ok := b.(LockedTransfersFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(len(af.Items()), len(bf.Items()))
+
+		for i := range af.Items() {
+			t.Equal(af.Items()[i].Bytes(), bf.Items()[i].Bytes())
+		}
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestLockStateValueEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: VestingLockHint, Instance: VestingLock{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: LockStateValueHint, Instance: LockStateValue{}}))
+
+		vl, _, err := NewVestingLock(mitumcurrency.NewBig(100), 40, 50).Claim(45)
+		t.NoError(err)
+
+		lv := NewLockStateValue(mitumcurrency.CurrencyID("SHOWME"), []VestingLock{
+			vl,
+			NewVestingLock(mitumcurrency.NewBig(33), 60, 60),
+		})
+		t.NoError(lv.IsValid(nil))
+
+		b, err := enc.Marshal(lv)
+		t.NoError(err)
+
+		return lv, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(LockStateValue)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		av, ok := a.(LockStateValue)
+		t.True(ok)
+		bv, ok := b.(LockStateValue)
+		t.True(ok)
+
+		t.NoError(bv.IsValid(nil))
+		t.Equal(av.HashBytes(), bv.HashBytes())
+		t.Equal(av.Currency(), bv.Currency())
+		t.Equal(len(av.Locks()), len(bv.Locks()))
+
+		for i := range av.Locks() {
+			t.Equal(av.Locks()[i].Bytes(), bv.Locks()[i].Bytes())
+		}
+	}
+
+	suite.Run(tt, t)
+}
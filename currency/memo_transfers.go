@@ -0,0 +1,160 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	MemoTransfersFactHint = hint.MustNewHint("mitum-currency-memo-transfers-operation-fact-v0.0.1")
+	MemoTransfersHint     = hint.MustNewHint("mitum-currency-memo-transfers-operation-v0.0.1")
+)
+
+type MemoTransfersFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []MemoTransfersItem
+}
+
+func NewMemoTransfersFact(
+	token []byte,
+	sender base.Address,
+	items []MemoTransfersItem,
+) MemoTransfersFact {
+	bf := base.NewBaseFact(MemoTransfersFactHint, token)
+	fact := MemoTransfersFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MemoTransfersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MemoTransfersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MemoTransfersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MemoTransfersFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+	)
+}
+
+func (fact MemoTransfersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	foundReceivers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Receiver().String()
+		switch _, found := foundReceivers[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate receiver found, %s", it.Receiver())
+		case fact.sender.Equal(it.Receiver()):
+			return util.ErrInvalid.Errorf("receiver is same with sender, %q", fact.sender)
+		default:
+			foundReceivers[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact MemoTransfersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact MemoTransfersFact) Items() []mitumcurrency.TransfersItem {
+	its := make([]mitumcurrency.TransfersItem, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i]
+	}
+
+	return its
+}
+
+func (fact MemoTransfersFact) MemoItems() []MemoTransfersItem {
+	return fact.items
+}
+
+// Memos returns the non-empty memos of items; the digester indexes the
+// operation by these memos.
+func (fact MemoTransfersFact) Memos() []string {
+	var memos []string // nolint:prealloc
+	for i := range fact.items {
+		if m := fact.items[i].Memo(); len(m) > 0 {
+			memos = append(memos, m)
+		}
+	}
+
+	return memos
+}
+
+func (fact MemoTransfersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+1)
+	for i := range fact.items {
+		as[i] = fact.items[i].Receiver()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+
+	return as, nil
+}
+
+// MemoTransfers transfers the amounts like Transfers, but each item carries
+// the memo which is included in the fact hash and indexed by the digester.
+type MemoTransfers struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewMemoTransfers(fact MemoTransfersFact) (MemoTransfers, error) {
+	return MemoTransfers{BaseOperation: mitumcurrency.NewBaseOperation(MemoTransfersHint, fact)}, nil
+}
+
+func (op *MemoTransfers) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
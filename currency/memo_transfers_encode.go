@@ -0,0 +1,36 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *MemoTransfersFact) unpack(enc encoder.Encoder, sd string, bit []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal MemoTransfersFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	hit, err := enc.DecodeSlice(bit)
+	if err != nil {
+		return e(err, "")
+	}
+
+	items := make([]MemoTransfersItem, len(hit))
+	for i := range hit {
+		j, ok := hit[i].(MemoTransfersItem)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected MemoTransfersItem, not %T", hit[i]), "")
+		}
+
+		items[i] = j
+	}
+	fact.items = items
+
+	return nil
+}
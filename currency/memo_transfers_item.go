@@ -0,0 +1,107 @@
+package currency
+
+import (
+	"unicode/utf8"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var MemoTransfersItemHint = hint.MustNewHint("mitum-currency-memo-transfers-item-v0.0.1")
+
+var MaxMemoLength = 256
+
+// MemoTransfersItem transfers the amounts to the receiver with memo; the memo
+// is the reference of transfer like the deposit tag of exchange.
+type MemoTransfersItem struct {
+	hint.BaseHinter
+	receiver base.Address
+	amounts  []mitumcurrency.Amount
+	memo     string
+}
+
+func NewMemoTransfersItem(
+	receiver base.Address,
+	amounts []mitumcurrency.Amount,
+	memo string,
+) MemoTransfersItem {
+	return MemoTransfersItem{
+		BaseHinter: hint.NewBaseHinter(MemoTransfersItemHint),
+		receiver:   receiver,
+		amounts:    amounts,
+		memo:       memo,
+	}
+}
+
+func (it MemoTransfersItem) Bytes() []byte {
+	bs := make([][]byte, len(it.amounts)+2)
+	bs[0] = it.receiver.Bytes()
+	bs[1] = []byte(it.memo)
+
+	for i := range it.amounts {
+		bs[i+2] = it.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (it MemoTransfersItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.receiver); err != nil {
+		return err
+	}
+
+	if n := len(it.amounts); n == 0 {
+		return util.ErrInvalid.Errorf("empty amounts")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("amounts, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if n := len(it.memo); n > MaxMemoLength {
+		return util.ErrInvalid.Errorf("memo, %d over max, %d", n, MaxMemoLength)
+	} else if !utf8.ValidString(it.memo) {
+		return util.ErrInvalid.Errorf("memo should be valid utf-8 string")
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range it.amounts {
+		am := it.amounts[i]
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+
+		if err := am.IsValid(nil); err != nil {
+			return err
+		} else if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount should be over zero")
+		}
+	}
+
+	return nil
+}
+
+func (it MemoTransfersItem) Receiver() base.Address {
+	return it.receiver
+}
+
+func (it MemoTransfersItem) Amounts() []mitumcurrency.Amount {
+	return it.amounts
+}
+
+func (it MemoTransfersItem) Memo() string {
+	return it.memo
+}
+
+func (it MemoTransfersItem) Rebuild() mitumcurrency.TransfersItem {
+	ams := make([]mitumcurrency.Amount, len(it.amounts))
+	for i := range it.amounts {
+		am := it.amounts[i]
+		ams[i] = am.WithBig(am.Big())
+	}
+
+	it.amounts = ams
+
+	return it
+}
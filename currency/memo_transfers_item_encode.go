@@ -0,0 +1,48 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *MemoTransfersItem) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	rc string,
+	bam []byte,
+	memo string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal MemoTransfersItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(rc, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		it.receiver = a
+	}
+
+	ham, err := enc.DecodeSlice(bam)
+	if err != nil {
+		return e(err, "")
+	}
+
+	amounts := make([]mitumcurrency.Amount, len(ham))
+	for i := range ham {
+		j, ok := ham[i].(mitumcurrency.Amount)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected Amount, not %T", ham[i]), "")
+		}
+
+		amounts[i] = j
+	}
+
+	it.amounts = amounts
+	it.memo = memo
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type MemoTransfersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Receiver base.Address           `json:"receiver"`
+	Amounts  []mitumcurrency.Amount `json:"amounts"`
+	Memo     string                 `json:"memo,omitempty"`
+}
+
+func (it MemoTransfersItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MemoTransfersItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Receiver:   it.receiver,
+		Amounts:    it.amounts,
+		Memo:       it.memo,
+	})
+}
+
+type MemoTransfersItemJSONUnMarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Receiver string          `json:"receiver"`
+	Amounts  json.RawMessage `json:"amounts"`
+	Memo     string          `json:"memo"`
+}
+
+func (it *MemoTransfersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of MemoTransfersItem")
+
+	var uit MemoTransfersItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e(err, "")
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Receiver, uit.Amounts, uit.Memo)
+}
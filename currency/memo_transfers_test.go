@@ -0,0 +1,153 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testMemoTransfers struct {
+	testProcessorSuite
+	cid mitumcurrency.CurrencyID
+}
+
+func (t *testMemoTransfers) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+}
+
+func (t *testMemoTransfers) item(receiver base.Address, memo string) MemoTransfersItem {
+	return NewMemoTransfersItem(
+		receiver,
+		[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		memo,
+	)
+}
+
+func (t *testMemoTransfers) TestTransfers() {
+	states := newTestStates(base.Height(33))
+	states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(receiver, t.cid, 0)
+
+	op, err := NewMemoTransfers(NewMemoTransfersFact(
+		util.UUID().Bytes(), sender, []MemoTransfersItem{t.item(receiver, "deposit-tag-33")}))
+	t.NoError(err)
+	t.NoError(op.HashSign(privs[0], t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	t.Nil(t.process(NewTransfersProcessor(), op, states))
+
+	t.Equal(mitumcurrency.NewBig(90), states.balance(sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), states.balance(receiver, t.cid))
+}
+
+func (t *testMemoTransfers) TestMemoInFactHash() {
+	token := util.UUID().Bytes()
+	sender := base.RandomAddress("")
+	receiver := base.RandomAddress("")
+
+	a := NewMemoTransfersFact(token, sender, []MemoTransfersItem{t.item(receiver, "showme")})
+	b := NewMemoTransfersFact(token, sender, []MemoTransfersItem{t.item(receiver, "findme")})
+
+	t.NoError(a.IsValid(nil))
+	t.NoError(b.IsValid(nil))
+	t.False(a.Hash().Equal(b.Hash()))
+}
+
+func (t *testMemoTransfers) TestInvalidMemo() {
+	t.Run("over max", func() {
+		err := t.item(base.RandomAddress(""), strings.Repeat("a", MaxMemoLength+1)).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "over max")
+	})
+
+	t.Run("max", func() {
+		t.NoError(t.item(base.RandomAddress(""), strings.Repeat("a", MaxMemoLength)).IsValid(nil))
+	})
+
+	t.Run("invalid utf-8", func() {
+		err := t.item(base.RandomAddress(""), string([]byte{0xff, 0xfe})).IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "memo should be valid utf-8 string")
+	})
+}
+
+func TestMemoTransfers(t *testing.T) {
+	suite.Run(t, new(testMemoTransfers))
+}
+
+func TestMemoTransfersFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AmountHint, Instance: mitumcurrency.Amount{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: MemoTransfersItemHint, Instance: MemoTransfersItem{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: MemoTransfersFactHint, Instance: MemoTransfersFact{}}))
+
+		fact := NewMemoTransfersFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]MemoTransfersItem{
+				NewMemoTransfersItem(
+					mitumcurrency.NewAddress(util.UUID().String()),
+					[]mitumcurrency.Amount{
+						mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+					},
+					"deposit-tag-33",
+				),
+				NewMemoTransfersItem(
+					mitumcurrency.NewAddress(util.UUID().String()),
+					[]mitumcurrency.Amount{
+						mitumcurrency.NewAmount(mitumcurrency.NewBig(20), mitumcurrency.CurrencyID("SHOWME")),
+					},
+					"",
+				),
+			},
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(MemoTransfersFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(MemoTransfersFact)
+		t.True(ok)
+		bf, ok := b.(MemoTransfersFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Memos(), bf.Memos())
+	}
+
+	suite.Run(tt, t)
+}
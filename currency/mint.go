@@ -0,0 +1,109 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	MintFactHint = hint.MustNewHint("mitum-currency-mint-operation-fact-v0.0.1")
+	MintHint     = hint.MustNewHint("mitum-currency-mint-operation-v0.0.1")
+)
+
+type MintFact struct {
+	base.BaseFact
+	sender   base.Address
+	receiver base.Address
+	amount   mitumcurrency.Amount
+}
+
+func NewMintFact(token []byte, sender, receiver base.Address, amount mitumcurrency.Amount) MintFact {
+	bf := base.NewBaseFact(MintFactHint, token)
+	fact := MintFact{
+		BaseFact: bf,
+		sender:   sender,
+		receiver: receiver,
+		amount:   amount,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MintFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MintFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MintFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MintFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.receiver.Bytes(),
+		fact.amount.Bytes(),
+	)
+}
+
+func (fact MintFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.receiver, fact.amount); err != nil {
+		return err
+	}
+
+	if !fact.amount.Big().OverZero() {
+		return util.ErrInvalid.Errorf("amount should be over zero")
+	}
+
+	return nil
+}
+
+func (fact MintFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact MintFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact MintFact) Amount() mitumcurrency.Amount {
+	return fact.amount
+}
+
+func (fact MintFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.receiver}, nil
+}
+
+// Mint issues new currency to the receiver; it should be signed by the minter
+// of currency policy.
+type Mint struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewMint(fact MintFact) (Mint, error) {
+	return Mint{BaseOperation: mitumcurrency.NewBaseOperation(MintHint, fact)}, nil
+}
+
+func (op *Mint) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type MintFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address         `json:"sender"`
+	Receiver base.Address         `json:"receiver"`
+	Amount   mitumcurrency.Amount `json:"amount"`
+}
+
+func (fact MintFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MintFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Receiver:              fact.receiver,
+		Amount:                fact.amount,
+	})
+}
+
+type MintFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Receiver string          `json:"receiver"`
+	Amount   json.RawMessage `json:"amount"`
+}
+
+func (fact *MintFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of MintFact")
+
+	var uf MintFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Receiver, uf.Amount)
+}
+
+type mintMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op Mint) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(mintMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Mint) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of Mint")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
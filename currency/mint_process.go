@@ -0,0 +1,223 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var mintProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MintProcessor)
+	},
+}
+
+func (Mint) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type MintProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewMintProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new MintProcessor")
+
+		nopp := mintProcessorPool.Get()
+		opp, ok := nopp.(*MintProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected MintProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *MintProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess Mint")
+
+	fact, ok := op.Fact().(MintFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected MintFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be mint sender, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("receiver not found, %q: %w", fact.receiver, err), nil
+	}
+
+	if err := checkActiveContractAccount(fact.receiver, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid receiver, %q: %w", fact.receiver, err), nil
+	}
+
+	cid := fact.amount.Currency()
+
+	policy, err := existsActiveCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err), nil
+	}
+
+	minter, found := policy.Minter(fact.sender)
+	if !found {
+		return ctx, base.NewBaseOperationProcessReasonError("sender is not minter of currency, %q, %q", fact.sender, cid), nil
+	}
+
+	if _, err := minter.Mint(fact.amount.Big()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to mint, %q: %w", cid, err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *MintProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process Mint")
+
+	fact, ok := op.Fact().(MintFact)
+	if !ok {
+		return nil, nil, e(nil, "expected MintFact, not %T", op.Fact())
+	}
+
+	cid := fact.amount.Currency()
+
+	st, err := existsState(StateKeyCurrencyDesign(cid), "key of currency design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", cid, err), nil
+	}
+
+	de, err := StateCurrencyDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get currency design value, %q: %w", cid, err), nil
+	}
+
+	minter, found := de.Policy().Minter(fact.sender)
+	if !found {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not minter of currency, %q, %q", fact.sender, cid), nil
+	}
+
+	minter, err = minter.Mint(fact.amount.Big())
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to mint, %q: %w", cid, err), nil
+	}
+
+	nde, err := de.AddAggregate(fact.amount.Big())
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to add aggregate, %q: %w", cid, err), nil
+	}
+	nde = nde.SetPolicy(nde.Policy().SetMinter(minter))
+
+	fee, err := de.Policy().FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", cid, err), nil
+	}
+
+	if fee.OverZero() {
+		if err := checkNotFrozen(fact.sender, cid, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+		}
+	}
+
+	sb, err := existsState(mitumcurrency.StateKeyBalance(fact.sender, cid), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.sender, err), nil
+	}
+
+	switch b, err := mitumcurrency.StateBalanceValue(sb); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", mitumcurrency.StateKeyBalance(fact.sender, cid), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.sender), nil
+	}
+
+	v, ok := sb.Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	if fact.sender.Equal(fact.receiver) {
+		sts = append(sts, NewBalanceStateMergeValue(
+			sb.Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(fact.amount.Big()).Sub(fee))),
+		))
+	} else {
+		sts = append(sts, NewBalanceStateMergeValue(
+			sb.Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		))
+
+		var rb mitumcurrency.Amount
+
+		k := mitumcurrency.StateKeyBalance(fact.receiver, cid)
+		switch st, found, err := getStateFunc(k); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find receiver balance state, %q: %w", k, err), nil
+		case !found:
+			rb = mitumcurrency.NewZeroAmount(cid)
+		default:
+			b, err := mitumcurrency.StateBalanceValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", k, err), nil
+			}
+			rb = b
+		}
+
+		sts = append(sts, NewBalanceStateMergeValue(
+			k,
+			mitumcurrency.NewBalanceStateValue(rb.WithBig(rb.Big().Add(fact.amount.Big()))),
+		))
+	}
+
+	sts = append(sts, NewCurrencyDesignStateMergeValue(st.Key(), NewCurrencyDesignStateValue(nde)))
+
+	fsts, err := CollectFee(factHint(op.Fact()), cid, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *MintProcessor) Close() error {
+	mintProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testMint struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	minter   base.Address
+	priv     base.Privatekey
+	receiver base.Address
+}
+
+func (t *testMint) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	minter, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(minter, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy().SetMinters(
+		[]CurrencyMinter{NewCurrencyMinter(minter, mitumcurrency.NewBig(50))},
+	))
+
+	t.minter, t.priv, t.receiver = minter, privs[0], receiver
+}
+
+func (t *testMint) mint(sender base.Address, priv base.Privatekey, big int64) Mint {
+	op, err := NewMint(NewMintFact(
+		util.UUID().Bytes(), sender, t.receiver, mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testMint) TestMint() {
+	t.Nil(t.process(NewMintProcessor(), t.mint(t.minter, t.priv, 30), t.states))
+
+	t.Equal(mitumcurrency.NewBig(30), t.states.balance(t.receiver, t.cid))
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.minter, t.cid))
+
+	de, err := t.states.currencyDesign(t.cid)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1000030), de.Aggregate())
+
+	minter, found := de.Policy().Minter(t.minter)
+	t.True(found)
+	t.Equal(mitumcurrency.NewBig(30), minter.Minted())
+
+	t.Run("over quota", func() {
+		reason := t.preProcess(NewMintProcessor(), t.mint(t.minter, t.priv, 21), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "over quota of minter")
+	})
+
+	t.Nil(t.process(NewMintProcessor(), t.mint(t.minter, t.priv, 20), t.states))
+	t.Equal(mitumcurrency.NewBig(50), t.states.balance(t.receiver, t.cid))
+}
+
+func (t *testMint) TestNotMinter() {
+	other, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(other, t.cid, 100)
+
+	reason := t.preProcess(NewMintProcessor(), t.mint(other, privs[0], 10), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "sender is not minter of currency")
+}
+
+func TestMint(t *testing.T) {
+	suite.Run(t, new(testMint))
+}
+
+func TestMintFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: MintFactHint, Instance: MintFact{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		fact := NewMintFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME")),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(MintFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(MintFact)
+		t.True(ok)
+		bf, ok := b.(MintFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.True(af.Amount().Equal(bf.Amount()))
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestCurrencyPolicyWithMintersEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: CurrencyMinterHint, Instance: CurrencyMinter{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		minter := NewCurrencyMinter(mitumcurrency.NewAddress(util.UUID().String()), mitumcurrency.NewBig(50))
+		minter, err := minter.Mint(mitumcurrency.NewBig(10))
+		t.NoError(err)
+
+		po := newTestCurrencyPolicy().SetMinters([]CurrencyMinter{
+			minter,
+			NewCurrencyMinter(mitumcurrency.NewAddress(util.UUID().String()), mitumcurrency.ZeroBig),
+		})
+		t.NoError(po.IsValid(nil))
+
+		b, err := enc.Marshal(po)
+		t.NoError(err)
+
+		return po, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyPolicy)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		ap, ok := a.(CurrencyPolicy)
+		t.True(ok)
+		bp, ok := b.(CurrencyPolicy)
+		t.True(ok)
+
+		t.NoError(bp.IsValid(nil))
+		t.Equal(ap.Bytes(), bp.Bytes())
+		t.Equal(len(ap.Minters()), len(bp.Minters()))
+
+		for i := range ap.Minters() {
+			t.True(ap.Minters()[i].Minted().Equal(bp.Minters()[i].Minted()))
+		}
+	}
+
+	suite.Run(tt, t)
+}
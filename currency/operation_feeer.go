@@ -0,0 +1,84 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var OperationFeeerHint = hint.MustNewHint("mitum-currency-operation-feeer-v0.0.1")
+
+var MaxOperationFeeers = 20
+
+// OperationFeeer is the feeer for the operation which has the fact of fact
+// hint type; the version of fact hint is not considered.
+type OperationFeeer struct {
+	hint.BaseHinter
+	fact  hint.Type
+	feeer Feeer
+}
+
+func NewOperationFeeer(fact hint.Type, feeer Feeer) OperationFeeer {
+	return OperationFeeer{
+		BaseHinter: hint.NewBaseHinter(OperationFeeerHint),
+		fact:       fact,
+		feeer:      feeer,
+	}
+}
+
+func (of OperationFeeer) Bytes() []byte {
+	return util.ConcatBytesSlice(of.fact.Bytes(), of.feeer.Bytes())
+}
+
+func (of OperationFeeer) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, of.BaseHinter, of.fact); err != nil {
+		return util.ErrInvalid.Errorf("invalid operation feeer: %w", err)
+	}
+
+	if of.feeer == nil {
+		return util.ErrInvalid.Errorf("empty feeer of operation feeer, %q", of.fact)
+	}
+
+	if err := of.feeer.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("invalid feeer of operation feeer, %q: %w", of.fact, err)
+	}
+
+	return nil
+}
+
+func (of OperationFeeer) Fact() hint.Type {
+	return of.fact
+}
+
+func (of OperationFeeer) Feeer() Feeer {
+	return of.feeer
+}
+
+// baseFactHints maps the fact of the wrapper operations to the fact of the
+// operation they wrap, so the wrapper operations pay the fee of the base
+// operation.
+var baseFactHints = map[hint.Type]hint.Hint{
+	MemoTransfersFactHint.Type():             mitumcurrency.TransfersFactHint,
+	SponsoredTransfersFactHint.Type():        mitumcurrency.TransfersFactHint,
+	FeeExchangeTransfersFactHint.Type():      mitumcurrency.TransfersFactHint,
+	SponsoredCreateAccountsFactHint.Type():   mitumcurrency.CreateAccountsFactHint,
+	FeeExchangeCreateAccountsFactHint.Type(): mitumcurrency.CreateAccountsFactHint,
+	SponsoredWithdrawsFactHint.Type():        WithdrawsFactHint,
+	FeeExchangeWithdrawsFactHint.Type():      WithdrawsFactHint,
+}
+
+// factHint returns the hint of fact for the fee; the wrapper operations
+// return the hint of base operation. Empty hint if fact is not hinted.
+func factHint(fact base.Fact) hint.Hint {
+	i, ok := fact.(hint.Hinter)
+	if !ok {
+		return hint.Hint{}
+	}
+
+	if h, found := baseFactHints[i.Hint().Type()]; found {
+		return h
+	}
+
+	return i.Hint()
+}
@@ -0,0 +1,40 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (of OperationFeeer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": of.Hint().String(),
+			"fact":  of.fact,
+			"feeer": of.feeer,
+		},
+	)
+}
+
+type OperationFeeerBSONUnmarshaler struct {
+	Hint  string   `bson:"_hint"`
+	Fact  string   `bson:"fact"`
+	Feeer bson.Raw `bson:"feeer"`
+}
+
+func (of *OperationFeeer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of OperationFeeer")
+
+	var uof OperationFeeerBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uof); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uof.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return of.unpack(enc, ht, uof.Fact, uof.Feeer)
+}
@@ -0,0 +1,27 @@
+package currency // nolint: dupl, revive
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (of *OperationFeeer) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	fact string,
+	bfe []byte,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal OperationFeeer")
+
+	of.BaseHinter = hint.NewBaseHinter(ht)
+	of.fact = hint.Type(fact)
+
+	var feeer Feeer
+	if err := encoder.Decode(enc, bfe, &feeer); err != nil {
+		return e(err, "failed to decode feeer")
+	}
+	of.feeer = feeer
+
+	return nil
+}
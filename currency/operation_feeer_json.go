@@ -0,0 +1,40 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type OperationFeeerJSONMarshaler struct {
+	hint.BaseHinter
+	Fact  hint.Type `json:"fact"`
+	Feeer Feeer     `json:"feeer"`
+}
+
+func (of OperationFeeer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationFeeerJSONMarshaler{
+		BaseHinter: of.BaseHinter,
+		Fact:       of.fact,
+		Feeer:      of.feeer,
+	})
+}
+
+type OperationFeeerJSONUnmarshaler struct {
+	Hint  hint.Hint       `json:"_hint"`
+	Fact  string          `json:"fact"`
+	Feeer json.RawMessage `json:"feeer"`
+}
+
+func (of *OperationFeeer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of OperationFeeer")
+
+	var uof OperationFeeerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uof); err != nil {
+		return e(err, "")
+	}
+
+	return of.unpack(enc, uof.Hint, uof.Fact, uof.Feeer)
+}
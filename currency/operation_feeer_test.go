@@ -0,0 +1,232 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testOperationFeeer struct {
+	testProcessorSuite
+	cid         mitumcurrency.CurrencyID
+	states      *testStates
+	sender      base.Address
+	priv        base.Privatekey
+	receiver    base.Address
+	feeReceiver base.Address
+}
+
+func newTestOperationFeeerPolicy(receiver base.Address) CurrencyPolicy {
+	return NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(receiver, mitumcurrency.NewBig(1), mitumcurrency.ZeroBig),
+	).SetOperationFeeers([]OperationFeeer{
+		NewOperationFeeer(
+			CreateContractAccountsFactHint.Type(),
+			NewFixedFeeer(receiver, mitumcurrency.NewBig(50), mitumcurrency.ZeroBig),
+		),
+	})
+}
+
+func newTestTransfersFeeerPolicy(receiver base.Address) CurrencyPolicy {
+	return NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(receiver, mitumcurrency.NewBig(1), mitumcurrency.ZeroBig),
+	).SetOperationFeeers([]OperationFeeer{
+		NewOperationFeeer(
+			mitumcurrency.TransfersFactHint.Type(),
+			NewFixedFeeer(receiver, mitumcurrency.NewBig(5), mitumcurrency.ZeroBig),
+		),
+		NewOperationFeeer(
+			WithdrawsFactHint.Type(),
+			NewFixedFeeer(receiver, mitumcurrency.NewBig(7), mitumcurrency.ZeroBig),
+		),
+	})
+}
+
+func (t *testOperationFeeer) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	feeReceiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(feeReceiver, t.cid, 0)
+
+	t.states.setCurrency(t.cid, newTestOperationFeeerPolicy(feeReceiver))
+
+	t.sender, t.priv, t.receiver, t.feeReceiver = sender, privs[0], receiver, feeReceiver
+}
+
+func (t *testOperationFeeer) TestFeeerOf() {
+	po := newTestOperationFeeerPolicy(t.feeReceiver)
+	t.NoError(po.IsValid(nil))
+
+	fee, err := po.FeeerOf(CreateContractAccountsFactHint).Fee(mitumcurrency.NewBig(10))
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(50), fee)
+
+	// NOTE the other operations fall back to the default feeer
+	fee, err = po.FeeerOf(mitumcurrency.TransfersFactHint).Fee(mitumcurrency.NewBig(10))
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1), fee)
+
+	fee, err = po.FeeerOf(WithdrawsFactHint).Fee(mitumcurrency.NewBig(10))
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(1), fee)
+}
+
+func (t *testOperationFeeer) TestCalculateItemsFee() {
+	items := []mitumcurrency.AmountsItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		),
+	}
+
+	required, err := CalculateItemsFee(t.states.getStateFunc, CreateContractAccountsFactHint, items)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(60), required[t.cid][0])
+	t.Equal(mitumcurrency.NewBig(50), required[t.cid][1])
+
+	required, err = CalculateItemsFee(t.states.getStateFunc, mitumcurrency.TransfersFactHint, items)
+	t.NoError(err)
+	t.Equal(mitumcurrency.NewBig(11), required[t.cid][0])
+	t.Equal(mitumcurrency.NewBig(1), required[t.cid][1])
+}
+
+func (t *testOperationFeeer) TestTransfers() {
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), t.sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	t.Nil(t.process(NewTransfersProcessor(), op, t.states))
+
+	t.Equal(mitumcurrency.NewBig(89), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(1), t.states.balance(t.feeReceiver, t.cid))
+}
+
+func (t *testOperationFeeer) TestWrapperOperations() {
+	po := newTestTransfersFeeerPolicy(t.feeReceiver)
+	t.NoError(po.IsValid(nil))
+
+	token := util.UUID().Bytes()
+	sender := base.RandomAddress("")
+
+	cases := []struct {
+		name string
+		fact base.Fact
+		fee  int64
+	}{
+		{name: "memo transfers", fact: NewMemoTransfersFact(token, sender, nil), fee: 5},
+		{name: "sponsored transfers", fact: NewSponsoredTransfersFact(token, sender, nil, sender, t.cid), fee: 5},
+		{name: "fee exchange transfers", fact: NewFeeExchangeTransfersFact(token, sender, nil, t.cid), fee: 5},
+		{name: "sponsored withdraws", fact: NewSponsoredWithdrawsFact(token, sender, nil, sender, t.cid), fee: 7},
+		{name: "fee exchange withdraws", fact: NewFeeExchangeWithdrawsFact(token, sender, nil, t.cid), fee: 7},
+	}
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func() {
+			fee, err := po.FeeerOf(factHint(c.fact)).Fee(mitumcurrency.NewBig(10))
+			t.NoError(err)
+			t.Equal(mitumcurrency.NewBig(c.fee), fee)
+		})
+	}
+}
+
+func (t *testOperationFeeer) TestMemoTransfers() {
+	t.states.setCurrency(t.cid, newTestTransfersFeeerPolicy(t.feeReceiver))
+
+	op, err := NewMemoTransfers(NewMemoTransfersFact(util.UUID().Bytes(), t.sender, []MemoTransfersItem{
+		NewMemoTransfersItem(
+			t.receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+			"showme",
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	t.Nil(t.process(NewTransfersProcessor(), op, t.states))
+
+	t.Equal(mitumcurrency.NewBig(85), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(5), t.states.balance(t.feeReceiver, t.cid))
+}
+
+func (t *testOperationFeeer) TestDuplicateOperationFeeer() {
+	of := NewOperationFeeer(
+		CreateContractAccountsFactHint.Type(),
+		NewFixedFeeer(t.feeReceiver, mitumcurrency.NewBig(50), mitumcurrency.ZeroBig),
+	)
+
+	po := newTestCurrencyPolicy().SetOperationFeeers([]OperationFeeer{of, of})
+
+	err := po.IsValid(nil)
+	t.Error(err)
+	t.ErrorContains(err, "duplicate operation feeer")
+}
+
+func TestOperationFeeer(t *testing.T) {
+	suite.Run(t, new(testOperationFeeer))
+}
+
+func TestCurrencyPolicyWithOperationFeeersEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: OperationFeeerHint, Instance: OperationFeeer{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		po := newTestOperationFeeerPolicy(mitumcurrency.NewAddress(util.UUID().String()))
+		t.NoError(po.IsValid(nil))
+
+		b, err := enc.Marshal(po)
+		t.NoError(err)
+
+		return po, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(CurrencyPolicy)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		ap, ok := a.(CurrencyPolicy)
+		t.True(ok)
+		bp, ok := b.(CurrencyPolicy)
+		t.True(ok)
+
+		t.NoError(bp.IsValid(nil))
+		t.Equal(ap.Bytes(), bp.Bytes())
+		t.Equal(len(ap.OperationFeeers()), len(bp.OperationFeeers()))
+
+		for i := range ap.OperationFeeers() {
+			t.Equal(ap.OperationFeeers()[i].Fact(), bp.OperationFeeers()[i].Fact())
+			t.Equal(ap.OperationFeeers()[i].Feeer().Bytes(), bp.OperationFeeers()[i].Feeer().Bytes())
+		}
+	}
+
+	suite.Run(tt, t)
+}
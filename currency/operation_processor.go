@@ -27,11 +27,23 @@ type GetNewProcessor func(
 	newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
 	newProcessConstraintFunc base.NewOperationProcessorProcessFunc) (base.OperationProcessor, error)
 
+// GetNewProcessorByHint finds the GetNewProcessor of the operation hint; it is
+// used to process the operation embedded in the other operation.
+type GetNewProcessorByHint func(hint.Hint) (GetNewProcessor, bool)
+
 type DuplicationType string
 
 const (
-	DuplicationTypeSender   DuplicationType = "sender"
-	DuplicationTypeCurrency DuplicationType = "currency"
+	DuplicationTypeSender    DuplicationType = "sender"
+	DuplicationTypeCurrency  DuplicationType = "currency"
+	DuplicationTypeExchange  DuplicationType = "exchange"
+	DuplicationTypeEscrow    DuplicationType = "escrow"
+	DuplicationTypeFreeze    DuplicationType = "freeze"
+	DuplicationTypeAllowance DuplicationType = "allowance"
+	DuplicationTypeSchedule  DuplicationType = "schedule"
+	DuplicationTypeRecovery  DuplicationType = "recovery"
+	DuplicationTypeContract  DuplicationType = "contract"
+	DuplicationTypeLock      DuplicationType = "lock"
 )
 
 type BaseOperationProcessor interface {
@@ -118,6 +130,17 @@ func (opr *OperationProcessor) SetProcessor(
 	return opr, nil
 }
 
+func (opr *OperationProcessor) GetNewProcessorByHint(ht hint.Hint) (GetNewProcessor, bool) {
+	i := opr.processorHintSet.Find(ht)
+	if i == nil {
+		return nil, false
+	}
+
+	f, ok := i.(GetNewProcessor)
+
+	return f, ok
+}
+
 func (opr *OperationProcessor) PreProcess(ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (context.Context, base.OperationProcessReasonError, error) {
 	e := util.StringErrorFunc("failed to preprocess for OperationProcessor")
 
@@ -171,9 +194,20 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 	opr.Lock()
 	defer opr.Unlock()
 
+	return opr.checkOperationDuplication(op)
+}
+
+func (opr *OperationProcessor) checkOperationDuplication(op base.Operation) error {
 	var did string
 	var didtype DuplicationType
 	var newAddresses []base.Address
+	var currencies []string
+	var freezes []string
+	var allowances []string
+	var recoveries []string
+	var contracts []string
+	var locks []string
+	var owner base.Address
 
 	switch t := op.(type) {
 	case mitumcurrency.CreateAccounts:
@@ -224,6 +258,331 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+
+		cs, err := opr.operatorWithdrawContracts(fact.Sender(), fact.Items())
+		if err != nil {
+			return err
+		}
+		contracts = cs
+	case FeeExchangeTransfers:
+		fact, ok := t.Fact().(FeeExchangeTransfersFact)
+		if !ok {
+			return errors.Errorf("expected FeeExchangeTransfersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case FeeExchangeCreateAccounts:
+		fact, ok := t.Fact().(FeeExchangeCreateAccountsFact)
+		if !ok {
+			return errors.Errorf("expected FeeExchangeCreateAccountsFact, not %T", t.Fact())
+		}
+		as, err := fact.Targets()
+		if err != nil {
+			return errors.Errorf("failed to get Addresses")
+		}
+		newAddresses = as
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case SponsoredTransfers:
+		fact, ok := t.Fact().(SponsoredTransfersFact)
+		if !ok {
+			return errors.Errorf("expected SponsoredTransfersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case SponsoredCreateAccounts:
+		fact, ok := t.Fact().(SponsoredCreateAccountsFact)
+		if !ok {
+			return errors.Errorf("expected SponsoredCreateAccountsFact, not %T", t.Fact())
+		}
+		as, err := fact.Targets()
+		if err != nil {
+			return errors.Errorf("failed to get Addresses")
+		}
+		newAddresses = as
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case FeeExchangeWithdraws:
+		fact, ok := t.Fact().(FeeExchangeWithdrawsFact)
+		if !ok {
+			return errors.Errorf("expected FeeExchangeWithdrawsFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		cs, err := opr.operatorWithdrawContracts(fact.Sender(), fact.Items())
+		if err != nil {
+			return err
+		}
+		contracts = cs
+	case SponsoredWithdraws:
+		fact, ok := t.Fact().(SponsoredWithdrawsFact)
+		if !ok {
+			return errors.Errorf("expected SponsoredWithdrawsFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		cs, err := opr.operatorWithdrawContracts(fact.Sender(), fact.Items())
+		if err != nil {
+			return err
+		}
+		contracts = cs
+	case UpdateContractAccountStatus:
+		fact, ok := t.Fact().(UpdateContractAccountStatusFact)
+		if !ok {
+			return errors.Errorf("expected UpdateContractAccountStatusFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		contracts = append(contracts, StateKeyContractAccount(fact.target))
+	case ContractAccountOwnerUpdater:
+		fact, ok := t.Fact().(ContractAccountOwnerUpdaterFact)
+		if !ok {
+			return errors.Errorf("expected ContractAccountOwnerUpdaterFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		contracts = append(contracts, StateKeyContractAccount(fact.contract))
+	case GrantContractAccountOperator:
+		fact, ok := t.Fact().(GrantContractAccountOperatorFact)
+		if !ok {
+			return errors.Errorf("expected GrantContractAccountOperatorFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		contracts = append(contracts, StateKeyContractAccount(fact.contract))
+	case RevokeContractAccountOperator:
+		fact, ok := t.Fact().(RevokeContractAccountOperatorFact)
+		if !ok {
+			return errors.Errorf("expected RevokeContractAccountOperatorFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		contracts = append(contracts, StateKeyContractAccount(fact.contract))
+	case UpdateContractAccountOwners:
+		fact, ok := t.Fact().(UpdateContractAccountOwnersFact)
+		if !ok {
+			return errors.Errorf("expected UpdateContractAccountOwnersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		contracts = append(contracts, StateKeyContractAccount(fact.contract))
+	case Burns:
+		fact, ok := t.Fact().(BurnsFact)
+		if !ok {
+			return errors.Errorf("expected BurnsFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		for i := range fact.Amounts() {
+			currencies = append(currencies, fact.Amounts()[i].Currency().String())
+		}
+	case Mint:
+		fact, ok := t.Fact().(MintFact)
+		if !ok {
+			return errors.Errorf("expected MintFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		currencies = append(currencies, fact.Amount().Currency().String())
+	case LockedTransfers:
+		fact, ok := t.Fact().(LockedTransfersFact)
+		if !ok {
+			return errors.Errorf("expected LockedTransfersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+
+		for i := range fact.items {
+			for j := range fact.items[i].Amounts() {
+				locks = append(locks, StateKeyLock(fact.items[i].Receiver(), fact.items[i].Amounts()[j].Currency()))
+			}
+		}
+	case MemoTransfers:
+		fact, ok := t.Fact().(MemoTransfersFact)
+		if !ok {
+			return errors.Errorf("expected MemoTransfersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case ClaimVested:
+		fact, ok := t.Fact().(ClaimVestedFact)
+		if !ok {
+			return errors.Errorf("expected ClaimVestedFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case CreateEscrow:
+		fact, ok := t.Fact().(CreateEscrowFact)
+		if !ok {
+			return errors.Errorf("expected CreateEscrowFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case ReleaseEscrow:
+		fact, ok := t.Fact().(ReleaseEscrowFact)
+		if !ok {
+			return errors.Errorf("expected ReleaseEscrowFact, not %T", t.Fact())
+		}
+		did = StateKeyEscrow(fact.Escrow())
+		didtype = DuplicationTypeEscrow
+
+		owner = fact.Sender()
+	case RefundEscrow:
+		fact, ok := t.Fact().(RefundEscrowFact)
+		if !ok {
+			return errors.Errorf("expected RefundEscrowFact, not %T", t.Fact())
+		}
+		did = StateKeyEscrow(fact.Escrow())
+		didtype = DuplicationTypeEscrow
+
+		owner = fact.Sender()
+	case ProposeOperation:
+		fact, ok := t.Fact().(ProposeOperationFact)
+		if !ok {
+			return errors.Errorf("expected ProposeOperationFact, not %T", t.Fact())
+		}
+
+		return opr.checkPendingOperationDuplication(fact.Sender(), fact.Operation())
+	case ApproveOperation:
+		fact, ok := t.Fact().(ApproveOperationFact)
+		if !ok {
+			return errors.Errorf("expected ApproveOperationFact, not %T", t.Fact())
+		}
+
+		var pending base.Operation
+
+		switch st, found, err := opr.GetStateFunc(StateKeyPendingOperation(fact.Pending())); {
+		case err != nil:
+			return err
+		case found:
+			po, err := StatePendingOperationValue(st)
+			if err != nil {
+				return err
+			}
+			pending = po.Operation()
+		}
+
+		return opr.checkPendingOperationDuplication(fact.Sender(), pending)
+	case ScheduleOperation:
+		fact, ok := t.Fact().(ScheduleOperationFact)
+		if !ok {
+			return errors.Errorf("expected ScheduleOperationFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case ExecuteScheduledOperation:
+		fact, ok := t.Fact().(ExecuteScheduledOperationFact)
+		if !ok {
+			return errors.Errorf("expected ExecuteScheduledOperationFact, not %T", t.Fact())
+		}
+		did = StateKeyScheduledOperation(fact.Schedule())
+
+		if _, found := opr.duplicated[did]; found {
+			return errors.Errorf("duplicate scheduled operation, %q found in proposal", did)
+		}
+
+		switch st, found, err := opr.GetStateFunc(did); {
+		case err != nil:
+			return err
+		case found:
+			so, err := StateScheduledOperationValue(st)
+			if err != nil {
+				return err
+			}
+
+			if err := opr.checkPendingOperationDuplication(so.Account(), so.Operation()); err != nil {
+				return err
+			}
+		}
+
+		opr.duplicated[did] = DuplicationTypeSchedule
+
+		return nil
+	case CancelScheduledOperation:
+		fact, ok := t.Fact().(CancelScheduledOperationFact)
+		if !ok {
+			return errors.Errorf("expected CancelScheduledOperationFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case Approve:
+		fact, ok := t.Fact().(ApproveFact)
+		if !ok {
+			return errors.Errorf("expected ApproveFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		allowances = append(allowances, StateKeyAllowance(fact.sender, fact.spender, fact.amount.Currency()))
+	case TransferFrom:
+		fact, ok := t.Fact().(TransferFromFact)
+		if !ok {
+			return errors.Errorf("expected TransferFromFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		owner = fact.owner
+		for i := range fact.amounts {
+			allowances = append(allowances, StateKeyAllowance(fact.owner, fact.sender, fact.amounts[i].Currency()))
+		}
+	case SetRecoveryGuardians:
+		fact, ok := t.Fact().(SetRecoveryGuardiansFact)
+		if !ok {
+			return errors.Errorf("expected SetRecoveryGuardiansFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		recoveries = append(recoveries, StateKeyAccountRecovery(fact.sender))
+	case RecoverAccount:
+		fact, ok := t.Fact().(RecoverAccountFact)
+		if !ok {
+			return errors.Errorf("expected RecoverAccountFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		owner = fact.account
+		recoveries = append(recoveries, StateKeyAccountRecovery(fact.account))
+	case CancelAccountRecovery:
+		fact, ok := t.Fact().(CancelAccountRecoveryFact)
+		if !ok {
+			return errors.Errorf("expected CancelAccountRecoveryFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+		recoveries = append(recoveries, StateKeyAccountRecovery(fact.sender))
+	case SetKeyRotationDelay:
+		fact, ok := t.Fact().(SetKeyRotationDelayFact)
+		if !ok {
+			return errors.Errorf("expected SetKeyRotationDelayFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case CancelKeyRotation:
+		fact, ok := t.Fact().(CancelKeyRotationFact)
+		if !ok {
+			return errors.Errorf("expected CancelKeyRotationFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case FreezeAccounts:
+		fact, ok := t.Fact().(FreezeAccountsFact)
+		if !ok {
+			return errors.Errorf("expected FreezeAccountsFact, not %T", t.Fact())
+		}
+		for i := range fact.accounts {
+			freezes = append(freezes, StateKeyFreeze(fact.accounts[i], fact.currency))
+		}
+	case UnfreezeAccounts:
+		fact, ok := t.Fact().(UnfreezeAccountsFact)
+		if !ok {
+			return errors.Errorf("expected UnfreezeAccountsFact, not %T", t.Fact())
+		}
+		for i := range fact.accounts {
+			freezes = append(freezes, StateKeyFreeze(fact.accounts[i], fact.currency))
+		}
 	case CurrencyRegister:
 		fact, ok := t.Fact().(CurrencyRegisterFact)
 		if !ok {
@@ -238,13 +597,29 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		}
 		did = fact.currency.String()
 		didtype = DuplicationTypeCurrency
+	case CurrencyMetadataUpdater:
+		fact, ok := t.Fact().(CurrencyMetadataUpdaterFact)
+		if !ok {
+			return errors.Errorf("expected CurrencyMetadataUpdaterFact, not %T", t.Fact())
+		}
+		did = fact.currency.String()
+		didtype = DuplicationTypeCurrency
+	case ExchangeRateUpdater:
+		fact, ok := t.Fact().(ExchangeRateUpdaterFact)
+		if !ok {
+			return errors.Errorf("expected ExchangeRateUpdaterFact, not %T", t.Fact())
+		}
+		did = StateKeyExchangeRate(fact.rate.From(), fact.rate.To())
+		didtype = DuplicationTypeExchange
 	case mitumcurrency.SuffrageInflation:
-		// fact, ok := t.Fact().(mitumcurrency.SuffrageInflationFact)
-		// if !ok {
-		// 	return errors.Errorf("expected SuffrageInflationFact, not %T", t.Fact())
-		// }
-		// did = fact.mitumcurrency.String()
-		// didtype = DuplicationTypeCurrency
+		fact, ok := t.Fact().(mitumcurrency.SuffrageInflationFact)
+		if !ok {
+			return errors.Errorf("expected SuffrageInflationFact, not %T", t.Fact())
+		}
+
+		for i := range fact.Items() {
+			currencies = append(currencies, fact.Items()[i].Amount().Currency().String())
+		}
 	default:
 		return nil
 	}
@@ -256,6 +631,12 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 				return errors.Errorf("violates only one sender in proposal")
 			case DuplicationTypeCurrency:
 				return errors.Errorf("duplicate currency id, %q found in proposal", did)
+			case DuplicationTypeExchange:
+				return errors.Errorf("duplicate exchange rate, %q found in proposal", did)
+			case DuplicationTypeEscrow:
+				return errors.Errorf("duplicate escrow, %q found in proposal", did)
+			case DuplicationTypeSchedule:
+				return errors.Errorf("duplicate scheduled operation, %q found in proposal", did)
 			default:
 				return errors.Errorf("violates duplication in proposal")
 			}
@@ -264,6 +645,97 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 		opr.duplicated[did] = didtype
 	}
 
+	// NOTE fee payer pays the fee like sender, so fee payer can not be the
+	// sender of the other operations in proposal
+	if feePayer := feePayerOf(op.Fact()); feePayer != nil {
+		if _, found := opr.duplicated[feePayer.String()]; found {
+			return errors.Errorf("violates only one sender in proposal")
+		}
+
+		opr.duplicated[feePayer.String()] = DuplicationTypeSender
+	}
+
+	// NOTE owner of TransferFrom pays the amounts like sender, the resolver of
+	// escrow pays the fee, and the keys of recovered account can be replaced,
+	// so they can not be the sender of the other operations in proposal
+	if owner != nil {
+		if _, found := opr.duplicated[owner.String()]; found {
+			return errors.Errorf("violates only one sender in proposal")
+		}
+
+		opr.duplicated[owner.String()] = DuplicationTypeSender
+	}
+
+	// NOTE operations which update the currency design are not allowed with
+	// other currency operations of same currency in proposal
+	for i := range currencies {
+		if _, found := opr.duplicated[currencies[i]]; found {
+			return errors.Errorf("duplicate currency id, %q found in proposal", currencies[i])
+		}
+	}
+
+	for i := range currencies {
+		opr.duplicated[currencies[i]] = DuplicationTypeCurrency
+	}
+
+	// NOTE the freeze state of account can be updated once in proposal
+	for i := range freezes {
+		if _, found := opr.duplicated[freezes[i]]; found {
+			return errors.Errorf("duplicate freeze, %q found in proposal", freezes[i])
+		}
+	}
+
+	for i := range freezes {
+		opr.duplicated[freezes[i]] = DuplicationTypeFreeze
+	}
+
+	// NOTE the allowance can be updated once in proposal
+	for i := range allowances {
+		if _, found := opr.duplicated[allowances[i]]; found {
+			return errors.Errorf("duplicate allowance, %q found in proposal", allowances[i])
+		}
+	}
+
+	for i := range allowances {
+		opr.duplicated[allowances[i]] = DuplicationTypeAllowance
+	}
+
+	// NOTE the recovery of account can be updated once in proposal
+	for i := range recoveries {
+		if _, found := opr.duplicated[recoveries[i]]; found {
+			return errors.Errorf("duplicate account recovery, %q found in proposal", recoveries[i])
+		}
+	}
+
+	for i := range recoveries {
+		opr.duplicated[recoveries[i]] = DuplicationTypeRecovery
+	}
+
+	// NOTE the contract account state is replaced by the operations which
+	// update the status, owners or operators of contract account and by the
+	// withdraws of operator, so it can be updated once in proposal
+	for i := range contracts {
+		if _, found := opr.duplicated[contracts[i]]; found {
+			return errors.Errorf("duplicate contract account, %q found in proposal", contracts[i])
+		}
+	}
+
+	for i := range contracts {
+		opr.duplicated[contracts[i]] = DuplicationTypeContract
+	}
+
+	// NOTE the number of vesting locks is checked with the locks before
+	// proposal, so the vesting locks of receiver can be added once in proposal
+	for i := range locks {
+		if _, found := opr.duplicated[locks[i]]; found {
+			return errors.Errorf("duplicate vesting lock, %q found in proposal", locks[i])
+		}
+	}
+
+	for i := range locks {
+		opr.duplicated[locks[i]] = DuplicationTypeLock
+	}
+
 	if len(newAddresses) > 0 {
 		if err := opr.checkNewAddressDuplication(newAddresses); err != nil {
 			return err
@@ -273,6 +745,57 @@ func (opr *OperationProcessor) checkDuplication(op base.Operation) error {
 	return nil
 }
 
+// checkPendingOperationDuplication checks the duplication of the pending or
+// scheduled operation like it is processed in proposal, because it is
+// processed inside of ProposeOperation, ApproveOperation or
+// ExecuteScheduledOperation.
+func (opr *OperationProcessor) checkPendingOperationDuplication(sender base.Address, op base.Operation) error {
+	if _, found := opr.duplicated[sender.String()]; found {
+		return errors.Errorf("violates only one sender in proposal")
+	}
+
+	if op != nil {
+		if err := opr.checkOperationDuplication(op); err != nil {
+			return err
+		}
+	}
+
+	opr.duplicated[sender.String()] = DuplicationTypeSender
+
+	return nil
+}
+
+// operatorWithdrawContracts returns the state keys of the contract accounts
+// which sender withdraws from as operator; the withdraw of operator spends the
+// allowance in the contract account state.
+func (opr *OperationProcessor) operatorWithdrawContracts(sender base.Address, items []WithdrawsItem) ([]string, error) {
+	var keys []string
+
+	for i := range items {
+		k := StateKeyContractAccount(items[i].Target())
+
+		switch st, found, err := opr.GetStateFunc(k); {
+		case err != nil:
+			return nil, err
+		case !found:
+			continue
+		default:
+			ca, err := StateContractAccountValue(st)
+			if err != nil {
+				return nil, err
+			}
+
+			if ca.Owner().Equal(sender) || ca.IsOwner(sender) {
+				continue
+			}
+
+			keys = append(keys, k)
+		}
+	}
+
+	return keys, nil
+}
+
 func (opr *OperationProcessor) checkNewAddressDuplication(as []base.Address) error {
 	for i := range as {
 		if _, found := opr.duplicatedNewAddress[as[i].String()]; found {
@@ -338,8 +861,43 @@ func (opr *OperationProcessor) getNewProcessor(op base.Operation) (base.Operatio
 		mitumcurrency.Transfers,
 		CreateContractAccounts,
 		Withdraws,
+		FeeExchangeTransfers,
+		FeeExchangeCreateAccounts,
+		SponsoredTransfers,
+		SponsoredCreateAccounts,
+		FeeExchangeWithdraws,
+		SponsoredWithdraws,
+		UpdateContractAccountStatus,
+		ContractAccountOwnerUpdater,
+		GrantContractAccountOperator,
+		RevokeContractAccountOperator,
+		UpdateContractAccountOwners,
+		Burns,
+		Mint,
+		LockedTransfers,
+		ClaimVested,
+		CreateEscrow,
+		ReleaseEscrow,
+		RefundEscrow,
+		ProposeOperation,
+		ApproveOperation,
+		FreezeAccounts,
+		UnfreezeAccounts,
+		Approve,
+		TransferFrom,
+		MemoTransfers,
+		ScheduleOperation,
+		ExecuteScheduledOperation,
+		CancelScheduledOperation,
+		SetRecoveryGuardians,
+		RecoverAccount,
+		CancelAccountRecovery,
+		SetKeyRotationDelay,
+		CancelKeyRotation,
 		CurrencyRegister,
 		CurrencyPolicyUpdater,
+		CurrencyMetadataUpdater,
+		ExchangeRateUpdater,
 		mitumcurrency.SuffrageInflation:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
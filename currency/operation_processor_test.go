@@ -0,0 +1,150 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type testOperationDuplication struct {
+	testProcessorSuite
+	cid    mitumcurrency.CurrencyID
+	states *testStates
+}
+
+func (t *testOperationDuplication) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+}
+
+func (t *testOperationDuplication) newOperationProcessor() *OperationProcessor {
+	opr := NewOperationProcessor()
+	opr.GetStateFunc = t.states.getStateFunc
+
+	return opr
+}
+
+func (t *testOperationDuplication) account() (base.Address, base.Privatekey) {
+	a, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(a, t.cid, 100)
+
+	return a, privs[0]
+}
+
+// contract sets the contract account of owner with operators.
+func (t *testOperationDuplication) contract(owner base.Address, operators ...base.Address) base.Address {
+	contract, err := t.states.newContractAccount(owner)
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	ca, err := t.states.contractAccount(contract)
+	t.NoError(err)
+
+	for i := range operators {
+		ca, err = ca.SetOperator(NewContractAccountOperator(
+			operators[i],
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(50), t.cid)},
+		))
+		t.NoError(err)
+	}
+
+	t.states.set(StateKeyContractAccount(contract), NewContractAccountStateValue(ca))
+
+	return contract
+}
+
+func (t *testOperationDuplication) withdraws(sender base.Address, priv base.Privatekey, contract base.Address) Withdraws {
+	op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), sender, []WithdrawsItem{
+		NewWithdrawsItemMultiAmounts(
+			contract,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(30), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+
+	return op
+}
+
+func (t *testOperationDuplication) TestOperatorWithdraws() {
+	owner, _ := t.account()
+	a, apriv := t.account()
+	b, bpriv := t.account()
+
+	contract := t.contract(owner, a, b)
+
+	opr := t.newOperationProcessor()
+
+	t.NoError(opr.checkDuplication(t.withdraws(a, apriv, contract)))
+
+	err := opr.checkDuplication(t.withdraws(b, bpriv, contract))
+	t.Error(err)
+	t.ErrorContains(err, "duplicate contract account")
+}
+
+func (t *testOperationDuplication) TestOwnerWithdrawsWithOperatorWithdraws() {
+	owner, opriv := t.account()
+	a, apriv := t.account()
+
+	contract := t.contract(owner, a)
+
+	opr := t.newOperationProcessor()
+
+	// NOTE the withdraw of owner does not update the contract account state
+	t.NoError(opr.checkDuplication(t.withdraws(owner, opriv, contract)))
+	t.NoError(opr.checkDuplication(t.withdraws(a, apriv, contract)))
+}
+
+func (t *testOperationDuplication) TestOperatorWithdrawsWithContractAccountUpdate() {
+	owner, opriv := t.account()
+	a, apriv := t.account()
+	newOwner, _ := t.account()
+
+	contract := t.contract(owner, a)
+
+	revoke, err := NewRevokeContractAccountOperator(
+		NewRevokeContractAccountOperatorFact(util.UUID().Bytes(), owner, contract, a, t.cid))
+	t.NoError(err)
+	t.NoError(revoke.HashSign(opriv, t.networkID))
+
+	status, err := NewUpdateContractAccountStatus(
+		NewUpdateContractAccountStatusFact(util.UUID().Bytes(), owner, contract, false, t.cid))
+	t.NoError(err)
+	t.NoError(status.HashSign(opriv, t.networkID))
+
+	ownerUpdater, err := NewContractAccountOwnerUpdater(
+		NewContractAccountOwnerUpdaterFact(util.UUID().Bytes(), owner, contract, newOwner, t.cid))
+	t.NoError(err)
+	t.NoError(ownerUpdater.HashSign(opriv, t.networkID))
+
+	for _, op := range []base.Operation{revoke, status, ownerUpdater} {
+		opr := t.newOperationProcessor()
+
+		t.NoError(opr.checkDuplication(op))
+
+		err := opr.checkDuplication(t.withdraws(a, apriv, contract))
+		t.Error(err)
+		t.ErrorContains(err, "duplicate contract account")
+	}
+
+	t.Run("withdraws first", func() {
+		opr := t.newOperationProcessor()
+
+		t.NoError(opr.checkDuplication(t.withdraws(a, apriv, contract)))
+
+		err := opr.checkDuplication(revoke)
+		t.Error(err)
+		t.ErrorContains(err, "duplicate contract account")
+	})
+}
+
+func TestOperationDuplication(t *testing.T) {
+	suite.Run(t, new(testOperationDuplication))
+}
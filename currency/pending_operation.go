@@ -0,0 +1,211 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var PendingOperationHint = hint.MustNewHint("mitum-currency-pending-operation-v0.0.1")
+
+type PendingOperationStatus string
+
+const (
+	PendingOperationPending  = PendingOperationStatus("pending")
+	PendingOperationExecuted = PendingOperationStatus("executed")
+)
+
+func (s PendingOperationStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s PendingOperationStatus) IsValid([]byte) error {
+	switch s {
+	case PendingOperationPending, PendingOperationExecuted:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown pending operation status, %q", s)
+	}
+}
+
+// PendingOperation is the operation of multi-key account proposed by
+// ProposeOperation; the key holders approve it by ApproveOperation in the
+// later blocks. The approvals are the signs over the fact hash of the
+// operation, which come from the proposed operation and ApproveOperation, and
+// once the weight of approvals reaches the threshold of account keys, the
+// operation is processed with the approvals as its signs.
+// PendingOperation is identified by the fact hash of ProposeOperation.
+type PendingOperation struct {
+	hint.BaseHinter
+	id        util.Hash
+	account   base.Address
+	operation base.Operation
+	approvals []base.Sign
+	expiry    base.Height
+	status    PendingOperationStatus
+}
+
+func NewPendingOperation(
+	id util.Hash,
+	account base.Address,
+	operation base.Operation,
+	approvals []base.Sign,
+	expiry base.Height,
+) PendingOperation {
+	return PendingOperation{
+		BaseHinter: hint.NewBaseHinter(PendingOperationHint),
+		id:         id,
+		account:    account,
+		operation:  operation,
+		approvals:  approvals,
+		expiry:     expiry,
+		status:     PendingOperationPending,
+	}
+}
+
+func (po PendingOperation) Bytes() []byte {
+	bs := make([][]byte, len(po.approvals))
+	for i := range po.approvals {
+		bs[i] = po.approvals[i].Signer().Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		po.id.Bytes(),
+		po.account.Bytes(),
+		po.operation.Hash().Bytes(),
+		util.ConcatBytesSlice(bs...),
+		po.expiry.Bytes(),
+		po.status.Bytes(),
+	)
+}
+
+func (po PendingOperation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		po.BaseHinter,
+		po.id,
+		po.account,
+		po.expiry,
+		po.status,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid pending operation: %w", err)
+	}
+
+	if po.operation == nil {
+		return util.ErrInvalid.Errorf("empty operation of pending operation")
+	}
+
+	if len(po.approvals) < 1 {
+		return util.ErrInvalid.Errorf("empty approvals of pending operation")
+	}
+
+	founds := map[string]struct{}{}
+	for i := range po.approvals {
+		k := po.approvals[i].Signer().String()
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicate approval found, %q", k)
+		}
+		founds[k] = struct{}{}
+	}
+
+	return nil
+}
+
+func (po PendingOperation) ID() util.Hash {
+	return po.id
+}
+
+func (po PendingOperation) Account() base.Address {
+	return po.account
+}
+
+func (po PendingOperation) Operation() base.Operation {
+	return po.operation
+}
+
+func (po PendingOperation) Approvals() []base.Sign {
+	return po.approvals
+}
+
+func (po PendingOperation) Expiry() base.Height {
+	return po.expiry
+}
+
+func (po PendingOperation) Status() PendingOperationStatus {
+	return po.status
+}
+
+func (po PendingOperation) IsPending() bool {
+	return po.status == PendingOperationPending
+}
+
+// IsExpired returns true when the block height is over the expiry height.
+func (po PendingOperation) IsExpired(height base.Height) bool {
+	return height > po.expiry
+}
+
+// Approve adds the signs of the new approvers; it returns the number of
+// added approvals.
+func (po PendingOperation) Approve(signs []base.Sign) (PendingOperation, int) {
+	founds := map[string]struct{}{}
+	for i := range po.approvals {
+		founds[po.approvals[i].Signer().String()] = struct{}{}
+	}
+
+	approvals := make([]base.Sign, len(po.approvals), len(po.approvals)+len(signs))
+	copy(approvals, po.approvals)
+
+	for i := range signs {
+		k := signs[i].Signer().String()
+		if _, found := founds[k]; found {
+			continue
+		}
+
+		approvals = append(approvals, signs[i])
+		founds[k] = struct{}{}
+	}
+
+	added := len(approvals) - len(po.approvals)
+	po.approvals = approvals
+
+	return po, added
+}
+
+// IsApproved checks the weight of approvals reaches the threshold of keys.
+func (po PendingOperation) IsApproved(keys mitumcurrency.AccountKeys) bool {
+	return checkThreshold(po.approvals, keys) == nil
+}
+
+func (po PendingOperation) Executed() PendingOperation {
+	po.status = PendingOperationExecuted
+
+	return po
+}
+
+// ApprovedOperation returns the operation, which has the approvals as its
+// signs. The approvals are signed with PendingNetworkID and
+// ApprovalNetworkID, so the operation is not valid by itself.
+func (po PendingOperation) ApprovedOperation() base.Operation {
+	return approvedOperation{Operation: po.operation, signs: po.approvals}
+}
+
+type approvedOperation struct {
+	base.Operation
+	signs []base.Sign
+}
+
+func (op approvedOperation) Signs() []base.Sign {
+	return op.signs
+}
+
+// pendingOperationSender returns the account which sends the operation.
+func pendingOperationSender(fact base.Fact) base.Address {
+	switch t := fact.(type) {
+	case interface{ Sender() base.Address }:
+		return t.Sender()
+	case interface{ Target() base.Address }:
+		return t.Target()
+	default:
+		return nil
+	}
+}
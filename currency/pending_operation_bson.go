@@ -0,0 +1,54 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (po PendingOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     po.Hint().String(),
+			"id":        po.id.String(),
+			"account":   po.account,
+			"operation": po.operation,
+			"approvals": po.approvals,
+			"expiry":    po.expiry,
+			"status":    po.status,
+		},
+	)
+}
+
+type PendingOperationBSONUnmarshaler struct {
+	Hint      string      `bson:"_hint"`
+	ID        string      `bson:"id"`
+	Account   string      `bson:"account"`
+	Operation bson.Raw    `bson:"operation"`
+	Expiry    base.Height `bson:"expiry"`
+	Status    string      `bson:"status"`
+	// Approvals []bson.Raw `bson:"approvals"`
+}
+
+func (po *PendingOperation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of PendingOperation")
+
+	var upo PendingOperationBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &upo); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(upo.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	// NOTE like the signs of BaseOperation, approvals are not decoded from bson
+	return po.unpack(
+		enc, ht, valuehash.NewBytesFromString(upo.ID),
+		upo.Account, upo.Operation, upo.Expiry, upo.Status,
+	)
+}
@@ -0,0 +1,41 @@
+package currency // nolint: dupl, revive
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (po *PendingOperation) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id util.Hash,
+	ac string,
+	bop []byte,
+	expiry base.Height,
+	status string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal PendingOperation")
+
+	po.BaseHinter = hint.NewBaseHinter(ht)
+	po.id = id
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		po.account = a
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(enc, bop, &op); err != nil {
+		return e(err, "failed to decode operation")
+	}
+	po.operation = op
+
+	po.expiry = expiry
+	po.status = PendingOperationStatus(status)
+
+	return nil
+}
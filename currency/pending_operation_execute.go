@@ -0,0 +1,188 @@
+package currency
+
+import (
+	"context"
+	"io"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+// checkPendingOperationAccount checks that the account can have the pending
+// operation and returns the keys of account; every signer should be the key of
+// account.
+func checkPendingOperationAccount(
+	account base.Address,
+	signs []base.Sign,
+	getStateFunc base.GetStateFunc,
+) (mitumcurrency.AccountKeys, base.OperationProcessReasonError) {
+	st, err := existsState(mitumcurrency.StateKeyAccount(account), "keys of account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", account, err)
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(account), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"contract account cannot have pending operation, %q: %w", account, err)
+	}
+
+	keys, err := mitumcurrency.StateKeysValue(st)
+	switch {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get keys, %q: %w", account, err)
+	case keys == nil:
+		return nil, base.NewBaseOperationProcessReasonError("empty keys found, %q", account)
+	}
+
+	if err := checkSignersOfKeys(account, keys, signs); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// checkSignersOfKeys checks that every signer is the key of account.
+func checkSignersOfKeys(
+	account base.Address,
+	keys mitumcurrency.AccountKeys,
+	signs []base.Sign,
+) base.OperationProcessReasonError {
+	for i := range signs {
+		if _, found := keys.Key(signs[i].Signer()); !found {
+			return base.NewBaseOperationProcessReasonError(
+				"signer is not the key of account, %q: %q", account, signs[i].Signer())
+		}
+	}
+
+	return nil
+}
+
+func checkPendingOperationProcessor(
+	op base.Operation,
+	getNewProcessorByHint GetNewProcessorByHint,
+) (GetNewProcessor, error) {
+	hinter, ok := op.(hint.Hinter)
+	if !ok {
+		return nil, errors.Errorf("expected hint.Hinter, not %T", op)
+	}
+
+	f, found := getNewProcessorByHint(hinter.Hint())
+	if !found {
+		return nil, errors.Errorf("processor not found, %q", hinter.Hint())
+	}
+
+	return f, nil
+}
+
+// executePendingOperation processes the approved pending operation with the
+// approvals as its signs and closes the pending operation. The approvals are
+// the signs over the fact hash of operation, which are verified by
+// ProposeOperation and ApproveOperation; the weight of them is checked again
+// with the current keys of account before the operation is processed.
+func executePendingOperation(
+	ctx context.Context,
+	po PendingOperation,
+	getNewProcessorByHint GetNewProcessorByHint,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	if err := checkFactSignsByState(po.Account(), po.Approvals(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"approvals of pending operation not passed, %q: %w", po.ID(), err), nil
+	}
+
+	op := po.ApprovedOperation()
+
+	f, err := checkPendingOperationProcessor(op, getNewProcessorByHint)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to process pending operation, %q: %w", po.ID(), err), nil
+	}
+
+	opp, err := f(height, getStateFunc, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if i, ok := opp.(io.Closer); ok {
+		defer func() {
+			_ = i.Close()
+		}()
+	}
+
+	switch _, rerr, err := opp.PreProcess(ctx, op, getStateFunc); {
+	case err != nil:
+		return nil, nil, err
+	case rerr != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to preprocess pending operation, %q: %w", po.ID(), rerr), nil
+	}
+
+	sts, rerr, err := opp.Process(ctx, op, getStateFunc)
+	switch {
+	case err != nil:
+		return nil, nil, err
+	case rerr != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to process pending operation, %q: %w", po.ID(), rerr), nil
+	}
+
+	return append(sts, NewPendingOperationStateMergeValue(
+		StateKeyPendingOperation(po.ID()),
+		NewPendingOperationStateValue(po.Executed()),
+	)), nil, nil
+}
+
+func checkApprovePendingOperation(
+	op base.Operation,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) (PendingOperation, mitumcurrency.AccountKeys, base.OperationProcessReasonError) {
+	fact, ok := op.Fact().(ApproveOperationFact)
+	if !ok {
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"expected ApproveOperationFact, not %T", op.Fact())
+	}
+
+	keys, rerr := checkPendingOperationAccount(fact.Sender(), op.Signs(), getStateFunc)
+	if rerr != nil {
+		return PendingOperation{}, nil, rerr
+	}
+
+	if rerr := checkSignersOfKeys(fact.Sender(), keys, fact.Approvals()); rerr != nil {
+		return PendingOperation{}, nil, rerr
+	}
+
+	st, err := existsState(StateKeyPendingOperation(fact.Pending()), "key of pending operation", getStateFunc)
+	if err != nil {
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"pending operation not found, %q: %w", fact.Pending(), err)
+	}
+
+	po, err := StatePendingOperationValue(st)
+	if err != nil {
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"failed to get pending operation value, %q: %w", fact.Pending(), err)
+	}
+
+	switch {
+	case !po.Account().Equal(fact.Sender()):
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"pending operation is not of sender, %q: %q", fact.Pending(), fact.Sender())
+	case !po.Operation().Fact().Hash().Equal(fact.Operation()):
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"approvals are not of pending operation, %q: %q", fact.Pending(), fact.Operation())
+	case !po.IsPending():
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"pending operation already %s, %q", po.Status(), fact.Pending())
+	case po.IsExpired(height):
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"pending operation expired, %q; %d > %d", fact.Pending(), height, po.Expiry())
+	}
+
+	if _, added := po.Approve(fact.Approvals()); added < 1 {
+		return PendingOperation{}, nil, base.NewBaseOperationProcessReasonError(
+			"no new approval for pending operation, %q", fact.Pending())
+	}
+
+	return po, keys, nil
+}
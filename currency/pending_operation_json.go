@@ -0,0 +1,65 @@
+package currency
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type PendingOperationJSONMarshaler struct {
+	hint.BaseHinter
+	ID        util.Hash              `json:"id"`
+	Account   base.Address           `json:"account"`
+	Operation base.Operation         `json:"operation"`
+	Approvals []base.Sign            `json:"approvals"`
+	Expiry    base.Height            `json:"expiry"`
+	Status    PendingOperationStatus `json:"status"`
+}
+
+func (po PendingOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PendingOperationJSONMarshaler{
+		BaseHinter: po.BaseHinter,
+		ID:         po.id,
+		Account:    po.account,
+		Operation:  po.operation,
+		Approvals:  po.approvals,
+		Expiry:     po.expiry,
+		Status:     po.status,
+	})
+}
+
+type PendingOperationJSONUnmarshaler struct {
+	Hint      hint.Hint             `json:"_hint"`
+	ID        valuehash.HashDecoder `json:"id"`
+	Account   string                `json:"account"`
+	Operation json.RawMessage       `json:"operation"`
+	Approvals []json.RawMessage     `json:"approvals"`
+	Expiry    base.Height           `json:"expiry"`
+	Status    string                `json:"status"`
+}
+
+func (po *PendingOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of PendingOperation")
+
+	var upo PendingOperationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &upo); err != nil {
+		return e(err, "")
+	}
+
+	approvals := make([]base.Sign, len(upo.Approvals))
+	for i := range upo.Approvals {
+		var ub base.BaseSign
+		if err := ub.DecodeJSON(upo.Approvals[i], enc); err != nil {
+			return e(err, "failed to decode approval")
+		}
+
+		approvals[i] = ub
+	}
+	po.approvals = approvals
+
+	return po.unpack(enc, upo.Hint, upo.ID.Hash(), upo.Account, upo.Operation, upo.Expiry, upo.Status)
+}
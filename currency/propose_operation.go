@@ -0,0 +1,166 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ProposeOperationFactHint = hint.MustNewHint("mitum-currency-propose-operation-operation-fact-v0.0.1")
+	ProposeOperationHint     = hint.MustNewHint("mitum-currency-propose-operation-operation-v0.0.1")
+)
+
+// MaxPendingOperationLifespan is the maximum number of blocks in which the
+// pending operation can be approved after it is proposed.
+var MaxPendingOperationLifespan = base.Height(100000)
+
+type ProposeOperationFact struct {
+	base.BaseFact
+	sender    base.Address
+	operation base.Operation
+	lifespan  base.Height
+}
+
+func NewProposeOperationFact(
+	token []byte,
+	sender base.Address,
+	operation base.Operation,
+	lifespan base.Height,
+) ProposeOperationFact {
+	bf := base.NewBaseFact(ProposeOperationFactHint, token)
+	fact := ProposeOperationFact{
+		BaseFact:  bf,
+		sender:    sender,
+		operation: operation,
+		lifespan:  lifespan,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ProposeOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ProposeOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ProposeOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ProposeOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.operation.Hash().Bytes(),
+		fact.lifespan.Bytes(),
+	)
+}
+
+func (fact ProposeOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender); err != nil {
+		return err
+	}
+
+	if fact.operation == nil {
+		return util.ErrInvalid.Errorf("empty operation")
+	}
+
+	switch {
+	case fact.lifespan < 1:
+		return util.ErrInvalid.Errorf("lifespan under 1, %d", fact.lifespan)
+	case fact.lifespan > MaxPendingOperationLifespan:
+		return util.ErrInvalid.Errorf("lifespan, %d over max, %d", fact.lifespan, MaxPendingOperationLifespan)
+	}
+
+	switch fact.operation.(type) {
+	case ProposeOperation, ApproveOperation:
+		return util.ErrInvalid.Errorf("operation can not be proposed, %T", fact.operation)
+	}
+
+	// NOTE the operation should be signed with the network id of proposal, so
+	// it can not be processed without the proposal or proposed again.
+	if err := fact.operation.IsValid(PendingNetworkID(b, fact.Token(), fact.lifespan)); err != nil {
+		return util.ErrInvalid.Errorf("invalid operation: %w", err)
+	}
+
+	switch sender := pendingOperationSender(fact.operation.Fact()); {
+	case sender == nil:
+		return util.ErrInvalid.Errorf("operation without sender can not be proposed, %T", fact.operation)
+	case !sender.Equal(fact.sender):
+		return util.ErrInvalid.Errorf("sender of operation is not matched with sender, %q != %q", sender, fact.sender)
+	}
+
+	if feePayerOf(fact.operation.Fact()) != nil {
+		return util.ErrInvalid.Errorf("operation with fee payer can not be proposed, %T", fact.operation)
+	}
+
+	return nil
+}
+
+func (fact ProposeOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ProposeOperationFact) Operation() base.Operation {
+	return fact.operation
+}
+
+func (fact ProposeOperationFact) Lifespan() base.Height {
+	return fact.lifespan
+}
+
+func (fact ProposeOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// PendingOperation returns the pending operation, which is identified by the
+// fact hash; the signs of the proposed operation are the first approvals and
+// it expires after lifespan from the proposed height.
+func (fact ProposeOperationFact) PendingOperation(height base.Height) PendingOperation {
+	return NewPendingOperation(
+		fact.Hash(), fact.sender, fact.operation, fact.operation.Signs(), height+fact.lifespan)
+}
+
+// PendingNetworkID returns the network id for the signs of the proposed
+// operation; the operation signed by it is only valid in the proposal of the
+// token and lifespan.
+func PendingNetworkID(networkID []byte, token base.Token, lifespan base.Height) base.NetworkID {
+	return util.ConcatBytesSlice(networkID, []byte("pending"), token, lifespan.Bytes())
+}
+
+// ProposeOperation proposes the operation of multi-key account; the operation
+// is processed when the weight of approvals reaches the threshold of account
+// keys.
+//
+// The operation should be signed with PendingNetworkID, so it can not be
+// processed by itself.
+type ProposeOperation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewProposeOperation(fact ProposeOperationFact) (ProposeOperation, error) {
+	return ProposeOperation{BaseOperation: mitumcurrency.NewBaseOperation(ProposeOperationHint, fact)}, nil
+}
+
+func (op *ProposeOperation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ProposeOperationFact) unpack(enc encoder.Encoder, sd string, bop []byte, lifespan base.Height) error {
+	e := util.StringErrorFunc("failed to unmarshal ProposeOperationFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(enc, bop, &op); err != nil {
+		return e(err, "failed to decode operation")
+	}
+	fact.operation = op
+	fact.lifespan = lifespan
+
+	return nil
+}
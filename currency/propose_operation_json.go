@@ -0,0 +1,69 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ProposeOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address   `json:"sender"`
+	Operation base.Operation `json:"operation"`
+	Lifespan  base.Height    `json:"lifespan"`
+}
+
+func (fact ProposeOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposeOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Operation:             fact.operation,
+		Lifespan:              fact.lifespan,
+	})
+}
+
+type ProposeOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string          `json:"sender"`
+	Operation json.RawMessage `json:"operation"`
+	Lifespan  base.Height     `json:"lifespan"`
+}
+
+func (fact *ProposeOperationFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ProposeOperationFact")
+
+	var uf ProposeOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Operation, uf.Lifespan)
+}
+
+type proposeOperationMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ProposeOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(proposeOperationMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ProposeOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ProposeOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
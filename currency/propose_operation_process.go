@@ -0,0 +1,128 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var proposeOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ProposeOperationProcessor)
+	},
+}
+
+func (ProposeOperation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ProposeOperationProcessor struct {
+	*base.BaseOperationProcessor
+	getNewProcessorByHint GetNewProcessorByHint
+}
+
+func NewProposeOperationProcessor(getNewProcessorByHint GetNewProcessorByHint) GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ProposeOperationProcessor")
+
+		nopp := proposeOperationProcessorPool.Get()
+		opp, ok := nopp.(*ProposeOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ProposeOperationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getNewProcessorByHint = getNewProcessorByHint
+
+		return opp, nil
+	}
+}
+
+func (opp *ProposeOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ProposeOperation")
+
+	fact, ok := op.Fact().(ProposeOperationFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ProposeOperationFact, not %T", op.Fact())
+	}
+
+	keys, rerr := checkPendingOperationAccount(fact.sender, op.Signs(), getStateFunc)
+	if rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if rerr := checkSignersOfKeys(fact.sender, keys, fact.operation.Signs()); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	if err := checkNotExistsState(StateKeyPendingOperation(fact.Hash()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("pending operation already exists, %q: %w", fact.Hash(), err), nil
+	}
+
+	if _, err := checkPendingOperationProcessor(fact.operation, opp.getNewProcessorByHint); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("operation can not be proposed: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ProposeOperationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ProposeOperation")
+
+	fact, ok := op.Fact().(ProposeOperationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ProposeOperationFact, not %T", op.Fact())
+	}
+
+	keys, rerr := checkPendingOperationAccount(fact.sender, op.Signs(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	if rerr := checkSignersOfKeys(fact.sender, keys, fact.operation.Signs()); rerr != nil {
+		return nil, rerr, nil
+	}
+
+	po := fact.PendingOperation(opp.Height())
+	if !po.IsApproved(keys) {
+		return []base.StateMergeValue{
+			NewPendingOperationStateMergeValue(StateKeyPendingOperation(po.ID()), NewPendingOperationStateValue(po)),
+		}, nil, nil
+	}
+
+	sts, rerr, err := executePendingOperation(ctx, po, opp.getNewProcessorByHint, opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, nil, e(err, "")
+	}
+
+	return sts, rerr, nil
+}
+
+func (opp *ProposeOperationProcessor) Close() error {
+	opp.getNewProcessorByHint = nil
+	proposeOperationProcessorPool.Put(opp)
+
+	return nil
+}
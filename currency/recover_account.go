@@ -0,0 +1,123 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RecoverAccountFactHint = hint.MustNewHint("mitum-currency-recover-account-operation-fact-v0.0.1")
+	RecoverAccountHint     = hint.MustNewHint("mitum-currency-recover-account-operation-v0.0.1")
+)
+
+// RecoverAccountFact is the approval of guardian for the new keys of account.
+// When the approved keys are ready, RecoverAccount with the same keys
+// replaces the account keys.
+type RecoverAccountFact struct {
+	base.BaseFact
+	sender   base.Address
+	account  base.Address
+	keys     mitumcurrency.AccountKeys
+	currency mitumcurrency.CurrencyID
+}
+
+func NewRecoverAccountFact(
+	token []byte,
+	sender base.Address,
+	account base.Address,
+	keys mitumcurrency.AccountKeys,
+	currency mitumcurrency.CurrencyID,
+) RecoverAccountFact {
+	bf := base.NewBaseFact(RecoverAccountFactHint, token)
+	fact := RecoverAccountFact{
+		BaseFact: bf,
+		sender:   sender,
+		account:  account,
+		keys:     keys,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RecoverAccountFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RecoverAccountFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoverAccountFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RecoverAccountFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.account.Bytes(),
+		fact.keys.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoverAccountFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.account, fact.keys, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.account) {
+		return util.ErrInvalid.Errorf("account is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact RecoverAccountFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RecoverAccountFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact RecoverAccountFact) Keys() mitumcurrency.AccountKeys {
+	return fact.keys
+}
+
+func (fact RecoverAccountFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoverAccountFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.account}, nil
+}
+
+type RecoverAccount struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewRecoverAccount(fact RecoverAccountFact) (RecoverAccount, error) {
+	return RecoverAccount{BaseOperation: mitumcurrency.NewBaseOperation(RecoverAccountHint, fact)}, nil
+}
+
+func (op *RecoverAccount) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RecoverAccountFact) unpack(enc encoder.Encoder, sd, ac string, bks []byte, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal RecoverAccountFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.account = a
+	}
+
+	if hinter, err := enc.Decode(bks); err != nil {
+		return e(err, "")
+	} else if k, ok := hinter.(mitumcurrency.AccountKeys); !ok {
+		return e(util.ErrWrongType.Errorf("expected AccountKeys, not %T", hinter), "")
+	} else {
+		fact.keys = k
+	}
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RecoverAccountFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address              `json:"sender"`
+	Account  base.Address              `json:"account"`
+	Keys     mitumcurrency.AccountKeys `json:"keys"`
+	Currency mitumcurrency.CurrencyID  `json:"currency"`
+}
+
+func (fact RecoverAccountFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoverAccountFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Account:               fact.account,
+		Keys:                  fact.keys,
+		Currency:              fact.currency,
+	})
+}
+
+type RecoverAccountFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Account  string          `json:"account"`
+	Keys     json.RawMessage `json:"keys"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *RecoverAccountFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RecoverAccountFact")
+
+	var uf RecoverAccountFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Account, uf.Keys, uf.Currency)
+}
+
+type recoverAccountMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op RecoverAccount) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(recoverAccountMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RecoverAccount) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RecoverAccount")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var recoverAccountProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoverAccountProcessor)
+	},
+}
+
+func (RecoverAccount) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RecoverAccountProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRecoverAccountProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RecoverAccountProcessor")
+
+		nopp := recoverAccountProcessorPool.Get()
+		opp, ok := nopp.(*RecoverAccountProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RecoverAccountProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoverAccountProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess RecoverAccount")
+
+	fact, ok := op.Fact().(RecoverAccountFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected RecoverAccountFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	st, err := existsState(mitumcurrency.StateKeyAccount(fact.account), "key of account", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("account not found, %q: %w", fact.account, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.account), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be recovered, %q: %w", fact.account, err), nil
+	}
+
+	switch ks, err := mitumcurrency.StateKeysValue(st); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError("failed to get keys value, %q: %w", fact.account, err), nil
+	case ks.Equal(fact.keys):
+		return ctx, base.NewBaseOperationProcessReasonError("same keys as existing, %q", fact.keys.Hash()), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if _, _, rerr := opp.checkRecovery(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RecoverAccountProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process RecoverAccount")
+
+	fact, ok := op.Fact().(RecoverAccountFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RecoverAccountFact, not %T", op.Fact())
+	}
+
+	ar, recovered, rerr := opp.checkRecovery(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewAccountRecoveryStateMergeValue(StateKeyAccountRecovery(fact.account), NewAccountRecoveryStateValue(ar)),
+		NewBalanceStateMergeValue(
+			sb[fact.currency].Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		),
+	}
+
+	if recovered {
+		st, err := existsState(mitumcurrency.StateKeyAccount(fact.account), "key of account", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("account not found, %q: %w", fact.account, err), nil
+		}
+
+		ac, err := mitumcurrency.LoadStateAccountValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to get account value, %q: %w", fact.account, err), nil
+		}
+
+		nac, err := ac.SetKeys(ar.Keys())
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to set keys of account, %q: %w", fact.account, err), nil
+		}
+
+		sts = append(sts, mitumcurrency.NewAccountStateMergeValue(st.Key(), mitumcurrency.NewAccountStateValue(nac)))
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *RecoverAccountProcessor) Close() error {
+	recoverAccountProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkRecovery returns the account recovery updated by the approval of
+// sender; if the approved keys are ready, the recovery is recovered and the
+// keys replace the account keys.
+func (opp *RecoverAccountProcessor) checkRecovery(
+	fact RecoverAccountFact,
+	getStateFunc base.GetStateFunc,
+) (AccountRecovery, bool, base.OperationProcessReasonError) {
+	st, err := existsState(StateKeyRecoveryGuardians(fact.account), "key of recovery guardians", getStateFunc)
+	if err != nil {
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"recovery guardians not found, %q: %w", fact.account, err)
+	}
+
+	pv, err := StateRecoveryGuardiansValue(st)
+	if err != nil {
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to get recovery guardians value, %q: %w", fact.account, err)
+	}
+
+	gv := pv.At(opp.Height())
+
+	if !gv.IsGuardian(fact.sender) {
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"sender is not guardian of account, %q: %q", fact.sender, fact.account)
+	}
+
+	ar, found, err := pendingAccountRecovery(fact.account, getStateFunc)
+	switch {
+	case err != nil:
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to get account recovery, %q: %w", fact.account, err)
+	case !found:
+		ar = NewAccountRecovery(fact.account)
+	}
+
+	if ar.IsApproved() {
+		switch {
+		case !ar.Keys().Equal(fact.keys):
+			return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+				"other keys already approved for account, %q", fact.account)
+		case !ar.IsReady(opp.Height()):
+			return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+				"account recovery not yet ready, %q; %d < %d", fact.account, opp.Height(), ar.Ready())
+		}
+
+		return ar.Recovered(), true, nil
+	}
+
+	// NOTE the recovery is not ready earlier than the key rotation of account
+	delay, err := keyRotationDelay(fact.account, opp.Height(), getStateFunc)
+	if err != nil {
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation delay, %q: %w", fact.account, err)
+	}
+
+	if gv.Delay() > delay {
+		delay = gv.Delay()
+	}
+
+	nar, err := ar.Approve(fact.sender, fact.keys, gv.Threshold(), delay, opp.Height())
+	if err != nil {
+		return AccountRecovery{}, false, base.NewBaseOperationProcessReasonError(
+			"failed to approve account recovery, %q: %w", fact.account, err)
+	}
+
+	return nar, false, nil
+}
@@ -0,0 +1,93 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RefundEscrowFactHint = hint.MustNewHint("mitum-currency-refund-escrow-operation-fact-v0.0.1")
+	RefundEscrowHint     = hint.MustNewHint("mitum-currency-refund-escrow-operation-v0.0.1")
+)
+
+type RefundEscrowFact struct {
+	base.BaseFact
+	sender base.Address
+	escrow util.Hash
+}
+
+func NewRefundEscrowFact(token []byte, sender base.Address, escrow util.Hash) RefundEscrowFact {
+	bf := base.NewBaseFact(RefundEscrowFactHint, token)
+	fact := RefundEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		escrow:   escrow,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RefundEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RefundEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RefundEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RefundEscrowFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.escrow.Bytes(),
+	)
+}
+
+func (fact RefundEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.escrow)
+}
+
+func (fact RefundEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RefundEscrowFact) Escrow() util.Hash {
+	return fact.escrow
+}
+
+func (fact RefundEscrowFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// RefundEscrow refunds the escrowed amounts to the payer.
+type RefundEscrow struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewRefundEscrow(fact RefundEscrowFact) (RefundEscrow, error) {
+	return RefundEscrow{BaseOperation: mitumcurrency.NewBaseOperation(RefundEscrowHint, fact)}, nil
+}
+
+func (op *RefundEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type RefundEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Escrow util.Hash    `json:"escrow"`
+}
+
+func (fact RefundEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RefundEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Escrow:                fact.escrow,
+	})
+}
+
+type RefundEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string                `json:"sender"`
+	Escrow valuehash.HashDecoder `json:"escrow"`
+}
+
+func (fact *RefundEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RefundEscrowFact")
+
+	var uf RefundEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Escrow.Hash())
+}
+
+type refundEscrowMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op RefundEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(refundEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RefundEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RefundEscrow")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var refundEscrowProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RefundEscrowProcessor)
+	},
+}
+
+func (RefundEscrow) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type RefundEscrowProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRefundEscrowProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RefundEscrowProcessor")
+
+		nopp := refundEscrowProcessorPool.Get()
+		opp, ok := nopp.(*RefundEscrowProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RefundEscrowProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RefundEscrowProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess RefundEscrow")
+
+	if _, ok := op.Fact().(RefundEscrowFact); !ok {
+		return ctx, nil, e(nil, "expected RefundEscrowFact, not %T", op.Fact())
+	}
+
+	if _, err := checkResolveEscrow(op, opp.Height(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RefundEscrowProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process RefundEscrow")
+
+	fact, ok := op.Fact().(RefundEscrowFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RefundEscrowFact, not %T", op.Fact())
+	}
+
+	es, rerr := checkResolveEscrow(op, opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	sts, err := resolveEscrow(factHint(op.Fact()), es, fact.Sender(), es.Payer(), EscrowRefunded, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to refund escrow, %q: %w", es.ID(), err), nil
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *RefundEscrowProcessor) Close() error {
+	refundEscrowProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ReleaseEscrowFactHint = hint.MustNewHint("mitum-currency-release-escrow-operation-fact-v0.0.1")
+	ReleaseEscrowHint     = hint.MustNewHint("mitum-currency-release-escrow-operation-v0.0.1")
+)
+
+type ReleaseEscrowFact struct {
+	base.BaseFact
+	sender base.Address
+	escrow util.Hash
+}
+
+func NewReleaseEscrowFact(token []byte, sender base.Address, escrow util.Hash) ReleaseEscrowFact {
+	bf := base.NewBaseFact(ReleaseEscrowFactHint, token)
+	fact := ReleaseEscrowFact{
+		BaseFact: bf,
+		sender:   sender,
+		escrow:   escrow,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ReleaseEscrowFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ReleaseEscrowFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ReleaseEscrowFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ReleaseEscrowFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.escrow.Bytes(),
+	)
+}
+
+func (fact ReleaseEscrowFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.escrow)
+}
+
+func (fact ReleaseEscrowFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ReleaseEscrowFact) Escrow() util.Hash {
+	return fact.escrow
+}
+
+func (fact ReleaseEscrowFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// ReleaseEscrow releases the escrowed amounts to the payee.
+type ReleaseEscrow struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewReleaseEscrow(fact ReleaseEscrowFact) (ReleaseEscrow, error) {
+	return ReleaseEscrow{BaseOperation: mitumcurrency.NewBaseOperation(ReleaseEscrowHint, fact)}, nil
+}
+
+func (op *ReleaseEscrow) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
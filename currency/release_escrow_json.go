@@ -0,0 +1,65 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ReleaseEscrowFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address `json:"sender"`
+	Escrow util.Hash    `json:"escrow"`
+}
+
+func (fact ReleaseEscrowFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReleaseEscrowFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Escrow:                fact.escrow,
+	})
+}
+
+type ReleaseEscrowFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string                `json:"sender"`
+	Escrow valuehash.HashDecoder `json:"escrow"`
+}
+
+func (fact *ReleaseEscrowFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ReleaseEscrowFact")
+
+	var uf ReleaseEscrowFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Escrow.Hash())
+}
+
+type releaseEscrowMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ReleaseEscrow) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(releaseEscrowMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ReleaseEscrow) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ReleaseEscrow")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var releaseEscrowProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ReleaseEscrowProcessor)
+	},
+}
+
+func (ReleaseEscrow) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ReleaseEscrowProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewReleaseEscrowProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ReleaseEscrowProcessor")
+
+		nopp := releaseEscrowProcessorPool.Get()
+		opp, ok := nopp.(*ReleaseEscrowProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ReleaseEscrowProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ReleaseEscrowProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ReleaseEscrow")
+
+	if _, ok := op.Fact().(ReleaseEscrowFact); !ok {
+		return ctx, nil, e(nil, "expected ReleaseEscrowFact, not %T", op.Fact())
+	}
+
+	if _, err := checkResolveEscrow(op, opp.Height(), getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ReleaseEscrowProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ReleaseEscrow")
+
+	fact, ok := op.Fact().(ReleaseEscrowFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ReleaseEscrowFact, not %T", op.Fact())
+	}
+
+	es, rerr := checkResolveEscrow(op, opp.Height(), getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	sts, err := resolveEscrow(factHint(op.Fact()), es, fact.Sender(), es.Payee(), EscrowReleased, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to release escrow, %q: %w", es.ID(), err), nil
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *ReleaseEscrowProcessor) Close() error {
+	releaseEscrowProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevokeContractAccountOperatorFactHint = hint.MustNewHint("mitum-currency-revoke-contract-account-operator-operation-fact-v0.0.1")
+	RevokeContractAccountOperatorHint     = hint.MustNewHint("mitum-currency-revoke-contract-account-operator-operation-v0.0.1")
+)
+
+type RevokeContractAccountOperatorFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	operator base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewRevokeContractAccountOperatorFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	operator base.Address,
+	currency mitumcurrency.CurrencyID,
+) RevokeContractAccountOperatorFact {
+	bf := base.NewBaseFact(RevokeContractAccountOperatorFactHint, token)
+	fact := RevokeContractAccountOperatorFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		operator: operator,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeContractAccountOperatorFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeContractAccountOperatorFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeContractAccountOperatorFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeContractAccountOperatorFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.operator.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeContractAccountOperatorFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.operator, fact.currency); err != nil {
+		return err
+	}
+
+	switch {
+	case fact.sender.Equal(fact.contract):
+		return util.ErrInvalid.Errorf("contract account is same with sender, %q", fact.sender)
+	case fact.sender.Equal(fact.operator):
+		return util.ErrInvalid.Errorf("operator is same with sender, %q", fact.sender)
+	case fact.contract.Equal(fact.operator):
+		return util.ErrInvalid.Errorf("operator is same with contract account, %q", fact.contract)
+	}
+
+	return nil
+}
+
+func (fact RevokeContractAccountOperatorFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeContractAccountOperatorFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeContractAccountOperatorFact) Operator() base.Address {
+	return fact.operator
+}
+
+func (fact RevokeContractAccountOperatorFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeContractAccountOperatorFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.contract, fact.operator}, nil
+}
+
+type RevokeContractAccountOperator struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewRevokeContractAccountOperator(fact RevokeContractAccountOperatorFact) (RevokeContractAccountOperator, error) {
+	return RevokeContractAccountOperator{BaseOperation: mitumcurrency.NewBaseOperation(RevokeContractAccountOperatorHint, fact)}, nil
+}
+
+func (op *RevokeContractAccountOperator) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevokeContractAccountOperatorFact) unpack(enc encoder.Encoder, sd, ct, ow string, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal RevokeContractAccountOperatorFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.operator = a
+	}
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RevokeContractAccountOperatorFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Operator base.Address             `json:"operator"`
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeContractAccountOperatorFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeContractAccountOperatorFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Operator:              fact.operator,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeContractAccountOperatorFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	Operator string `json:"operator"`
+	Currency string `json:"currency"`
+}
+
+func (fact *RevokeContractAccountOperatorFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RevokeContractAccountOperatorFact")
+
+	var uf RevokeContractAccountOperatorFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Operator, uf.Currency)
+}
+
+type revokeContractAccountOperatorMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op RevokeContractAccountOperator) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(revokeContractAccountOperatorMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeContractAccountOperator) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RevokeContractAccountOperator")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
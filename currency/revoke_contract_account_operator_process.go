@@ -0,0 +1,169 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var revokeContractAccountOperatorProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevokeContractAccountOperatorProcessor)
+	},
+}
+
+type RevokeContractAccountOperatorProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRevokeContractAccountOperatorProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new RevokeContractAccountOperatorProcessor")
+
+		nopp := revokeContractAccountOperatorProcessorPool.Get()
+		opp, ok := nopp.(*RevokeContractAccountOperatorProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RevokeContractAccountOperatorProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RevokeContractAccountOperatorProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess RevokeContractAccountOperator")
+
+	fact, ok := op.Fact().(RevokeContractAccountOperatorFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected RevokeContractAccountOperatorFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be revoke contract account operator sender, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	st, err := existsState(StateKeyContractAccount(fact.contract), "key of contract account", getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", fact.contract, err), nil
+	}
+
+	ca, err := StateContractAccountValue(st)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to get contract account value, %q: %w", fact.contract, err), nil
+	}
+
+	if err := checkContractAccountOwnerSigns(ca, fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid owner signing: %w", err), nil
+	}
+
+	if _, found := ca.Operator(fact.operator); !found {
+		return ctx, base.NewBaseOperationProcessReasonError("operator not found in contract account, %q", fact.operator), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RevokeContractAccountOperatorProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process RevokeContractAccountOperator")
+
+	fact, ok := op.Fact().(RevokeContractAccountOperatorFact)
+	if !ok {
+		return nil, nil, e(nil, "expected RevokeContractAccountOperatorFact, not %T", op.Fact())
+	}
+
+	st, err := existsState(StateKeyContractAccount(fact.contract), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", fact.contract, err), nil
+	}
+
+	ca, err := StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get contract account value, %q: %w", fact.contract, err), nil
+	}
+
+	nca, err := ca.RemoveOperator(fact.operator)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to remove operator of contract account, %q: %w", fact.contract, err), nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	if fee.OverZero() {
+		if err := checkNotFrozen(fact.sender, fact.currency, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+		}
+	}
+
+	sb, err := existsState(mitumcurrency.StateKeyBalance(fact.sender, fact.currency), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.sender, err), nil
+	}
+
+	switch b, err := mitumcurrency.StateBalanceValue(sb); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", mitumcurrency.StateKeyBalance(fact.sender, fact.currency), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.sender), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	v, ok := sb.Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts = append(sts, NewBalanceStateMergeValue(sb.Key(), mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	sts = append(sts, NewContractAccountStateMergeValue(st.Key(), NewContractAccountStateValue(nca)))
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *RevokeContractAccountOperatorProcessor) Close() error {
+	revokeContractAccountOperatorProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ScheduleOperationFactHint = hint.MustNewHint("mitum-currency-schedule-operation-operation-fact-v0.0.1")
+	ScheduleOperationHint     = hint.MustNewHint("mitum-currency-schedule-operation-operation-v0.0.1")
+)
+
+type ScheduleOperationFact struct {
+	base.BaseFact
+	sender    base.Address
+	operation base.Operation
+	height    base.Height
+	currency  mitumcurrency.CurrencyID
+}
+
+func NewScheduleOperationFact(
+	token []byte,
+	sender base.Address,
+	operation base.Operation,
+	height base.Height,
+	currency mitumcurrency.CurrencyID,
+) ScheduleOperationFact {
+	bf := base.NewBaseFact(ScheduleOperationFactHint, token)
+	fact := ScheduleOperationFact{
+		BaseFact:  bf,
+		sender:    sender,
+		operation: operation,
+		height:    height,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ScheduleOperationFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ScheduleOperationFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ScheduleOperationFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ScheduleOperationFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.operation.Hash().Bytes(),
+		fact.height.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ScheduleOperationFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.height, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.operation == nil {
+		return util.ErrInvalid.Errorf("empty operation")
+	}
+
+	switch fact.operation.(type) {
+	case ScheduleOperation, ExecuteScheduledOperation, CancelScheduledOperation, ProposeOperation, ApproveOperation:
+		return util.ErrInvalid.Errorf("operation can not be scheduled, %T", fact.operation)
+	}
+
+	// NOTE the operation should be signed with the network id of schedule, so
+	// it can not be processed without the schedule of the target height.
+	if err := fact.operation.IsValid(ScheduleNetworkID(b, fact.height)); err != nil {
+		return util.ErrInvalid.Errorf("invalid operation: %w", err)
+	}
+
+	switch sender := pendingOperationSender(fact.operation.Fact()); {
+	case sender == nil:
+		return util.ErrInvalid.Errorf("operation without sender can not be scheduled, %T", fact.operation)
+	case !sender.Equal(fact.sender):
+		return util.ErrInvalid.Errorf("sender of operation is not matched with sender, %q != %q", sender, fact.sender)
+	}
+
+	if feePayerOf(fact.operation.Fact()) != nil {
+		return util.ErrInvalid.Errorf("operation with fee payer can not be scheduled, %T", fact.operation)
+	}
+
+	return nil
+}
+
+func (fact ScheduleOperationFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ScheduleOperationFact) Operation() base.Operation {
+	return fact.operation
+}
+
+func (fact ScheduleOperationFact) Height() base.Height {
+	return fact.height
+}
+
+func (fact ScheduleOperationFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact ScheduleOperationFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+// ScheduledOperation returns the scheduled operation, which is identified by
+// the fact hash of the operation.
+func (fact ScheduleOperationFact) ScheduledOperation(fee mitumcurrency.Big) ScheduledOperation {
+	return NewScheduledOperation(
+		fact.operation.Fact().Hash(), fact.sender, fact.operation, fact.height, mitumcurrency.NewAmount(fee, fact.currency))
+}
+
+// ScheduleNetworkID returns the network id for the signs of the scheduled
+// operation; the operation signed by it is only valid in the schedule of the
+// target height.
+func ScheduleNetworkID(networkID []byte, height base.Height) base.NetworkID {
+	return util.ConcatBytesSlice(networkID, []byte("schedule"), height.Bytes())
+}
+
+// ScheduleOperation schedules the signed operation of sender to be processed at
+// the target height; the fee of currency is reserved up front, and the sender
+// can cancel it by CancelScheduledOperation before the target height.
+//
+// The operation should be signed with ScheduleNetworkID, and the scheduled
+// operation is identified by the fact hash of the operation, so the operation
+// can not be processed by itself or scheduled again.
+//
+// NOTE the operation processor of mitum2 processes only the operations in
+// proposal and there is no hook at the beginning of block, so the scheduled
+// operation is not processed by itself at the target height; it is processed
+// by ExecuteScheduledOperation, which anyone can send at or after the target
+// height.
+type ScheduleOperation struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewScheduleOperation(fact ScheduleOperationFact) (ScheduleOperation, error) {
+	return ScheduleOperation{BaseOperation: mitumcurrency.NewBaseOperation(ScheduleOperationHint, fact)}, nil
+}
+
+func (op *ScheduleOperation) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
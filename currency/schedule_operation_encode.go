@@ -0,0 +1,35 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ScheduleOperationFact) unpack(
+	enc encoder.Encoder,
+	sd string,
+	bop []byte,
+	height base.Height,
+	cid string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal ScheduleOperationFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(enc, bop, &op); err != nil {
+		return e(err, "failed to decode operation")
+	}
+	fact.operation = op
+	fact.height = height
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ScheduleOperationFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address             `json:"sender"`
+	Operation base.Operation           `json:"operation"`
+	Height    base.Height              `json:"height"`
+	Currency  mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact ScheduleOperationFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduleOperationFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Operation:             fact.operation,
+		Height:                fact.height,
+		Currency:              fact.currency,
+	})
+}
+
+type ScheduleOperationFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string          `json:"sender"`
+	Operation json.RawMessage `json:"operation"`
+	Height    base.Height     `json:"height"`
+	Currency  string          `json:"currency"`
+}
+
+func (fact *ScheduleOperationFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ScheduleOperationFact")
+
+	var uf ScheduleOperationFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Operation, uf.Height, uf.Currency)
+}
+
+type scheduleOperationMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op ScheduleOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(scheduleOperationMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ScheduleOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ScheduleOperation")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var scheduleOperationProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ScheduleOperationProcessor)
+	},
+}
+
+func (ScheduleOperation) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type ScheduleOperationProcessor struct {
+	*base.BaseOperationProcessor
+	getNewProcessorByHint GetNewProcessorByHint
+}
+
+func NewScheduleOperationProcessor(getNewProcessorByHint GetNewProcessorByHint) GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new ScheduleOperationProcessor")
+
+		nopp := scheduleOperationProcessorPool.Get()
+		opp, ok := nopp.(*ScheduleOperationProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ScheduleOperationProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getNewProcessorByHint = getNewProcessorByHint
+
+		return opp, nil
+	}
+}
+
+func (opp *ScheduleOperationProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess ScheduleOperation")
+
+	fact, ok := op.Fact().(ScheduleOperationFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected ScheduleOperationFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"contract account cannot schedule operation, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if fact.height <= opp.Height() {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"target height should be over current height; %d <= %d", fact.height, opp.Height()), nil
+	}
+
+	if _, err := existsActiveCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid currency, %q: %w", fact.currency, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyScheduledOperation(fact.operation.Fact().Hash()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"operation already scheduled, %q: %w", fact.operation.Fact().Hash(), err), nil
+	}
+
+	if _, err := checkPendingOperationProcessor(fact.operation, opp.getNewProcessorByHint); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("operation can not be scheduled: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ScheduleOperationProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process ScheduleOperation")
+
+	fact, ok := op.Fact().(ScheduleOperationFact)
+	if !ok {
+		return nil, nil, e(nil, "expected ScheduleOperationFact, not %T", op.Fact())
+	}
+
+	policy, err := existsActiveCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid currency, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	// NOTE the fee is reserved in the scheduled operation, not collected
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to reserve fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	so := fact.ScheduledOperation(fee)
+
+	return []base.StateMergeValue{
+		NewScheduledOperationStateMergeValue(StateKeyScheduledOperation(so.ID()), NewScheduledOperationStateValue(so)),
+		NewBalanceStateMergeValue(
+			sb[fact.currency].Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		),
+	}, nil, nil
+}
+
+func (opp *ScheduleOperationProcessor) Close() error {
+	opp.getNewProcessorByHint = nil
+	scheduleOperationProcessorPool.Put(opp)
+
+	return nil
+}
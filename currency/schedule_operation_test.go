@@ -0,0 +1,274 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/stretchr/testify/suite"
+)
+
+type testScheduleOperation struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	sender   base.Address
+	priv     base.Privatekey
+	receiver base.Address
+	keeper   base.Address
+	kpriv    base.Privatekey
+}
+
+func (t *testScheduleOperation) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	sender, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(sender, t.cid, 100)
+
+	receiver, _, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(receiver, t.cid, 0)
+
+	keeper, kprivs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+
+	t.sender, t.priv, t.receiver = sender, privs[0], receiver
+	t.keeper, t.kpriv = keeper, kprivs[0]
+}
+
+func (t *testScheduleOperation) getNewProcessorByHint(ht hint.Hint) (GetNewProcessor, bool) {
+	if !ht.Equal(mitumcurrency.TransfersHint) {
+		return nil, false
+	}
+
+	return NewTransfersProcessor(), true
+}
+
+func (t *testScheduleOperation) newTransfers(big int64, networkID base.NetworkID) mitumcurrency.Transfers {
+	fact := mitumcurrency.NewTransfersFact(
+		util.UUID().Bytes(),
+		t.sender,
+		[]mitumcurrency.TransfersItem{
+			mitumcurrency.NewTransfersItemMultiAmounts(
+				t.receiver,
+				[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+			),
+		},
+	)
+
+	op, err := mitumcurrency.NewTransfers(fact)
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, networkID))
+
+	return op
+}
+
+func (t *testScheduleOperation) schedule(inner base.Operation, height base.Height) ScheduleOperation {
+	op, err := NewScheduleOperation(NewScheduleOperationFact(util.UUID().Bytes(), t.sender, inner, height, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testScheduleOperation) execute(id util.Hash) ExecuteScheduledOperation {
+	op, err := NewExecuteScheduledOperation(NewExecuteScheduledOperationFact(util.UUID().Bytes(), t.keeper, id))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.kpriv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testScheduleOperation) cancel(id util.Hash) CancelScheduledOperation {
+	op, err := NewCancelScheduledOperation(NewCancelScheduledOperationFact(util.UUID().Bytes(), t.sender, id))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testScheduleOperation) scheduledOperation(id util.Hash) ScheduledOperation {
+	st, found, err := t.states.getStateFunc(StateKeyScheduledOperation(id))
+	t.NoError(err)
+	t.True(found)
+
+	so, err := StateScheduledOperationValue(st)
+	t.NoError(err)
+
+	return so
+}
+
+func (t *testScheduleOperation) TestExecute() {
+	height := t.states.height + 3
+	inner := t.newTransfers(10, ScheduleNetworkID(t.networkID, height))
+
+	op := t.schedule(inner, height)
+	t.NoError(op.IsValid(t.networkID))
+
+	t.Nil(t.process(NewScheduleOperationProcessor(t.getNewProcessorByHint), op, t.states))
+
+	so := t.scheduledOperation(inner.Fact().Hash())
+	t.True(so.IsScheduled())
+	t.Equal(height, so.Height())
+
+	t.Run("not yet due", func() {
+		reason := t.preProcess(NewExecuteScheduledOperationProcessor(t.getNewProcessorByHint), t.execute(so.ID()), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "not yet due")
+	})
+
+	t.states.height = height
+
+	t.Nil(t.process(NewExecuteScheduledOperationProcessor(t.getNewProcessorByHint), t.execute(so.ID()), t.states))
+
+	so = t.scheduledOperation(inner.Fact().Hash())
+	t.Equal(ScheduledOperationExecuted, so.Status())
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.sender, t.cid))
+	t.Equal(mitumcurrency.NewBig(10), t.states.balance(t.receiver, t.cid))
+
+	t.Run("executed again", func() {
+		reason := t.preProcess(NewExecuteScheduledOperationProcessor(t.getNewProcessorByHint), t.execute(so.ID()), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "already executed")
+	})
+
+	t.Run("scheduled again", func() {
+		op := t.schedule(inner, height+1)
+
+		reason := t.preProcess(NewScheduleOperationProcessor(t.getNewProcessorByHint), op, t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "operation already scheduled")
+	})
+}
+
+func (t *testScheduleOperation) TestNotBoundOperation() {
+	height := t.states.height + 3
+
+	t.Run("signed with network id", func() {
+		op := t.schedule(t.newTransfers(10, t.networkID), height)
+
+		err := op.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "invalid operation")
+	})
+
+	t.Run("signed for the other height", func() {
+		op := t.schedule(t.newTransfers(10, ScheduleNetworkID(t.networkID, height+1)), height)
+
+		err := op.IsValid(t.networkID)
+		t.Error(err)
+		t.ErrorContains(err, "invalid operation")
+	})
+
+	t.Run("scheduled operation by itself", func() {
+		inner := t.newTransfers(10, ScheduleNetworkID(t.networkID, height))
+
+		t.Error(inner.IsValid(t.networkID))
+	})
+}
+
+func (t *testScheduleOperation) TestCancel() {
+	t.states.setCurrency(t.cid, NewCurrencyPolicy(
+		mitumcurrency.ZeroBig, NewFixedFeeer(base.RandomAddress(""), mitumcurrency.NewBig(5), mitumcurrency.ZeroBig)),
+	)
+
+	height := t.states.height + 3
+	inner := t.newTransfers(10, ScheduleNetworkID(t.networkID, height))
+
+	t.Nil(t.process(NewScheduleOperationProcessor(t.getNewProcessorByHint), t.schedule(inner, height), t.states))
+
+	so := t.scheduledOperation(inner.Fact().Hash())
+	t.Equal(mitumcurrency.NewBig(5), so.Fee().Big())
+	t.Equal(mitumcurrency.NewBig(95), t.states.balance(t.sender, t.cid))
+
+	t.Nil(t.process(NewCancelScheduledOperationProcessor(), t.cancel(so.ID()), t.states))
+
+	so = t.scheduledOperation(inner.Fact().Hash())
+	t.Equal(ScheduledOperationCancelled, so.Status())
+	t.Equal(mitumcurrency.NewBig(100), t.states.balance(t.sender, t.cid))
+
+	t.states.height = height
+
+	reason := t.preProcess(NewExecuteScheduledOperationProcessor(t.getNewProcessorByHint), t.execute(so.ID()), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "already cancelled")
+}
+
+func (t *testScheduleOperation) TestCancelDue() {
+	height := t.states.height + 3
+	inner := t.newTransfers(10, ScheduleNetworkID(t.networkID, height))
+
+	t.Nil(t.process(NewScheduleOperationProcessor(t.getNewProcessorByHint), t.schedule(inner, height), t.states))
+
+	t.states.height = height
+
+	reason := t.preProcess(NewCancelScheduledOperationProcessor(), t.cancel(inner.Fact().Hash()), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "already due")
+}
+
+func TestScheduleOperation(t *testing.T) {
+	suite.Run(t, new(testScheduleOperation))
+}
+
+func TestScheduleOperationFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestPendingOperationEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: ScheduleOperationFactHint, Instance: ScheduleOperationFact{}}))
+
+	networkID := base.NetworkID(util.UUID().Bytes())
+	height := base.Height(33)
+
+	t.Encode = func() (interface{}, []byte) {
+		inner, _ := newTestTransfers(t, ScheduleNetworkID(networkID, height))
+
+		fact := NewScheduleOperationFact(
+			util.UUID().Bytes(),
+			inner.Fact().(mitumcurrency.TransfersFact).Sender(),
+			inner,
+			height,
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(networkID))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(ScheduleOperationFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(ScheduleOperationFact)
+		t.True(ok)
+		bf, ok := b.(ScheduleOperationFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(networkID))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Height(), bf.Height())
+		t.True(af.Operation().Hash().Equal(bf.Operation().Hash()))
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,141 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ScheduledOperationHint = hint.MustNewHint("mitum-currency-scheduled-operation-v0.0.1")
+
+type ScheduledOperationStatus string
+
+const (
+	ScheduledOperationScheduled = ScheduledOperationStatus("scheduled")
+	ScheduledOperationExecuted  = ScheduledOperationStatus("executed")
+	ScheduledOperationFailed    = ScheduledOperationStatus("failed")
+	ScheduledOperationCancelled = ScheduledOperationStatus("cancelled")
+)
+
+func (s ScheduledOperationStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s ScheduledOperationStatus) IsValid([]byte) error {
+	switch s {
+	case ScheduledOperationScheduled,
+		ScheduledOperationExecuted,
+		ScheduledOperationFailed,
+		ScheduledOperationCancelled:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown scheduled operation status, %q", s)
+	}
+}
+
+// ScheduledOperation is the signed operation registered by ScheduleOperation;
+// it is processed by ExecuteScheduledOperation at or after the target height.
+// The fee is reserved from the account when it is scheduled; the reserved fee
+// is collected when it is executed and refunded when it is cancelled or failed.
+// ScheduledOperation is identified by the fact hash of the operation.
+type ScheduledOperation struct {
+	hint.BaseHinter
+	id        util.Hash
+	account   base.Address
+	operation base.Operation
+	height    base.Height
+	fee       mitumcurrency.Amount
+	status    ScheduledOperationStatus
+}
+
+func NewScheduledOperation(
+	id util.Hash,
+	account base.Address,
+	operation base.Operation,
+	height base.Height,
+	fee mitumcurrency.Amount,
+) ScheduledOperation {
+	return ScheduledOperation{
+		BaseHinter: hint.NewBaseHinter(ScheduledOperationHint),
+		id:         id,
+		account:    account,
+		operation:  operation,
+		height:     height,
+		fee:        fee,
+		status:     ScheduledOperationScheduled,
+	}
+}
+
+func (so ScheduledOperation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		so.id.Bytes(),
+		so.account.Bytes(),
+		so.operation.Hash().Bytes(),
+		so.height.Bytes(),
+		so.fee.Bytes(),
+		so.status.Bytes(),
+	)
+}
+
+func (so ScheduledOperation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		so.BaseHinter,
+		so.id,
+		so.account,
+		so.height,
+		so.fee,
+		so.status,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid scheduled operation: %w", err)
+	}
+
+	if so.operation == nil {
+		return util.ErrInvalid.Errorf("empty operation of scheduled operation")
+	}
+
+	if so.fee.Big().Compare(mitumcurrency.ZeroBig) < 0 {
+		return util.ErrInvalid.Errorf("reserved fee should not be under zero")
+	}
+
+	return nil
+}
+
+func (so ScheduledOperation) ID() util.Hash {
+	return so.id
+}
+
+func (so ScheduledOperation) Account() base.Address {
+	return so.account
+}
+
+func (so ScheduledOperation) Operation() base.Operation {
+	return so.operation
+}
+
+func (so ScheduledOperation) Height() base.Height {
+	return so.height
+}
+
+func (so ScheduledOperation) Fee() mitumcurrency.Amount {
+	return so.fee
+}
+
+func (so ScheduledOperation) Status() ScheduledOperationStatus {
+	return so.status
+}
+
+func (so ScheduledOperation) IsScheduled() bool {
+	return so.status == ScheduledOperationScheduled
+}
+
+// IsDue returns true when the block height reaches the target height.
+func (so ScheduledOperation) IsDue(height base.Height) bool {
+	return height >= so.height
+}
+
+func (so ScheduledOperation) SetStatus(status ScheduledOperationStatus) ScheduledOperation {
+	so.status = status
+
+	return so
+}
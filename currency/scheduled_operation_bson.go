@@ -0,0 +1,53 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (so ScheduledOperation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     so.Hint().String(),
+			"id":        so.id.String(),
+			"account":   so.account,
+			"operation": so.operation,
+			"height":    so.height,
+			"fee":       so.fee,
+			"status":    so.status,
+		},
+	)
+}
+
+type ScheduledOperationBSONUnmarshaler struct {
+	Hint      string      `bson:"_hint"`
+	ID        string      `bson:"id"`
+	Account   string      `bson:"account"`
+	Operation bson.Raw    `bson:"operation"`
+	Height    base.Height `bson:"height"`
+	Fee       bson.Raw    `bson:"fee"`
+	Status    string      `bson:"status"`
+}
+
+func (so *ScheduledOperation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ScheduledOperation")
+
+	var uso ScheduledOperationBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uso); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uso.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return so.unpack(
+		enc, ht, valuehash.NewBytesFromString(uso.ID),
+		uso.Account, uso.Operation, uso.Height, uso.Fee, uso.Status,
+	)
+}
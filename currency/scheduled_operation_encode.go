@@ -0,0 +1,49 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (so *ScheduledOperation) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	id util.Hash,
+	ac string,
+	bop []byte,
+	height base.Height,
+	bfe []byte,
+	status string,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal ScheduledOperation")
+
+	so.BaseHinter = hint.NewBaseHinter(ht)
+	so.id = id
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		so.account = a
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(enc, bop, &op); err != nil {
+		return e(err, "failed to decode operation")
+	}
+	so.operation = op
+
+	var fee mitumcurrency.Amount
+	if err := encoder.Decode(enc, bfe, &fee); err != nil {
+		return e(err, "failed to decode fee")
+	}
+	so.fee = fee
+
+	so.height = height
+	so.status = ScheduledOperationStatus(status)
+
+	return nil
+}
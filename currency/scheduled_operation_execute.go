@@ -0,0 +1,112 @@
+package currency
+
+import (
+	"context"
+	"io"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+// checkScheduledOperation returns the scheduled operation, which is not yet
+// executed or cancelled.
+func checkScheduledOperation(
+	id util.Hash,
+	getStateFunc base.GetStateFunc,
+) (ScheduledOperation, base.OperationProcessReasonError) {
+	st, err := existsState(StateKeyScheduledOperation(id), "key of scheduled operation", getStateFunc)
+	if err != nil {
+		return ScheduledOperation{}, base.NewBaseOperationProcessReasonError(
+			"scheduled operation not found, %q: %w", id, err)
+	}
+
+	so, err := StateScheduledOperationValue(st)
+	if err != nil {
+		return ScheduledOperation{}, base.NewBaseOperationProcessReasonError(
+			"failed to get scheduled operation value, %q: %w", id, err)
+	}
+
+	if !so.IsScheduled() {
+		return ScheduledOperation{}, base.NewBaseOperationProcessReasonError(
+			"scheduled operation already %s, %q", so.Status(), id)
+	}
+
+	return so, nil
+}
+
+// closeScheduledOperation closes the scheduled operation with the status and
+// refunds the reserved fee to the account.
+func closeScheduledOperation(so ScheduledOperation, status ScheduledOperationStatus) []base.StateMergeValue {
+	sts := []base.StateMergeValue{
+		NewScheduledOperationStateMergeValue(
+			StateKeyScheduledOperation(so.ID()),
+			NewScheduledOperationStateValue(so.SetStatus(status)),
+		),
+	}
+
+	if so.Fee().Big().OverZero() {
+		sts = append(sts, NewBalanceStateMergeValue(
+			mitumcurrency.StateKeyBalance(so.Account(), so.Fee().Currency()),
+			NewAddBalanceStateValue(so.Fee()),
+		))
+	}
+
+	return sts
+}
+
+// executeScheduledOperation processes the scheduled operation with its own
+// signs and collects the reserved fee. If the operation fails, the scheduled
+// operation is closed as failed and the reserved fee is refunded.
+func executeScheduledOperation(
+	ctx context.Context,
+	so ScheduledOperation,
+	getNewProcessorByHint GetNewProcessorByHint,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	op := so.Operation()
+
+	f, err := checkPendingOperationProcessor(op, getNewProcessorByHint)
+	if err != nil {
+		return closeScheduledOperation(so, ScheduledOperationFailed), nil, nil
+	}
+
+	opp, err := f(height, getStateFunc, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if i, ok := opp.(io.Closer); ok {
+		defer func() {
+			_ = i.Close()
+		}()
+	}
+
+	switch _, rerr, err := opp.PreProcess(ctx, op, getStateFunc); {
+	case err != nil:
+		return nil, nil, err
+	case rerr != nil:
+		return closeScheduledOperation(so, ScheduledOperationFailed), nil, nil
+	}
+
+	sts, rerr, err := opp.Process(ctx, op, getStateFunc)
+	switch {
+	case err != nil:
+		return nil, nil, err
+	case rerr != nil:
+		return closeScheduledOperation(so, ScheduledOperationFailed), nil, nil
+	}
+
+	sts = append(sts, NewScheduledOperationStateMergeValue(
+		StateKeyScheduledOperation(so.ID()),
+		NewScheduledOperationStateValue(so.SetStatus(ScheduledOperationExecuted)),
+	))
+
+	fsts, err := CollectFee(ScheduleOperationFactHint, so.Fee().Currency(), so.Fee().Big(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
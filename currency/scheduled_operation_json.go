@@ -0,0 +1,55 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+type ScheduledOperationJSONMarshaler struct {
+	hint.BaseHinter
+	ID        util.Hash                `json:"id"`
+	Account   base.Address             `json:"account"`
+	Operation base.Operation           `json:"operation"`
+	Height    base.Height              `json:"height"`
+	Fee       mitumcurrency.Amount     `json:"fee"`
+	Status    ScheduledOperationStatus `json:"status"`
+}
+
+func (so ScheduledOperation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduledOperationJSONMarshaler{
+		BaseHinter: so.BaseHinter,
+		ID:         so.id,
+		Account:    so.account,
+		Operation:  so.operation,
+		Height:     so.height,
+		Fee:        so.fee,
+		Status:     so.status,
+	})
+}
+
+type ScheduledOperationJSONUnmarshaler struct {
+	Hint      hint.Hint             `json:"_hint"`
+	ID        valuehash.HashDecoder `json:"id"`
+	Account   string                `json:"account"`
+	Operation json.RawMessage       `json:"operation"`
+	Height    base.Height           `json:"height"`
+	Fee       json.RawMessage       `json:"fee"`
+	Status    string                `json:"status"`
+}
+
+func (so *ScheduledOperation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ScheduledOperation")
+
+	var uso ScheduledOperationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uso); err != nil {
+		return e(err, "")
+	}
+
+	return so.unpack(enc, uso.Hint, uso.ID.Hash(), uso.Account, uso.Operation, uso.Height, uso.Fee, uso.Status)
+}
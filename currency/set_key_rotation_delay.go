@@ -0,0 +1,107 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SetKeyRotationDelayFactHint = hint.MustNewHint("mitum-currency-set-key-rotation-delay-operation-fact-v0.0.1")
+	SetKeyRotationDelayHint     = hint.MustNewHint("mitum-currency-set-key-rotation-delay-operation-v0.0.1")
+)
+
+// SetKeyRotationDelayFact sets the key rotation delay of sender account; with
+// the delay, KeyUpdater of sender keeps the new keys pending for delay blocks.
+// 0 delay means KeyUpdater replaces the keys immediately.
+type SetKeyRotationDelayFact struct {
+	base.BaseFact
+	sender   base.Address
+	delay    base.Height
+	currency mitumcurrency.CurrencyID
+}
+
+func NewSetKeyRotationDelayFact(
+	token []byte,
+	sender base.Address,
+	delay base.Height,
+	currency mitumcurrency.CurrencyID,
+) SetKeyRotationDelayFact {
+	bf := base.NewBaseFact(SetKeyRotationDelayFactHint, token)
+	fact := SetKeyRotationDelayFact{
+		BaseFact: bf,
+		sender:   sender,
+		delay:    delay,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SetKeyRotationDelayFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SetKeyRotationDelayFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetKeyRotationDelayFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SetKeyRotationDelayFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.delay.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SetKeyRotationDelayFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return util.CheckIsValiders(nil, false, fact.sender, fact.delay, fact.currency)
+}
+
+func (fact SetKeyRotationDelayFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetKeyRotationDelayFact) Delay() base.Height {
+	return fact.delay
+}
+
+func (fact SetKeyRotationDelayFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact SetKeyRotationDelayFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender}, nil
+}
+
+type SetKeyRotationDelay struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewSetKeyRotationDelay(fact SetKeyRotationDelayFact) (SetKeyRotationDelay, error) {
+	return SetKeyRotationDelay{BaseOperation: mitumcurrency.NewBaseOperation(SetKeyRotationDelayHint, fact)}, nil
+}
+
+func (op *SetKeyRotationDelay) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SetKeyRotationDelayFact) unpack(enc encoder.Encoder, sd string, delay base.Height, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal SetKeyRotationDelayFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	fact.delay = delay
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type SetKeyRotationDelayFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Delay    base.Height              `json:"delay"`
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact SetKeyRotationDelayFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetKeyRotationDelayFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Delay:                 fact.delay,
+		Currency:              fact.currency,
+	})
+}
+
+type SetKeyRotationDelayFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string      `json:"sender"`
+	Delay    base.Height `json:"delay"`
+	Currency string      `json:"currency"`
+}
+
+func (fact *SetKeyRotationDelayFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SetKeyRotationDelayFact")
+
+	var uf SetKeyRotationDelayFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Delay, uf.Currency)
+}
+
+type setKeyRotationDelayMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op SetKeyRotationDelay) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(setKeyRotationDelayMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SetKeyRotationDelay) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SetKeyRotationDelay")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
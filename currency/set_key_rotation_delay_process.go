@@ -0,0 +1,185 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var setKeyRotationDelayProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetKeyRotationDelayProcessor)
+	},
+}
+
+func (SetKeyRotationDelay) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type SetKeyRotationDelayProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSetKeyRotationDelayProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new SetKeyRotationDelayProcessor")
+
+		nopp := setKeyRotationDelayProcessorPool.Get()
+		opp, ok := nopp.(*SetKeyRotationDelayProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SetKeyRotationDelayProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SetKeyRotationDelayProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess SetKeyRotationDelay")
+
+	fact, ok := op.Fact().(SetKeyRotationDelayFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected SetKeyRotationDelayFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"contract account cannot set key rotation delay, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if _, err := existsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if _, rerr := opp.checkDelay(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SetKeyRotationDelayProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process SetKeyRotationDelay")
+
+	fact, ok := op.Fact().(SetKeyRotationDelayFact)
+	if !ok {
+		return nil, nil, e(nil, "expected SetKeyRotationDelayFact, not %T", op.Fact())
+	}
+
+	previous, rerr := opp.checkDelay(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewKeyRotationDelayStateMergeValue(
+			StateKeyKeyRotationDelay(fact.sender),
+			NewKeyRotationDelayStateValue(fact.sender, fact.delay, previous, opp.Height()),
+		),
+		NewBalanceStateMergeValue(
+			sb[fact.currency].Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		),
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *SetKeyRotationDelayProcessor) Close() error {
+	setKeyRotationDelayProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkDelay returns the key rotation delay of sender applied at the current
+// height; it becomes the previous delay of the new delay.
+func (opp *SetKeyRotationDelayProcessor) checkDelay(
+	fact SetKeyRotationDelayFact,
+	getStateFunc base.GetStateFunc,
+) (base.Height, base.OperationProcessReasonError) {
+	switch st, found, err := getStateFunc(StateKeyKeyRotationDelay(fact.sender)); {
+	case err != nil:
+		return 0, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation delay, %q: %w", fact.sender, err)
+	case !found:
+		if fact.delay < 1 {
+			return 0, base.NewBaseOperationProcessReasonError(
+				"key rotation delay not set, %q", fact.sender)
+		}
+
+		return 0, nil
+	default:
+		dv, err := StateKeyRotationDelayValue(st)
+		if err != nil {
+			return 0, base.NewBaseOperationProcessReasonError(
+				"failed to get key rotation delay value, %q: %w", fact.sender, err)
+		}
+
+		if dv.delay == fact.delay {
+			return 0, base.NewBaseOperationProcessReasonError(
+				"same key rotation delay as existing, %q: %d", fact.sender, fact.delay)
+		}
+
+		return dv.Delay(opp.Height()), nil
+	}
+}
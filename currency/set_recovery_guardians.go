@@ -0,0 +1,143 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SetRecoveryGuardiansFactHint = hint.MustNewHint("mitum-currency-set-recovery-guardians-operation-fact-v0.0.1")
+	SetRecoveryGuardiansHint     = hint.MustNewHint("mitum-currency-set-recovery-guardians-operation-v0.0.1")
+)
+
+// SetRecoveryGuardiansFact sets the guardians of sender account; empty
+// guardians disables the recovery of account. The pending recovery of account
+// is cancelled by the new guardians. If the account has the key rotation
+// delay, the recovery delay should not be under it and the new guardians are
+// pending for it.
+type SetRecoveryGuardiansFact struct {
+	base.BaseFact
+	sender    base.Address
+	guardians []base.Address
+	threshold uint
+	delay     base.Height
+	currency  mitumcurrency.CurrencyID
+}
+
+func NewSetRecoveryGuardiansFact(
+	token []byte,
+	sender base.Address,
+	guardians []base.Address,
+	threshold uint,
+	delay base.Height,
+	currency mitumcurrency.CurrencyID,
+) SetRecoveryGuardiansFact {
+	bf := base.NewBaseFact(SetRecoveryGuardiansFactHint, token)
+	fact := SetRecoveryGuardiansFact{
+		BaseFact:  bf,
+		sender:    sender,
+		guardians: guardians,
+		threshold: threshold,
+		delay:     delay,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SetRecoveryGuardiansFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SetRecoveryGuardiansFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SetRecoveryGuardiansFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SetRecoveryGuardiansFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.guardians))
+	for i := range fact.guardians {
+		bs[i] = fact.guardians[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		util.UintToBytes(fact.threshold),
+		fact.delay.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SetRecoveryGuardiansFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.currency); err != nil {
+		return err
+	}
+
+	if len(fact.guardians) < 1 && fact.delay != 0 {
+		return util.ErrInvalid.Errorf("delay without guardians, %d", fact.delay)
+	}
+
+	return isValidRecoveryGuardians(fact.sender, fact.guardians, fact.threshold, fact.delay)
+}
+
+func (fact SetRecoveryGuardiansFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SetRecoveryGuardiansFact) Guardians() []base.Address {
+	return fact.guardians
+}
+
+func (fact SetRecoveryGuardiansFact) Threshold() uint {
+	return fact.threshold
+}
+
+func (fact SetRecoveryGuardiansFact) Delay() base.Height {
+	return fact.delay
+}
+
+func (fact SetRecoveryGuardiansFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact SetRecoveryGuardiansFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.guardians)+1)
+	copy(as, fact.guardians)
+
+	as[len(fact.guardians)] = fact.sender
+
+	return as, nil
+}
+
+type SetRecoveryGuardians struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewSetRecoveryGuardians(fact SetRecoveryGuardiansFact) (SetRecoveryGuardians, error) {
+	return SetRecoveryGuardians{BaseOperation: mitumcurrency.NewBaseOperation(SetRecoveryGuardiansHint, fact)}, nil
+}
+
+func (op *SetRecoveryGuardians) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SetRecoveryGuardiansFact) unpack(enc encoder.Encoder, sd string, gds []string, th uint, delay base.Height, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal SetRecoveryGuardiansFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	guardians := make([]base.Address, len(gds))
+	for i := range gds {
+		a, err := base.DecodeAddress(gds[i], enc)
+		if err != nil {
+			return e(err, "")
+		}
+
+		guardians[i] = a
+	}
+	fact.guardians = guardians
+	fact.threshold = th
+	fact.delay = delay
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type SetRecoveryGuardiansFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address             `json:"sender"`
+	Guardians []base.Address           `json:"guardians"`
+	Threshold uint                     `json:"threshold"`
+	Delay     base.Height              `json:"delay"`
+	Currency  mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact SetRecoveryGuardiansFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SetRecoveryGuardiansFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Guardians:             fact.guardians,
+		Threshold:             fact.threshold,
+		Delay:                 fact.delay,
+		Currency:              fact.currency,
+	})
+}
+
+type SetRecoveryGuardiansFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string      `json:"sender"`
+	Guardians []string    `json:"guardians"`
+	Threshold uint        `json:"threshold"`
+	Delay     base.Height `json:"delay"`
+	Currency  string      `json:"currency"`
+}
+
+func (fact *SetRecoveryGuardiansFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SetRecoveryGuardiansFact")
+
+	var uf SetRecoveryGuardiansFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Guardians, uf.Threshold, uf.Delay, uf.Currency)
+}
+
+type setRecoveryGuardiansMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op SetRecoveryGuardians) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(setRecoveryGuardiansMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SetRecoveryGuardians) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SetRecoveryGuardians")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var setRecoveryGuardiansProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SetRecoveryGuardiansProcessor)
+	},
+}
+
+func (SetRecoveryGuardians) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type SetRecoveryGuardiansProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewSetRecoveryGuardiansProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new SetRecoveryGuardiansProcessor")
+
+		nopp := setRecoveryGuardiansProcessorPool.Get()
+		opp, ok := nopp.(*SetRecoveryGuardiansProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SetRecoveryGuardiansProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *SetRecoveryGuardiansProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess SetRecoveryGuardians")
+
+	fact, ok := op.Fact().(SetRecoveryGuardiansFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected SetRecoveryGuardiansFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot set recovery guardians, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for i := range fact.guardians {
+		gd := fact.guardians[i]
+
+		if err := checkExistsState(mitumcurrency.StateKeyAccount(gd), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("guardian not found, %q: %w", gd, err), nil
+		}
+
+		if err := checkNotExistsState(StateKeyContractAccount(gd), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be guardian, %q: %w", gd, err), nil
+		}
+	}
+
+	if _, err := existsCurrencyPolicy(fact.currency, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	if _, rerr := opp.newGuardians(fact, getStateFunc); rerr != nil {
+		return ctx, rerr, nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SetRecoveryGuardiansProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process SetRecoveryGuardians")
+
+	fact, ok := op.Fact().(SetRecoveryGuardiansFact)
+	if !ok {
+		return nil, nil, e(nil, "expected SetRecoveryGuardiansFact, not %T", op.Fact())
+	}
+
+	gv, rerr := opp.newGuardians(fact, getStateFunc)
+	if rerr != nil {
+		return nil, rerr, nil
+	}
+
+	policy, err := existsCurrencyPolicy(fact.currency, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.currency, err), nil
+	}
+
+	fee, err := policy.FeeerOf(factHint(op.Fact())).Fee(mitumcurrency.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.currency, err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(
+		fact.sender, map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{fact.currency: {fee, fee}}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	v, ok := sb[fact.currency].Value().(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[fact.currency].Value()), nil
+	}
+
+	sts := []base.StateMergeValue{
+		NewRecoveryGuardiansStateMergeValue(StateKeyRecoveryGuardians(fact.sender), gv),
+		NewBalanceStateMergeValue(
+			sb[fact.currency].Key(),
+			mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
+		),
+	}
+
+	// NOTE the approvals of pending recovery were made by the old guardians
+	switch ar, found, err := pendingAccountRecovery(fact.sender, getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get account recovery, %q: %w", fact.sender, err), nil
+	case found:
+		sts = append(sts, NewAccountRecoveryStateMergeValue(
+			StateKeyAccountRecovery(fact.sender), NewAccountRecoveryStateValue(ar.Cancelled())))
+	}
+
+	fsts, err := CollectFee(factHint(op.Fact()), fact.currency, fee, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *SetRecoveryGuardiansProcessor) Close() error {
+	setRecoveryGuardiansProcessorPool.Put(opp)
+
+	return nil
+}
+
+// newGuardians returns the new guardians of sender. With the key rotation
+// delay, the recovery delay should not be under the key rotation delay, and
+// the new guardians are pending for the key rotation delay, so the stolen key
+// can not set the guardians to recover the account earlier than the key
+// rotation.
+func (opp *SetRecoveryGuardiansProcessor) newGuardians(
+	fact SetRecoveryGuardiansFact,
+	getStateFunc base.GetStateFunc,
+) (RecoveryGuardiansStateValue, base.OperationProcessReasonError) {
+	delay, err := keyRotationDelay(fact.sender, opp.Height(), getStateFunc)
+	if err != nil {
+		return RecoveryGuardiansStateValue{}, base.NewBaseOperationProcessReasonError(
+			"failed to get key rotation delay, %q: %w", fact.sender, err)
+	}
+
+	if len(fact.guardians) > 0 && fact.delay < delay {
+		return RecoveryGuardiansStateValue{}, base.NewBaseOperationProcessReasonError(
+			"recovery delay under key rotation delay, %q; %d < %d", fact.sender, fact.delay, delay)
+	}
+
+	gv := NewRecoveryGuardiansStateValue(fact.sender, fact.guardians, fact.threshold, fact.delay)
+	if delay < 1 {
+		return gv, nil
+	}
+
+	previous := NewRecoveryGuardiansStateValue(fact.sender, nil, 0, 0)
+
+	switch st, found, err := getStateFunc(StateKeyRecoveryGuardians(fact.sender)); {
+	case err != nil:
+		return RecoveryGuardiansStateValue{}, base.NewBaseOperationProcessReasonError(
+			"failed to get recovery guardians, %q: %w", fact.sender, err)
+	case found:
+		pv, err := StateRecoveryGuardiansValue(st)
+		if err != nil {
+			return RecoveryGuardiansStateValue{}, base.NewBaseOperationProcessReasonError(
+				"failed to get recovery guardians value, %q: %w", fact.sender, err)
+		}
+
+		previous = pv.At(opp.Height())
+	}
+
+	return gv.Pending(previous, opp.Height()+delay), nil
+}
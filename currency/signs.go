@@ -28,3 +28,38 @@ func checkFactSignsByState(
 
 	return nil
 }
+
+// checkContractAccountOwnerSigns checks that sender can act as the owner of
+// contract account; with multiple owners, the fact should be signed by the
+// threshold of owners.
+func checkContractAccountOwnerSigns(
+	ca ContractAccount,
+	sender base.Address,
+	fs []base.Sign,
+	getState base.GetStateFunc,
+) error {
+	if !ca.IsMultiOwners() {
+		if !ca.Owner().Equal(sender) {
+			return base.NewBaseOperationProcessReasonError("contract account owner is not matched with %q", sender)
+		}
+
+		return nil
+	}
+
+	if !ca.IsOwner(sender) {
+		return base.NewBaseOperationProcessReasonError("sender, %q is not in contract account owners", sender)
+	}
+
+	var signed uint
+	for i := range ca.Owners() {
+		if err := checkFactSignsByState(ca.Owners()[i], fs, getState); err != nil {
+			continue
+		}
+
+		if signed++; signed >= ca.Threshold() {
+			return nil
+		}
+	}
+
+	return base.NewBaseOperationProcessReasonError("not enough signs of contract account owners, %d < %d", signed, ca.Threshold())
+}
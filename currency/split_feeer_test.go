@@ -0,0 +1,164 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testSplitFeeer struct {
+	testProcessorSuite
+}
+
+func newTestSplitFeeer(treasury, sink base.Address) SplitFeeer {
+	return NewSplitFeeer(
+		NewFixedFeeer(treasury, mitumcurrency.NewBig(10), mitumcurrency.ZeroBig),
+		[]FeeReceiver{NewFeeReceiver(treasury, 70), NewFeeReceiver(sink, 30)},
+	)
+}
+
+func (t *testSplitFeeer) TestSplit() {
+	fa := newTestSplitFeeer(base.RandomAddress(""), base.RandomAddress(""))
+	t.NoError(fa.IsValid(nil))
+
+	shares := fa.Split(mitumcurrency.NewBig(10))
+	t.Equal(2, len(shares))
+	t.Equal(mitumcurrency.NewBig(7), shares[0])
+	t.Equal(mitumcurrency.NewBig(3), shares[1])
+
+	// NOTE the first receiver takes the remainder
+	shares = fa.Split(mitumcurrency.NewBig(11))
+	t.Equal(mitumcurrency.NewBig(8), shares[0])
+	t.Equal(mitumcurrency.NewBig(3), shares[1])
+}
+
+func (t *testSplitFeeer) TestInvalid() {
+	treasury, sink := base.RandomAddress(""), base.RandomAddress("")
+	fixed := NewFixedFeeer(treasury, mitumcurrency.NewBig(10), mitumcurrency.ZeroBig)
+
+	t.Run("wrong sum of weights", func() {
+		fa := NewSplitFeeer(fixed, []FeeReceiver{NewFeeReceiver(treasury, 70), NewFeeReceiver(sink, 20)})
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "sum of receiver weights of split feeer")
+	})
+
+	t.Run("first receiver not receiver of feeer", func() {
+		fa := NewSplitFeeer(fixed, []FeeReceiver{NewFeeReceiver(sink, 30), NewFeeReceiver(treasury, 70)})
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "should be the receiver of feeer")
+	})
+
+	t.Run("duplicate receiver", func() {
+		fa := NewSplitFeeer(fixed, []FeeReceiver{NewFeeReceiver(treasury, 70), NewFeeReceiver(treasury, 30)})
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "duplicate receiver of split feeer")
+	})
+
+	t.Run("split of split", func() {
+		fa := NewSplitFeeer(newTestSplitFeeer(treasury, sink), []FeeReceiver{NewFeeReceiver(treasury, 100)})
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "invalid feeer type of split feeer")
+	})
+}
+
+func (t *testSplitFeeer) TestTransfers() {
+	cid := mitumcurrency.CurrencyID("SHOWME")
+	states := newTestStates(base.Height(33))
+
+	sender, privs, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(sender, cid, 100)
+
+	receiver, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(receiver, cid, 0)
+
+	treasury, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(treasury, cid, 0)
+
+	sink, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(sink, cid, 0)
+
+	states.setCurrency(cid, NewCurrencyPolicy(mitumcurrency.ZeroBig, newTestSplitFeeer(treasury, sink)))
+
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(30), cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(privs[0], t.networkID))
+
+	t.Nil(t.process(NewTransfersProcessor(), op, states))
+
+	t.Equal(mitumcurrency.NewBig(60), states.balance(sender, cid))
+	t.Equal(mitumcurrency.NewBig(30), states.balance(receiver, cid))
+	t.Equal(mitumcurrency.NewBig(7), states.balance(treasury, cid))
+	t.Equal(mitumcurrency.NewBig(3), states.balance(sink, cid))
+}
+
+func TestSplitFeeer(t *testing.T) {
+	suite.Run(t, new(testSplitFeeer))
+}
+
+func TestSplitFeeerEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: SplitFeeerHint, Instance: SplitFeeer{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		fa := newTestSplitFeeer(
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+		)
+		t.NoError(fa.IsValid(nil))
+
+		b, err := enc.Marshal(fa)
+		t.NoError(err)
+
+		return fa, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(SplitFeeer)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(SplitFeeer)
+		t.True(ok)
+		bf, ok := b.(SplitFeeer)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+		t.Equal(af.Bytes(), bf.Bytes())
+		t.Equal(af.Feeer().Type(), bf.Feeer().Type())
+		t.Equal(len(af.Receivers()), len(bf.Receivers()))
+
+		for i := range af.Receivers() {
+			t.True(af.Receivers()[i].Receiver().Equal(bf.Receivers()[i].Receiver()))
+			t.Equal(af.Receivers()[i].Weight(), bf.Receivers()[i].Weight())
+		}
+	}
+
+	suite.Run(tt, t)
+}
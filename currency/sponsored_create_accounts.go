@@ -0,0 +1,194 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SponsoredCreateAccountsFactHint = hint.MustNewHint("mitum-currency-sponsored-create-accounts-operation-fact-v0.0.1")
+	SponsoredCreateAccountsHint     = hint.MustNewHint("mitum-currency-sponsored-create-accounts-operation-v0.0.1")
+)
+
+type SponsoredCreateAccountsFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []mitumcurrency.CreateAccountsItem
+	feePayer    base.Address
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewSponsoredCreateAccountsFact(
+	token []byte,
+	sender base.Address,
+	items []mitumcurrency.CreateAccountsItem,
+	feePayer base.Address,
+	feeCurrency mitumcurrency.CurrencyID,
+) SponsoredCreateAccountsFact {
+	bf := base.NewBaseFact(SponsoredCreateAccountsFactHint, token)
+	fact := SponsoredCreateAccountsFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feePayer:    feePayer,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SponsoredCreateAccountsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SponsoredCreateAccountsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SponsoredCreateAccountsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SponsoredCreateAccountsFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	bs := [][]byte{
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feePayer.Bytes(),
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		bs = append(bs, fact.feeCurrency.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact SponsoredCreateAccountsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxCreateAccountsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxCreateAccountsItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feePayer); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.feePayer) {
+		return util.ErrInvalid.Errorf("fee payer is same with sender, %q", fact.sender)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	foundKeys := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Keys().Hash().String()
+		if _, found := foundKeys[k]; found {
+			return util.ErrInvalid.Errorf("duplicate account Keys found, %s", k)
+		}
+
+		switch a, err := it.Address(); {
+		case err != nil:
+			return err
+		case fact.sender.Equal(a):
+			return util.ErrInvalid.Errorf("target address is same with sender, %q", fact.sender)
+		default:
+			foundKeys[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact SponsoredCreateAccountsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SponsoredCreateAccountsFact) Items() []mitumcurrency.CreateAccountsItem {
+	return fact.items
+}
+
+func (fact SponsoredCreateAccountsFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// FeeCurrency returns the currency which the fee payer pays the fee in by
+// the exchange rate; empty FeeCurrency means the fee is paid in the currency
+// of amounts.
+func (fact SponsoredCreateAccountsFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact SponsoredCreateAccountsFact) Targets() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items))
+	for i := range fact.items {
+		a, err := fact.items[i].Address()
+		if err != nil {
+			return nil, err
+		}
+		as[i] = a
+	}
+
+	return as, nil
+}
+
+func (fact SponsoredCreateAccountsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+2)
+
+	tas, err := fact.Targets()
+	if err != nil {
+		return nil, err
+	}
+	copy(as, tas)
+
+	as[len(fact.items)] = fact.Sender()
+	as[len(fact.items)+1] = fact.FeePayer()
+
+	return as, nil
+}
+
+// SponsoredCreateAccounts is CreateAccounts of which fee is paid by the fee
+// payer instead of the sender, optionally in the fee currency by the exchange
+// rate.
+type SponsoredCreateAccounts struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewSponsoredCreateAccounts(fact SponsoredCreateAccountsFact) (SponsoredCreateAccounts, error) {
+	return SponsoredCreateAccounts{BaseOperation: mitumcurrency.NewBaseOperation(SponsoredCreateAccountsHint, fact)}, nil
+}
+
+func (op *SponsoredCreateAccounts) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package currency // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact SponsoredCreateAccountsFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":     fact.Hint().String(),
+		"sender":    fact.sender,
+		"items":     fact.items,
+		"fee_payer": fact.feePayer,
+		"hash":      fact.BaseFact.Hash().String(),
+		"token":     fact.BaseFact.Token(),
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		m["fee_currency"] = fact.feeCurrency
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type SponsoredCreateAccountsFactBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Sender      string   `bson:"sender"`
+	Items       bson.Raw `bson:"items"`
+	FeePayer    string   `bson:"fee_payer"`
+	FeeCurrency string   `bson:"fee_currency,omitempty"`
+}
+
+func (fact *SponsoredCreateAccountsFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SponsoredCreateAccountsFact")
+
+	var ubf mitumcurrency.BaseFactBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf SponsoredCreateAccountsFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeePayer, uf.FeeCurrency)
+}
+
+func (op SponsoredCreateAccounts) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *SponsoredCreateAccounts) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of SponsoredCreateAccounts")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type SponsoredCreateAccountsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address                       `json:"sender"`
+	Items       []mitumcurrency.CreateAccountsItem `json:"items"`
+	FeePayer    base.Address                       `json:"fee_payer"`
+	FeeCurrency mitumcurrency.CurrencyID           `json:"fee_currency,omitempty"`
+}
+
+func (fact SponsoredCreateAccountsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsoredCreateAccountsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+		FeePayer:              fact.feePayer,
+		FeeCurrency:           fact.feeCurrency,
+	})
+}
+
+type SponsoredCreateAccountsFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeePayer    string          `json:"fee_payer"`
+	FeeCurrency string          `json:"fee_currency"`
+}
+
+func (fact *SponsoredCreateAccountsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SponsoredCreateAccountsFact")
+
+	var uf SponsoredCreateAccountsFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeePayer, uf.FeeCurrency)
+}
+
+type sponsoredCreateAccountsMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op SponsoredCreateAccounts) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(sponsoredCreateAccountsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SponsoredCreateAccounts) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SponsoredCreateAccounts")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
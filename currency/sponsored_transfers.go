@@ -0,0 +1,175 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SponsoredTransfersFactHint = hint.MustNewHint("mitum-currency-sponsored-transfers-operation-fact-v0.0.1")
+	SponsoredTransfersHint     = hint.MustNewHint("mitum-currency-sponsored-transfers-operation-v0.0.1")
+)
+
+type SponsoredTransfersFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []mitumcurrency.TransfersItem
+	feePayer    base.Address
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewSponsoredTransfersFact(
+	token []byte,
+	sender base.Address,
+	items []mitumcurrency.TransfersItem,
+	feePayer base.Address,
+	feeCurrency mitumcurrency.CurrencyID,
+) SponsoredTransfersFact {
+	bf := base.NewBaseFact(SponsoredTransfersFactHint, token)
+	fact := SponsoredTransfersFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feePayer:    feePayer,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SponsoredTransfersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SponsoredTransfersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SponsoredTransfersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SponsoredTransfersFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	bs := [][]byte{
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feePayer.Bytes(),
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		bs = append(bs, fact.feeCurrency.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact SponsoredTransfersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(mitumcurrency.MaxTransferItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, mitumcurrency.MaxTransferItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feePayer); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.feePayer) {
+		return util.ErrInvalid.Errorf("fee payer is same with sender, %q", fact.sender)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	foundReceivers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Receiver().String()
+		switch _, found := foundReceivers[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate receiver found, %s", it.Receiver())
+		case fact.sender.Equal(it.Receiver()):
+			return util.ErrInvalid.Errorf("receiver is same with sender, %q", fact.sender)
+		case fact.feePayer.Equal(it.Receiver()):
+			return util.ErrInvalid.Errorf("receiver is same with fee payer, %q", fact.feePayer)
+		default:
+			foundReceivers[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact SponsoredTransfersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SponsoredTransfersFact) Items() []mitumcurrency.TransfersItem {
+	return fact.items
+}
+
+func (fact SponsoredTransfersFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// FeeCurrency returns the currency which the fee payer pays the fee in by
+// the exchange rate; empty FeeCurrency means the fee is paid in the currency
+// of amounts.
+func (fact SponsoredTransfersFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact SponsoredTransfersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+2)
+	for i := range fact.items {
+		as[i] = fact.items[i].Receiver()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+	as[len(fact.items)+1] = fact.FeePayer()
+
+	return as, nil
+}
+
+// SponsoredTransfers is Transfers of which fee is paid by the fee payer
+// instead of the sender, optionally in the fee currency by the exchange rate.
+type SponsoredTransfers struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewSponsoredTransfers(fact SponsoredTransfersFact) (SponsoredTransfers, error) {
+	return SponsoredTransfers{BaseOperation: mitumcurrency.NewBaseOperation(SponsoredTransfersHint, fact)}, nil
+}
+
+func (op *SponsoredTransfers) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
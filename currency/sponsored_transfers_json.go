@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type SponsoredTransfersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender      base.Address                  `json:"sender"`
+	Items       []mitumcurrency.TransfersItem `json:"items"`
+	FeePayer    base.Address                  `json:"fee_payer"`
+	FeeCurrency mitumcurrency.CurrencyID      `json:"fee_currency,omitempty"`
+}
+
+func (fact SponsoredTransfersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsoredTransfersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+		FeePayer:              fact.feePayer,
+		FeeCurrency:           fact.feeCurrency,
+	})
+}
+
+type SponsoredTransfersFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender      string          `json:"sender"`
+	Items       json.RawMessage `json:"items"`
+	FeePayer    string          `json:"fee_payer"`
+	FeeCurrency string          `json:"fee_currency"`
+}
+
+func (fact *SponsoredTransfersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SponsoredTransfersFact")
+
+	var uf SponsoredTransfersFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items, uf.FeePayer, uf.FeeCurrency)
+}
+
+type sponsoredTransfersMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op SponsoredTransfers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(sponsoredTransfersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SponsoredTransfers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of SponsoredTransfers")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
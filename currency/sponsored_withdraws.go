@@ -0,0 +1,175 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SponsoredWithdrawsFactHint = hint.MustNewHint("mitum-currency-sponsored-withdraws-operation-fact-v0.0.1")
+	SponsoredWithdrawsHint     = hint.MustNewHint("mitum-currency-sponsored-withdraws-operation-v0.0.1")
+)
+
+type SponsoredWithdrawsFact struct {
+	base.BaseFact
+	sender      base.Address
+	items       []WithdrawsItem
+	feePayer    base.Address
+	feeCurrency mitumcurrency.CurrencyID
+}
+
+func NewSponsoredWithdrawsFact(
+	token []byte,
+	sender base.Address,
+	items []WithdrawsItem,
+	feePayer base.Address,
+	feeCurrency mitumcurrency.CurrencyID,
+) SponsoredWithdrawsFact {
+	bf := base.NewBaseFact(SponsoredWithdrawsFactHint, token)
+	fact := SponsoredWithdrawsFact{
+		BaseFact:    bf,
+		sender:      sender,
+		items:       items,
+		feePayer:    feePayer,
+		feeCurrency: feeCurrency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SponsoredWithdrawsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SponsoredWithdrawsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SponsoredWithdrawsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SponsoredWithdrawsFact) Bytes() []byte {
+	its := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		its[i] = fact.items[i].Bytes()
+	}
+
+	bs := [][]byte{
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(its...),
+		fact.feePayer.Bytes(),
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		bs = append(bs, fact.feeCurrency.Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (fact SponsoredWithdrawsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxWithdrawsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxWithdrawsItems)
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.feePayer); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.feePayer) {
+		return util.ErrInvalid.Errorf("fee payer is same with sender, %q", fact.sender)
+	}
+
+	if len(fact.feeCurrency) > 0 {
+		if err := fact.feeCurrency.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	foundTargets := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := util.CheckIsValiders(nil, false, it); err != nil {
+			return err
+		}
+
+		k := it.Target().String()
+		switch _, found := foundTargets[k]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate target found, %s", it.Target())
+		case fact.sender.Equal(it.Target()):
+			return util.ErrInvalid.Errorf("target is same with sender, %q", fact.sender)
+		case fact.feePayer.Equal(it.Target()):
+			return util.ErrInvalid.Errorf("target is same with fee payer, %q", fact.feePayer)
+		default:
+			foundTargets[k] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact SponsoredWithdrawsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SponsoredWithdrawsFact) Items() []WithdrawsItem {
+	return fact.items
+}
+
+func (fact SponsoredWithdrawsFact) FeePayer() base.Address {
+	return fact.feePayer
+}
+
+// FeeCurrency returns the currency which the fee payer pays the fee in by
+// the exchange rate; empty FeeCurrency means the fee is paid in the currency
+// of amounts.
+func (fact SponsoredWithdrawsFact) FeeCurrency() mitumcurrency.CurrencyID {
+	return fact.feeCurrency
+}
+
+func (fact SponsoredWithdrawsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)+2)
+	for i := range fact.items {
+		as[i] = fact.items[i].Target()
+	}
+
+	as[len(fact.items)] = fact.Sender()
+	as[len(fact.items)+1] = fact.FeePayer()
+
+	return as, nil
+}
+
+// SponsoredWithdraws is Withdraws of which fee is paid by the fee payer
+// instead of the sender, optionally in the fee currency by the exchange rate.
+type SponsoredWithdraws struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewSponsoredWithdraws(fact SponsoredWithdrawsFact) (SponsoredWithdraws, error) {
+	return SponsoredWithdraws{BaseOperation: mitumcurrency.NewBaseOperation(SponsoredWithdrawsHint, fact)}, nil
+}
+
+func (op *SponsoredWithdraws) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
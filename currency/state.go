@@ -3,6 +3,7 @@ package currency
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
@@ -130,46 +131,1547 @@ func StateContractAccountValue(st base.State) (ContractAccount, error) {
 	return cs.account, nil
 }
 
+var ExchangeRateStateValueHint = hint.MustNewHint("exchange-rate-state-value-v0.0.1")
+
+var StateKeyExchangeRatePrefix = "exchangerate:"
+
+type ExchangeRateStateValue struct {
+	hint.BaseHinter
+	rate ExchangeRate
+}
+
+func NewExchangeRateStateValue(rate ExchangeRate) ExchangeRateStateValue {
+	return ExchangeRateStateValue{
+		BaseHinter: hint.NewBaseHinter(ExchangeRateStateValueHint),
+		rate:       rate,
+	}
+}
+
+func (c ExchangeRateStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c ExchangeRateStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ExchangeRateStateValue")
+
+	if err := c.BaseHinter.IsValid(ExchangeRateStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.rate); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c ExchangeRateStateValue) HashBytes() []byte {
+	return c.rate.Bytes()
+}
+
+func StateKeyExchangeRate(from, to mitumcurrency.CurrencyID) string {
+	return fmt.Sprintf("%s%s:%s", StateKeyExchangeRatePrefix, from, to)
+}
+
+func IsStateExchangeRateKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyExchangeRatePrefix)
+}
+
+func StateExchangeRateValue(st base.State) (ExchangeRate, error) {
+	v := st.Value()
+	if v == nil {
+		return ExchangeRate{}, util.ErrNotFound.Errorf("exchange rate not found in State")
+	}
+
+	er, ok := v.(ExchangeRateStateValue)
+	if !ok {
+		return ExchangeRate{}, errors.Errorf("invalid exchange rate value found, %T", v)
+	}
+
+	return er.rate, nil
+}
+
+var FeeStateValueHint = hint.MustNewHint("fee-state-value-v0.0.1")
+
+var StateKeyFeePrefix = "fee:"
+
+// FeeStateValue keeps the fees collected in the currency; fee is collected in
+// the block of state and total is collected since the first fee.
+type FeeStateValue struct {
+	hint.BaseHinter
+	currency mitumcurrency.CurrencyID
+	fee      mitumcurrency.Big
+	total    mitumcurrency.Big
+}
+
+func NewFeeStateValue(currency mitumcurrency.CurrencyID, fee, total mitumcurrency.Big) FeeStateValue {
+	return FeeStateValue{
+		BaseHinter: hint.NewBaseHinter(FeeStateValueHint),
+		currency:   currency,
+		fee:        fee,
+		total:      total,
+	}
+}
+
+func (c FeeStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c FeeStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FeeStateValue")
+
+	if err := c.BaseHinter.IsValid(FeeStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.currency); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !c.fee.OverNil() || !c.total.OverNil() {
+		return e.Wrap(errors.Errorf("under zero fee found"))
+	}
+
+	if c.total.Compare(c.fee) < 0 {
+		return e.Wrap(errors.Errorf("total is less than fee, %v < %v", c.total, c.fee))
+	}
+
+	return nil
+}
+
+func (c FeeStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		c.currency.Bytes(),
+		c.fee.Bytes(),
+		c.total.Bytes(),
+	)
+}
+
+func (c FeeStateValue) Currency() mitumcurrency.CurrencyID {
+	return c.currency
+}
+
+func (c FeeStateValue) Fee() mitumcurrency.Big {
+	return c.fee
+}
+
+func (c FeeStateValue) Total() mitumcurrency.Big {
+	return c.total
+}
+
+func StateKeyFee(cid mitumcurrency.CurrencyID) string {
+	return fmt.Sprintf("%s%s", StateKeyFeePrefix, cid)
+}
+
+func IsStateFeeKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyFeePrefix)
+}
+
+func StateFeeValue(st base.State) (FeeStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return FeeStateValue{}, util.ErrNotFound.Errorf("fee not found in State")
+	}
+
+	fv, ok := v.(FeeStateValue)
+	if !ok {
+		return FeeStateValue{}, errors.Errorf("invalid fee value found, %T", v)
+	}
+
+	return fv, nil
+}
+
+var LockStateValueHint = hint.MustNewHint("lock-state-value-v0.0.1")
+
+var StateKeyLockSuffix = ":lock"
+
+// LockStateValue keeps the vesting locks of the account in the currency.
+type LockStateValue struct {
+	hint.BaseHinter
+	currency mitumcurrency.CurrencyID
+	locks    []VestingLock
+}
+
+func NewLockStateValue(currency mitumcurrency.CurrencyID, locks []VestingLock) LockStateValue {
+	return LockStateValue{
+		BaseHinter: hint.NewBaseHinter(LockStateValueHint),
+		currency:   currency,
+		locks:      locks,
+	}
+}
+
+func (c LockStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c LockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid LockStateValue")
+
+	if err := c.BaseHinter.IsValid(LockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.currency); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range c.locks {
+		if err := c.locks[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (c LockStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(c.locks))
+	for i := range c.locks {
+		bs[i] = c.locks[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(c.currency.Bytes(), util.ConcatBytesSlice(bs...))
+}
+
+func (c LockStateValue) Currency() mitumcurrency.CurrencyID {
+	return c.currency
+}
+
+func (c LockStateValue) Locks() []VestingLock {
+	return c.locks
+}
+
+// Locked returns the amount which is not released yet at the height.
+func (c LockStateValue) Locked(height base.Height) mitumcurrency.Big {
+	b := mitumcurrency.ZeroBig
+	for i := range c.locks {
+		b = b.Add(c.locks[i].Locked(height))
+	}
+
+	return b
+}
+
+// Claimable returns the amount which is released, but not claimed yet at the
+// height.
+func (c LockStateValue) Claimable(height base.Height) mitumcurrency.Big {
+	b := mitumcurrency.ZeroBig
+	for i := range c.locks {
+		b = b.Add(c.locks[i].Claimable(height))
+	}
+
+	return b
+}
+
+// Claim claims the claimable amounts of locks at the height; the locks
+// claimed all are removed.
+func (c LockStateValue) Claim(height base.Height) (LockStateValue, mitumcurrency.Big, error) {
+	claimed := mitumcurrency.ZeroBig
+
+	var locks []VestingLock // nolint:prealloc
+	for i := range c.locks {
+		vl := c.locks[i]
+
+		if vl.Claimable(height).OverZero() {
+			j, k, err := vl.Claim(height)
+			if err != nil {
+				return c, mitumcurrency.ZeroBig, err
+			}
+
+			vl = j
+			claimed = claimed.Add(k)
+		}
+
+		if vl.IsClaimedAll() {
+			continue
+		}
+
+		locks = append(locks, vl)
+	}
+
+	if !claimed.OverZero() {
+		return c, mitumcurrency.ZeroBig, errors.Errorf("nothing to claim")
+	}
+
+	c.locks = locks
+
+	return c, claimed, nil
+}
+
+func StateKeyLock(a base.Address, cid mitumcurrency.CurrencyID) string {
+	return fmt.Sprintf("%s%s", mitumcurrency.StateBalanceKeyPrefix(a, cid), StateKeyLockSuffix)
+}
+
+func IsStateLockKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyLockSuffix)
+}
+
+func StateLockValue(st base.State) (LockStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return LockStateValue{}, util.ErrNotFound.Errorf("lock not found in State")
+	}
+
+	switch lv := v.(type) {
+	case LockStateValue:
+		return lv, nil
+	case AddLockStateValue:
+		return lv.LockStateValue, nil
+	default:
+		return LockStateValue{}, errors.Errorf("invalid lock value found, %T", v)
+	}
+}
+
+var EscrowStateValueHint = hint.MustNewHint("escrow-state-value-v0.0.1")
+
+var StateKeyEscrowPrefix = "escrow:"
+
+type EscrowStateValue struct {
+	hint.BaseHinter
+	escrow Escrow
+}
+
+func NewEscrowStateValue(escrow Escrow) EscrowStateValue {
+	return EscrowStateValue{
+		BaseHinter: hint.NewBaseHinter(EscrowStateValueHint),
+		escrow:     escrow,
+	}
+}
+
+func (c EscrowStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c EscrowStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid EscrowStateValue")
+
+	if err := c.BaseHinter.IsValid(EscrowStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.escrow); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c EscrowStateValue) HashBytes() []byte {
+	return c.escrow.Bytes()
+}
+
+func StateKeyEscrow(id util.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyEscrowPrefix, id)
+}
+
+func IsStateEscrowKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyEscrowPrefix)
+}
+
+func StateEscrowValue(st base.State) (Escrow, error) {
+	v := st.Value()
+	if v == nil {
+		return Escrow{}, util.ErrNotFound.Errorf("escrow not found in State")
+	}
+
+	es, ok := v.(EscrowStateValue)
+	if !ok {
+		return Escrow{}, errors.Errorf("invalid escrow value found, %T", v)
+	}
+
+	return es.escrow, nil
+}
+
+var PendingOperationStateValueHint = hint.MustNewHint("pending-operation-state-value-v0.0.1")
+
+var StateKeyPendingOperationPrefix = "pendingoperation:"
+
+type PendingOperationStateValue struct {
+	hint.BaseHinter
+	pending PendingOperation
+}
+
+func NewPendingOperationStateValue(pending PendingOperation) PendingOperationStateValue {
+	return PendingOperationStateValue{
+		BaseHinter: hint.NewBaseHinter(PendingOperationStateValueHint),
+		pending:    pending,
+	}
+}
+
+func (c PendingOperationStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c PendingOperationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid PendingOperationStateValue")
+
+	if err := c.BaseHinter.IsValid(PendingOperationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.pending); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c PendingOperationStateValue) HashBytes() []byte {
+	return c.pending.Bytes()
+}
+
+func StateKeyPendingOperation(id util.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyPendingOperationPrefix, id)
+}
+
+func IsStatePendingOperationKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyPendingOperationPrefix)
+}
+
+func StatePendingOperationValue(st base.State) (PendingOperation, error) {
+	v := st.Value()
+	if v == nil {
+		return PendingOperation{}, util.ErrNotFound.Errorf("pending operation not found in State")
+	}
+
+	po, ok := v.(PendingOperationStateValue)
+	if !ok {
+		return PendingOperation{}, errors.Errorf("invalid pending operation value found, %T", v)
+	}
+
+	return po.pending, nil
+}
+
+var ScheduledOperationStateValueHint = hint.MustNewHint("scheduled-operation-state-value-v0.0.1")
+
+var StateKeyScheduledOperationPrefix = "scheduledoperation:"
+
+type ScheduledOperationStateValue struct {
+	hint.BaseHinter
+	scheduled ScheduledOperation
+}
+
+func NewScheduledOperationStateValue(scheduled ScheduledOperation) ScheduledOperationStateValue {
+	return ScheduledOperationStateValue{
+		BaseHinter: hint.NewBaseHinter(ScheduledOperationStateValueHint),
+		scheduled:  scheduled,
+	}
+}
+
+func (c ScheduledOperationStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c ScheduledOperationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ScheduledOperationStateValue")
+
+	if err := c.BaseHinter.IsValid(ScheduledOperationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.scheduled); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c ScheduledOperationStateValue) HashBytes() []byte {
+	return c.scheduled.Bytes()
+}
+
+func StateKeyScheduledOperation(id util.Hash) string {
+	return fmt.Sprintf("%s%s", StateKeyScheduledOperationPrefix, id)
+}
+
+func IsStateScheduledOperationKey(key string) bool {
+	return strings.HasPrefix(key, StateKeyScheduledOperationPrefix)
+}
+
+func StateScheduledOperationValue(st base.State) (ScheduledOperation, error) {
+	v := st.Value()
+	if v == nil {
+		return ScheduledOperation{}, util.ErrNotFound.Errorf("scheduled operation not found in State")
+	}
+
+	so, ok := v.(ScheduledOperationStateValue)
+	if !ok {
+		return ScheduledOperation{}, errors.Errorf("invalid scheduled operation value found, %T", v)
+	}
+
+	return so.scheduled, nil
+}
+
+var FreezeStateValueHint = hint.MustNewHint("freeze-state-value-v0.0.1")
+
+var StateKeyFreezeSuffix = ":freeze"
+
+// FreezeStateValue keeps whether the account is frozen; without currency, all
+// the currencies of account are frozen.
+type FreezeStateValue struct {
+	hint.BaseHinter
+	account  base.Address
+	currency mitumcurrency.CurrencyID
+	frozen   bool
+}
+
+func NewFreezeStateValue(account base.Address, currency mitumcurrency.CurrencyID, frozen bool) FreezeStateValue {
+	return FreezeStateValue{
+		BaseHinter: hint.NewBaseHinter(FreezeStateValueHint),
+		account:    account,
+		currency:   currency,
+		frozen:     frozen,
+	}
+}
+
+func (c FreezeStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c FreezeStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid FreezeStateValue")
+
+	if err := c.BaseHinter.IsValid(FreezeStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.account); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(c.currency) > 0 {
+		if err := c.currency.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (c FreezeStateValue) HashBytes() []byte {
+	var frozen byte
+	if c.frozen {
+		frozen = 1
+	}
+
+	return util.ConcatBytesSlice(c.account.Bytes(), c.currency.Bytes(), []byte{frozen})
+}
+
+func (c FreezeStateValue) Account() base.Address {
+	return c.account
+}
+
+func (c FreezeStateValue) Currency() mitumcurrency.CurrencyID {
+	return c.currency
+}
+
+func (c FreezeStateValue) Frozen() bool {
+	return c.frozen
+}
+
+// StateKeyFreeze returns the key of freeze state; with empty currency, it is
+// the key for all the currencies of account.
+func StateKeyFreeze(a base.Address, cid mitumcurrency.CurrencyID) string {
+	if len(cid) < 1 {
+		return fmt.Sprintf("%s%s", a.String(), StateKeyFreezeSuffix)
+	}
+
+	return fmt.Sprintf("%s%s", mitumcurrency.StateBalanceKeyPrefix(a, cid), StateKeyFreezeSuffix)
+}
+
+func IsStateFreezeKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyFreezeSuffix)
+}
+
+func StateFreezeValue(st base.State) (FreezeStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return FreezeStateValue{}, util.ErrNotFound.Errorf("freeze not found in State")
+	}
+
+	fv, ok := v.(FreezeStateValue)
+	if !ok {
+		return FreezeStateValue{}, errors.Errorf("invalid freeze value found, %T", v)
+	}
+
+	return fv, nil
+}
+
+var AllowanceStateValueHint = hint.MustNewHint("allowance-state-value-v0.0.1")
+
+var StateKeyAllowanceSuffix = ":allowance"
+
+// AllowanceStateValue keeps the amount which the spender can move out of the
+// balance of owner by TransferFrom.
+type AllowanceStateValue struct {
+	hint.BaseHinter
+	owner   base.Address
+	spender base.Address
+	amount  mitumcurrency.Amount
+}
+
+func NewAllowanceStateValue(owner, spender base.Address, amount mitumcurrency.Amount) AllowanceStateValue {
+	return AllowanceStateValue{
+		BaseHinter: hint.NewBaseHinter(AllowanceStateValueHint),
+		owner:      owner,
+		spender:    spender,
+		amount:     amount,
+	}
+}
+
+func (c AllowanceStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c AllowanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid AllowanceStateValue")
+
+	if err := c.BaseHinter.IsValid(AllowanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.owner, c.spender, c.amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c AllowanceStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(c.owner.Bytes(), c.spender.Bytes(), c.amount.Bytes())
+}
+
+func (c AllowanceStateValue) Owner() base.Address {
+	return c.owner
+}
+
+func (c AllowanceStateValue) Spender() base.Address {
+	return c.spender
+}
+
+func (c AllowanceStateValue) Amount() mitumcurrency.Amount {
+	return c.amount
+}
+
+func StateKeyAllowance(owner, spender base.Address, cid mitumcurrency.CurrencyID) string {
+	return fmt.Sprintf("%s:%s%s", mitumcurrency.StateBalanceKeyPrefix(owner, cid), spender.String(), StateKeyAllowanceSuffix)
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAllowanceSuffix)
+}
+
+func StateAllowanceValue(st base.State) (AllowanceStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return AllowanceStateValue{}, util.ErrNotFound.Errorf("allowance not found in State")
+	}
+
+	av, ok := v.(AllowanceStateValue)
+	if !ok {
+		return AllowanceStateValue{}, errors.Errorf("invalid allowance value found, %T", v)
+	}
+
+	return av, nil
+}
+
+var RecoveryGuardiansStateValueHint = hint.MustNewHint("recovery-guardians-state-value-v0.0.1")
+
+var StateKeyRecoveryGuardiansSuffix = ":recoveryguardians"
+
+var MaxRecoveryGuardians = 10
+
+// RecoveryGuardiansStateValue keeps the guardians of account, which can
+// recover the account keys by RecoverAccount; the approved keys replace the
+// account keys after delay blocks. Empty guardians means the account can not
+// be recovered.
+//
+// If the account has the key rotation delay, the new guardians are pending
+// until the ready height like the key rotation, and the previous guardians are
+// kept until then; the pending guardians can be cancelled by
+// CancelKeyRotation.
+type RecoveryGuardiansStateValue struct {
+	hint.BaseHinter
+	account   base.Address
+	guardians []base.Address
+	threshold uint
+	delay     base.Height
+	previous  *RecoveryGuardiansStateValue
+	ready     base.Height
+}
+
+func NewRecoveryGuardiansStateValue(
+	account base.Address,
+	guardians []base.Address,
+	threshold uint,
+	delay base.Height,
+) RecoveryGuardiansStateValue {
+	return RecoveryGuardiansStateValue{
+		BaseHinter: hint.NewBaseHinter(RecoveryGuardiansStateValueHint),
+		account:    account,
+		guardians:  guardians,
+		threshold:  threshold,
+		delay:      delay,
+	}
+}
+
+func (c RecoveryGuardiansStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c RecoveryGuardiansStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RecoveryGuardiansStateValue")
+
+	if err := c.BaseHinter.IsValid(RecoveryGuardiansStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.account); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := isValidRecoveryGuardians(c.account, c.guardians, c.threshold, c.delay); err != nil {
+		return e.Wrap(err)
+	}
+
+	if c.previous != nil {
+		switch {
+		case c.previous.previous != nil:
+			return e.Errorf("previous guardians should not have previous")
+		case !c.previous.account.Equal(c.account):
+			return e.Errorf("account of previous guardians not matched, %q != %q", c.previous.account, c.account)
+		}
+
+		if err := c.previous.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (c RecoveryGuardiansStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(c.guardians))
+	for i := range c.guardians {
+		bs[i] = c.guardians[i].Bytes()
+	}
+
+	var pb []byte
+	if c.previous != nil {
+		pb = util.ConcatBytesSlice(c.previous.HashBytes(), c.ready.Bytes())
+	}
+
+	return util.ConcatBytesSlice(
+		c.account.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		util.UintToBytes(c.threshold),
+		c.delay.Bytes(),
+		pb,
+	)
+}
+
+func (c RecoveryGuardiansStateValue) Account() base.Address {
+	return c.account
+}
+
+func (c RecoveryGuardiansStateValue) Guardians() []base.Address {
+	return c.guardians
+}
+
+func (c RecoveryGuardiansStateValue) Threshold() uint {
+	return c.threshold
+}
+
+func (c RecoveryGuardiansStateValue) Delay() base.Height {
+	return c.delay
+}
+
+// Previous returns the previous guardians, which are kept until the ready
+// height of the pending guardians.
+func (c RecoveryGuardiansStateValue) Previous() (RecoveryGuardiansStateValue, bool) {
+	if c.previous == nil {
+		return RecoveryGuardiansStateValue{}, false
+	}
+
+	return *c.previous, true
+}
+
+// Ready returns the height from which the guardians replace the previous
+// guardians.
+func (c RecoveryGuardiansStateValue) Ready() base.Height {
+	return c.ready
+}
+
+// Pending returns the guardians, which replace the previous guardians at the
+// ready height.
+func (c RecoveryGuardiansStateValue) Pending(
+	previous RecoveryGuardiansStateValue, ready base.Height,
+) RecoveryGuardiansStateValue {
+	previous.previous = nil
+	previous.ready = 0
+
+	c.previous = &previous
+	c.ready = ready
+
+	return c
+}
+
+func (c RecoveryGuardiansStateValue) IsPending(height base.Height) bool {
+	return c.previous != nil && height < c.ready
+}
+
+// At returns the guardians applied at the height.
+func (c RecoveryGuardiansStateValue) At(height base.Height) RecoveryGuardiansStateValue {
+	if c.IsPending(height) {
+		return *c.previous
+	}
+
+	c.previous = nil
+	c.ready = 0
+
+	return c
+}
+
+func (c RecoveryGuardiansStateValue) IsGuardian(a base.Address) bool {
+	for i := range c.guardians {
+		if c.guardians[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isValidRecoveryGuardians(account base.Address, guardians []base.Address, threshold uint, delay base.Height) error {
+	switch n := len(guardians); {
+	case n < 1:
+		if threshold != 0 {
+			return util.ErrInvalid.Errorf("threshold without guardians, %d", threshold)
+		}
+
+		return nil
+	case n > MaxRecoveryGuardians:
+		return util.ErrInvalid.Errorf("guardians, %d over max, %d", n, MaxRecoveryGuardians)
+	case threshold < 1 || threshold > uint(n):
+		return util.ErrInvalid.Errorf("invalid threshold, %d for %d guardians", threshold, n)
+	case delay < 1:
+		return util.ErrInvalid.Errorf("delay should be over zero, %d", delay)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range guardians {
+		gd := guardians[i]
+		if err := gd.IsValid(nil); err != nil {
+			return err
+		}
+
+		switch _, found := founds[gd.String()]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate guardian found, %q", gd)
+		case account.Equal(gd):
+			return util.ErrInvalid.Errorf("guardian is same with account, %q", account)
+		default:
+			founds[gd.String()] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func StateKeyRecoveryGuardians(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyRecoveryGuardiansSuffix)
+}
+
+func IsStateRecoveryGuardiansKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyRecoveryGuardiansSuffix)
+}
+
+func StateRecoveryGuardiansValue(st base.State) (RecoveryGuardiansStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RecoveryGuardiansStateValue{}, util.ErrNotFound.Errorf("recovery guardians not found in State")
+	}
+
+	gv, ok := v.(RecoveryGuardiansStateValue)
+	if !ok {
+		return RecoveryGuardiansStateValue{}, errors.Errorf("invalid recovery guardians value found, %T", v)
+	}
+
+	return gv, nil
+}
+
+var AccountRecoveryStateValueHint = hint.MustNewHint("account-recovery-state-value-v0.0.1")
+
+var StateKeyAccountRecoverySuffix = ":accountrecovery"
+
+type AccountRecoveryStateValue struct {
+	hint.BaseHinter
+	recovery AccountRecovery
+}
+
+func NewAccountRecoveryStateValue(recovery AccountRecovery) AccountRecoveryStateValue {
+	return AccountRecoveryStateValue{
+		BaseHinter: hint.NewBaseHinter(AccountRecoveryStateValueHint),
+		recovery:   recovery,
+	}
+}
+
+func (c AccountRecoveryStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c AccountRecoveryStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid AccountRecoveryStateValue")
+
+	if err := c.BaseHinter.IsValid(AccountRecoveryStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.recovery); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c AccountRecoveryStateValue) HashBytes() []byte {
+	return c.recovery.Bytes()
+}
+
+func StateKeyAccountRecovery(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyAccountRecoverySuffix)
+}
+
+func IsStateAccountRecoveryKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyAccountRecoverySuffix)
+}
+
+func StateAccountRecoveryValue(st base.State) (AccountRecovery, error) {
+	v := st.Value()
+	if v == nil {
+		return AccountRecovery{}, util.ErrNotFound.Errorf("account recovery not found in State")
+	}
+
+	rv, ok := v.(AccountRecoveryStateValue)
+	if !ok {
+		return AccountRecovery{}, errors.Errorf("invalid account recovery value found, %T", v)
+	}
+
+	return rv.recovery, nil
+}
+
+var KeyRotationDelayStateValueHint = hint.MustNewHint("key-rotation-delay-state-value-v0.0.1")
+
+var StateKeyKeyRotationDelaySuffix = ":keyrotationdelay"
+
+// KeyRotationDelayStateValue keeps the key rotation delay of account; with
+// the delay, KeyUpdater does not replace the account keys immediately, but
+// the keys are pending for delay blocks. The increased delay is applied
+// immediately, but the decreased delay is applied after the previous delay
+// from the updated height, so the stolen keys can not skip the delay.
+type KeyRotationDelayStateValue struct {
+	hint.BaseHinter
+	account  base.Address
+	delay    base.Height
+	previous base.Height
+	updated  base.Height
+}
+
+func NewKeyRotationDelayStateValue(
+	account base.Address,
+	delay base.Height,
+	previous base.Height,
+	updated base.Height,
+) KeyRotationDelayStateValue {
+	return KeyRotationDelayStateValue{
+		BaseHinter: hint.NewBaseHinter(KeyRotationDelayStateValueHint),
+		account:    account,
+		delay:      delay,
+		previous:   previous,
+		updated:    updated,
+	}
+}
+
+func (c KeyRotationDelayStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c KeyRotationDelayStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid KeyRotationDelayStateValue")
+
+	if err := c.BaseHinter.IsValid(KeyRotationDelayStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.account, c.delay, c.previous, c.updated); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c KeyRotationDelayStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(
+		c.account.Bytes(),
+		c.delay.Bytes(),
+		c.previous.Bytes(),
+		c.updated.Bytes(),
+	)
+}
+
+func (c KeyRotationDelayStateValue) Account() base.Address {
+	return c.account
+}
+
+// Delay returns the key rotation delay applied at the height.
+func (c KeyRotationDelayStateValue) Delay(height base.Height) base.Height {
+	if c.delay < c.previous && height < c.updated+c.previous {
+		return c.previous
+	}
+
+	return c.delay
+}
+
+func StateKeyKeyRotationDelay(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyKeyRotationDelaySuffix)
+}
+
+func IsStateKeyRotationDelayKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyKeyRotationDelaySuffix)
+}
+
+func StateKeyRotationDelayValue(st base.State) (KeyRotationDelayStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return KeyRotationDelayStateValue{}, util.ErrNotFound.Errorf("key rotation delay not found in State")
+	}
+
+	dv, ok := v.(KeyRotationDelayStateValue)
+	if !ok {
+		return KeyRotationDelayStateValue{}, errors.Errorf("invalid key rotation delay value found, %T", v)
+	}
+
+	return dv, nil
+}
+
+var KeyRotationStateValueHint = hint.MustNewHint("key-rotation-state-value-v0.0.1")
+
+var StateKeyKeyRotationSuffix = ":keyrotation"
+
+type KeyRotationStateValue struct {
+	hint.BaseHinter
+	rotation KeyRotation
+}
+
+func NewKeyRotationStateValue(rotation KeyRotation) KeyRotationStateValue {
+	return KeyRotationStateValue{
+		BaseHinter: hint.NewBaseHinter(KeyRotationStateValueHint),
+		rotation:   rotation,
+	}
+}
+
+func (c KeyRotationStateValue) Hint() hint.Hint {
+	return c.BaseHinter.Hint()
+}
+
+func (c KeyRotationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid KeyRotationStateValue")
+
+	if err := c.BaseHinter.IsValid(KeyRotationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, c.rotation); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (c KeyRotationStateValue) HashBytes() []byte {
+	return c.rotation.Bytes()
+}
+
+func StateKeyKeyRotation(a base.Address) string {
+	return fmt.Sprintf("%s%s", a.String(), StateKeyKeyRotationSuffix)
+}
+
+func IsStateKeyRotationKey(key string) bool {
+	return strings.HasSuffix(key, StateKeyKeyRotationSuffix)
+}
+
+func StateKeyRotationValue(st base.State) (KeyRotation, error) {
+	v := st.Value()
+	if v == nil {
+		return KeyRotation{}, util.ErrNotFound.Errorf("key rotation not found in State")
+	}
+
+	rv, ok := v.(KeyRotationStateValue)
+	if !ok {
+		return KeyRotation{}, errors.Errorf("invalid key rotation value found, %T", v)
+	}
+
+	return rv.rotation, nil
+}
+
+// AddLockStateValue is the locks added to the lock state; like
+// AddBalanceStateValue, it is accumulated by LockStateValueMerger.
+type AddLockStateValue struct {
+	LockStateValue
+}
+
+func NewAddLockStateValue(currency mitumcurrency.CurrencyID, locks []VestingLock) AddLockStateValue {
+	return AddLockStateValue{
+		LockStateValue: NewLockStateValue(currency, locks),
+	}
+}
+
+// AddBalanceStateValue is the amount added to the balance; unlike
+// BalanceStateValue, it is accumulated by BalanceStateValueMerger, so the
+// several operations in the same block can add to the same balance, like the
+// fees to the receiver of feeer.
+type AddBalanceStateValue struct {
+	mitumcurrency.BalanceStateValue
+}
+
+func NewAddBalanceStateValue(amount mitumcurrency.Amount) AddBalanceStateValue {
+	return AddBalanceStateValue{
+		BalanceStateValue: mitumcurrency.NewBalanceStateValue(amount),
+	}
+}
+
 type CurrencyDesignStateValueMerger struct {
 	*base.BaseStateValueMerger
 }
 
-func NewCurrencyDesignStateValueMerger(height base.Height, key string, st base.State) *CurrencyDesignStateValueMerger {
-	s := &CurrencyDesignStateValueMerger{
+func NewCurrencyDesignStateValueMerger(height base.Height, key string, st base.State) *CurrencyDesignStateValueMerger {
+	s := &CurrencyDesignStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewCurrencyDesignStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewCurrencyDesignStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type ContractAccountStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewContractAccountStateValueMerger(height base.Height, key string, st base.State) *ContractAccountStateValueMerger {
+	s := &ContractAccountStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewContractAccountStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewContractAccountStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type ExchangeRateStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewExchangeRateStateValueMerger(height base.Height, key string, st base.State) *ExchangeRateStateValueMerger {
+	s := &ExchangeRateStateValueMerger{
 		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
 	}
 
 	return s
 }
 
-func NewCurrencyDesignStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+func NewExchangeRateStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
 	return base.NewBaseStateMergeValue(
 		key,
 		stv,
 		func(height base.Height, st base.State) base.StateValueMerger {
-			return NewCurrencyDesignStateValueMerger(height, key, st)
+			return NewExchangeRateStateValueMerger(height, key, st)
 		},
 	)
 }
 
-type ContractAccountStateValueMerger struct {
+// BalanceStateValueMerger merges the balances; BalanceStateValue replaces the
+// balance and AddBalanceStateValue is added to the balance.
+type BalanceStateValueMerger struct {
 	*base.BaseStateValueMerger
+	l     sync.Mutex
+	found bool
+	am    mitumcurrency.Amount
+	add   mitumcurrency.Big
 }
 
-func NewContractAccountStateValueMerger(height base.Height, key string, st base.State) *ContractAccountStateValueMerger {
-	s := &ContractAccountStateValueMerger{
+func NewBalanceStateValueMerger(height base.Height, key string, st base.State) *BalanceStateValueMerger {
+	s := &BalanceStateValueMerger{
 		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+		add:                  mitumcurrency.ZeroBig,
+	}
+
+	if st != nil {
+		if am, err := mitumcurrency.StateBalanceValue(st); err == nil {
+			s.am = am
+			s.found = true
+		}
 	}
 
 	return s
 }
 
-func NewContractAccountStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+func (s *BalanceStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	switch t := value.(type) {
+	case AddBalanceStateValue:
+		if !s.found {
+			s.am = mitumcurrency.NewAmount(mitumcurrency.ZeroBig, t.Amount.Currency())
+			s.found = true
+		}
+
+		s.add = s.add.Add(t.Amount.Big())
+	case mitumcurrency.BalanceStateValue:
+		s.am = t.Amount
+		s.found = true
+	default:
+		return errors.Errorf("expected BalanceStateValue, not %T", value)
+	}
+
+	return s.BaseStateValueMerger.Merge(
+		mitumcurrency.NewBalanceStateValue(s.am.WithBig(s.am.Big().Add(s.add))),
+		ops,
+	)
+}
+
+func NewBalanceStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
 	return base.NewBaseStateMergeValue(
 		key,
 		stv,
 		func(height base.Height, st base.State) base.StateValueMerger {
-			return NewContractAccountStateValueMerger(height, key, st)
+			return NewBalanceStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// FeeStateValueMerger sums the fees of the operations in the block.
+type FeeStateValueMerger struct {
+	*base.BaseStateValueMerger
+	l     sync.Mutex
+	fee   mitumcurrency.Big
+	total mitumcurrency.Big
+}
+
+func NewFeeStateValueMerger(height base.Height, key string, st base.State) *FeeStateValueMerger {
+	s := &FeeStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+		fee:                  mitumcurrency.ZeroBig,
+		total:                mitumcurrency.ZeroBig,
+	}
+
+	if st != nil {
+		if fv, err := StateFeeValue(st); err == nil {
+			s.total = fv.total
+		}
+	}
+
+	return s
+}
+
+func (s *FeeStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	fv, ok := value.(FeeStateValue)
+	if !ok {
+		return errors.Errorf("expected FeeStateValue, not %T", value)
+	}
+
+	s.fee = s.fee.Add(fv.fee)
+
+	return s.BaseStateValueMerger.Merge(
+		NewFeeStateValue(fv.currency, s.fee, s.total.Add(s.fee)),
+		ops,
+	)
+}
+
+func NewFeeStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewFeeStateValueMerger(height, key, st)
+		},
+	)
+}
+
+// LockStateValueMerger merges the locks; LockStateValue replaces the locks and
+// the locks of AddLockStateValue are appended.
+type LockStateValueMerger struct {
+	*base.BaseStateValueMerger
+	l     sync.Mutex
+	found bool
+	lv    LockStateValue
+	add   []VestingLock
+}
+
+func NewLockStateValueMerger(height base.Height, key string, st base.State) *LockStateValueMerger {
+	s := &LockStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	if st != nil {
+		if lv, err := StateLockValue(st); err == nil {
+			s.lv = lv
+			s.found = true
+		}
+	}
+
+	return s
+}
+
+func (s *LockStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	switch t := value.(type) {
+	case AddLockStateValue:
+		if !s.found {
+			s.lv = NewLockStateValue(t.currency, nil)
+			s.found = true
+		}
+
+		s.add = append(s.add, t.locks...)
+	case LockStateValue:
+		s.lv = t
+		s.found = true
+	default:
+		return errors.Errorf("expected LockStateValue, not %T", value)
+	}
+
+	locks := make([]VestingLock, len(s.lv.locks)+len(s.add))
+	copy(locks, s.lv.locks)
+	copy(locks[len(s.lv.locks):], s.add)
+
+	return s.BaseStateValueMerger.Merge(NewLockStateValue(s.lv.currency, locks), ops)
+}
+
+func NewLockStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewLockStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type EscrowStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewEscrowStateValueMerger(height base.Height, key string, st base.State) *EscrowStateValueMerger {
+	s := &EscrowStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewEscrowStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewEscrowStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type PendingOperationStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewPendingOperationStateValueMerger(height base.Height, key string, st base.State) *PendingOperationStateValueMerger {
+	s := &PendingOperationStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewPendingOperationStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewPendingOperationStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type ScheduledOperationStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewScheduledOperationStateValueMerger(height base.Height, key string, st base.State) *ScheduledOperationStateValueMerger {
+	s := &ScheduledOperationStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewScheduledOperationStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewScheduledOperationStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type FreezeStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewFreezeStateValueMerger(height base.Height, key string, st base.State) *FreezeStateValueMerger {
+	s := &FreezeStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewFreezeStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewFreezeStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type AllowanceStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewAllowanceStateValueMerger(height base.Height, key string, st base.State) *AllowanceStateValueMerger {
+	s := &AllowanceStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewAllowanceStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewAllowanceStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type RecoveryGuardiansStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewRecoveryGuardiansStateValueMerger(height base.Height, key string, st base.State) *RecoveryGuardiansStateValueMerger {
+	s := &RecoveryGuardiansStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewRecoveryGuardiansStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewRecoveryGuardiansStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type AccountRecoveryStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewAccountRecoveryStateValueMerger(height base.Height, key string, st base.State) *AccountRecoveryStateValueMerger {
+	s := &AccountRecoveryStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewAccountRecoveryStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewAccountRecoveryStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type KeyRotationDelayStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewKeyRotationDelayStateValueMerger(height base.Height, key string, st base.State) *KeyRotationDelayStateValueMerger {
+	s := &KeyRotationDelayStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewKeyRotationDelayStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewKeyRotationDelayStateValueMerger(height, key, st)
+		},
+	)
+}
+
+type KeyRotationStateValueMerger struct {
+	*base.BaseStateValueMerger
+}
+
+func NewKeyRotationStateValueMerger(height base.Height, key string, st base.State) *KeyRotationStateValueMerger {
+	s := &KeyRotationStateValueMerger{
+		BaseStateValueMerger: base.NewBaseStateValueMerger(height, key, st),
+	}
+
+	return s
+}
+
+func NewKeyRotationStateMergeValue(key string, stv base.StateValue) base.StateMergeValue {
+	return base.NewBaseStateMergeValue(
+		key,
+		stv,
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return NewKeyRotationStateValueMerger(height, key, st)
 		},
 	)
 }
@@ -250,3 +1752,37 @@ func existsCurrencyPolicy(cid mitumcurrency.CurrencyID, getStateFunc base.GetSta
 	}
 	return policy, nil
 }
+
+// existsActiveCurrencyPolicy returns the policy of currency; if the currency
+// is paused, it fails.
+func existsActiveCurrencyPolicy(cid mitumcurrency.CurrencyID, getStateFunc base.GetStateFunc) (CurrencyPolicy, error) {
+	policy, err := existsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return CurrencyPolicy{}, err
+	}
+
+	if policy.Paused() {
+		return CurrencyPolicy{}, errors.Errorf("currency paused, %v", cid)
+	}
+
+	return policy, nil
+}
+
+func checkActiveContractAccount(a base.Address, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(StateKeyContractAccount(a)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		ca, err := StateContractAccountValue(st)
+		if err != nil {
+			return err
+		}
+		if !ca.IsActive() {
+			return base.NewBaseOperationProcessReasonError("contract account, %q is deactivated", a)
+		}
+	}
+
+	return nil
+}
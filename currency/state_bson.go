@@ -2,6 +2,7 @@ package currency
 
 import (
 	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -83,3 +84,457 @@ func (s *ContractAccountStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 
 	return nil
 }
+
+func (s ExchangeRateStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        s.Hint().String(),
+			"exchangerate": s.rate,
+		},
+	)
+}
+
+type ExchangeRateStateValueBSONUnmarshaler struct {
+	Hint         string   `bson:"_hint"`
+	ExchangeRate bson.Raw `bson:"exchangerate"`
+}
+
+func (s *ExchangeRateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ExchangeRateStateValue")
+
+	var u ExchangeRateStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var er ExchangeRate
+	if err := er.DecodeBSON(u.ExchangeRate, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.rate = er
+
+	return nil
+}
+
+func (s FeeStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"currency": s.currency,
+			"fee":      s.fee.String(),
+			"total":    s.total.String(),
+		},
+	)
+}
+
+type FeeStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Currency string `bson:"currency"`
+	Fee      string `bson:"fee"`
+	Total    string `bson:"total"`
+}
+
+func (s *FeeStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of FeeStateValue")
+
+	var u FeeStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(ht, u.Currency, u.Fee, u.Total)
+}
+
+func (s LockStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"currency": s.currency,
+			"locks":    s.locks,
+		},
+	)
+}
+
+type LockStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Currency string   `bson:"currency"`
+	Locks    bson.Raw `bson:"locks"`
+}
+
+func (s *LockStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of LockStateValue")
+
+	var u LockStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, ht, u.Currency, u.Locks)
+}
+
+func (s EscrowStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"escrow": s.escrow,
+		},
+	)
+}
+
+type EscrowStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Escrow bson.Raw `bson:"escrow"`
+}
+
+func (s *EscrowStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of EscrowStateValue")
+
+	var u EscrowStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var es Escrow
+	if err := es.DecodeBSON(u.Escrow, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.escrow = es
+
+	return nil
+}
+
+func (s PendingOperationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"pending": s.pending,
+		},
+	)
+}
+
+type PendingOperationStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Pending bson.Raw `bson:"pending"`
+}
+
+func (s *PendingOperationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of PendingOperationStateValue")
+
+	var u PendingOperationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var po PendingOperation
+	if err := po.DecodeBSON(u.Pending, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.pending = po
+
+	return nil
+}
+
+func (s ScheduledOperationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"scheduled": s.scheduled,
+		},
+	)
+}
+
+type ScheduledOperationStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Scheduled bson.Raw `bson:"scheduled"`
+}
+
+func (s *ScheduledOperationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of ScheduledOperationStateValue")
+
+	var u ScheduledOperationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var so ScheduledOperation
+	if err := so.DecodeBSON(u.Scheduled, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.scheduled = so
+
+	return nil
+}
+
+func (s FreezeStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"account":  s.account,
+			"currency": s.currency,
+			"frozen":   s.frozen,
+		},
+	)
+}
+
+type FreezeStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Account  string `bson:"account"`
+	Currency string `bson:"currency"`
+	Frozen   bool   `bson:"frozen"`
+}
+
+func (s *FreezeStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of FreezeStateValue")
+
+	var u FreezeStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, ht, u.Account, u.Currency, u.Frozen)
+}
+
+func (s AllowanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   s.Hint().String(),
+			"owner":   s.owner,
+			"spender": s.spender,
+			"amount":  s.amount,
+		},
+	)
+}
+
+type AllowanceStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Owner   string   `bson:"owner"`
+	Spender string   `bson:"spender"`
+	Amount  bson.Raw `bson:"amount"`
+}
+
+func (s *AllowanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AllowanceStateValue")
+
+	var u AllowanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, ht, u.Owner, u.Spender, u.Amount)
+}
+
+func (s RecoveryGuardiansStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":     s.Hint().String(),
+		"account":   s.account,
+		"guardians": s.guardians,
+		"threshold": s.threshold,
+		"delay":     s.delay,
+	}
+
+	if s.previous != nil {
+		m["previous"] = s.previous
+		m["ready"] = s.ready
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type RecoveryGuardiansStateValueBSONUnmarshaler struct {
+	Hint      string      `bson:"_hint"`
+	Account   string      `bson:"account"`
+	Guardians []string    `bson:"guardians"`
+	Threshold uint        `bson:"threshold"`
+	Delay     base.Height `bson:"delay"`
+	Previous  bson.Raw    `bson:"previous,omitempty"`
+	Ready     base.Height `bson:"ready,omitempty"`
+}
+
+func (s *RecoveryGuardiansStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of RecoveryGuardiansStateValue")
+
+	var u RecoveryGuardiansStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	if err := s.unpack(enc, ht, u.Account, u.Guardians, u.Threshold, u.Delay, u.Ready); err != nil {
+		return e(err, "")
+	}
+
+	if len(u.Previous) > 0 {
+		var previous RecoveryGuardiansStateValue
+		if err := previous.DecodeBSON(u.Previous, enc); err != nil {
+			return e(err, "")
+		}
+
+		s.previous = &previous
+	}
+
+	return nil
+}
+
+func (s AccountRecoveryStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"recovery": s.recovery,
+		},
+	)
+}
+
+type AccountRecoveryStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Recovery bson.Raw `bson:"recovery"`
+}
+
+func (s *AccountRecoveryStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of AccountRecoveryStateValue")
+
+	var u AccountRecoveryStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var ar AccountRecovery
+	if err := ar.DecodeBSON(u.Recovery, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.recovery = ar
+
+	return nil
+}
+
+func (s KeyRotationDelayStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"account":  s.account,
+			"delay":    s.delay,
+			"previous": s.previous,
+			"updated":  s.updated,
+		},
+	)
+}
+
+type KeyRotationDelayStateValueBSONUnmarshaler struct {
+	Hint     string      `bson:"_hint"`
+	Account  string      `bson:"account"`
+	Delay    base.Height `bson:"delay"`
+	Previous base.Height `bson:"previous"`
+	Updated  base.Height `bson:"updated"`
+}
+
+func (s *KeyRotationDelayStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of KeyRotationDelayStateValue")
+
+	var u KeyRotationDelayStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, ht, u.Account, u.Delay, u.Previous, u.Updated)
+}
+
+func (s KeyRotationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"rotation": s.rotation,
+		},
+	)
+}
+
+type KeyRotationStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Rotation bson.Raw `bson:"rotation"`
+}
+
+func (s *KeyRotationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of KeyRotationStateValue")
+
+	var u KeyRotationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var kr KeyRotation
+	if err := kr.DecodeBSON(u.Rotation, enc); err != nil {
+		return e(err, "")
+	}
+
+	s.rotation = kr
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s *FeeStateValue) unpack(ht hint.Hint, cid, fee, total string) error {
+	e := util.StringErrorFunc("failed to unmarshal FeeStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.currency = mitumcurrency.CurrencyID(cid)
+
+	if big, err := mitumcurrency.NewBigFromString(fee); err != nil {
+		return e(err, "failed to decode fee")
+	} else {
+		s.fee = big
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(total); err != nil {
+		return e(err, "failed to decode total")
+	} else {
+		s.total = big
+	}
+
+	return nil
+}
+
+func (s *LockStateValue) unpack(enc encoder.Encoder, ht hint.Hint, cid string, bls []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal LockStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.currency = mitumcurrency.CurrencyID(cid)
+
+	hls, err := enc.DecodeSlice(bls)
+	if err != nil {
+		return e(err, "failed to decode locks")
+	}
+
+	locks := make([]VestingLock, len(hls))
+	for i := range hls {
+		j, ok := hls[i].(VestingLock)
+		if !ok {
+			return e(util.ErrWrongType.Errorf("expected VestingLock, not %T", hls[i]), "")
+		}
+
+		locks[i] = j
+	}
+	s.locks = locks
+
+	return nil
+}
+
+func (s *FreezeStateValue) unpack(enc encoder.Encoder, ht hint.Hint, ac, cid string, frozen bool) error {
+	e := util.StringErrorFunc("failed to unmarshal FreezeStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		s.account = a
+	}
+
+	s.currency = mitumcurrency.CurrencyID(cid)
+	s.frozen = frozen
+
+	return nil
+}
+
+func (s *AllowanceStateValue) unpack(enc encoder.Encoder, ht hint.Hint, ow, sp string, bam []byte) error {
+	e := util.StringErrorFunc("failed to unmarshal AllowanceStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		s.owner = a
+	}
+
+	switch a, err := base.DecodeAddress(sp, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		s.spender = a
+	}
+
+	var am mitumcurrency.Amount
+	if err := encoder.Decode(enc, bam, &am); err != nil {
+		return e(err, "failed to decode amount")
+	}
+	s.amount = am
+
+	return nil
+}
+
+func (s *RecoveryGuardiansStateValue) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	gds []string,
+	threshold uint,
+	delay base.Height,
+	ready base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal RecoveryGuardiansStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		s.account = a
+	}
+
+	guardians := make([]base.Address, len(gds))
+	for i := range gds {
+		a, err := base.DecodeAddress(gds[i], enc)
+		if err != nil {
+			return e(err, "")
+		}
+
+		guardians[i] = a
+	}
+	s.guardians = guardians
+
+	s.threshold = threshold
+	s.delay = delay
+	s.ready = ready
+
+	return nil
+}
+
+func (s *KeyRotationDelayStateValue) unpack(
+	enc encoder.Encoder,
+	ht hint.Hint,
+	ac string,
+	delay base.Height,
+	previous base.Height,
+	updated base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal KeyRotationDelayStateValue")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		s.account = a
+	}
+
+	s.delay = delay
+	s.previous = previous
+	s.updated = updated
+
+	return nil
+}
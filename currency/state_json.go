@@ -3,6 +3,8 @@ package currency
 import (
 	"encoding/json"
 
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -78,3 +80,445 @@ func (s *ContractAccountStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) e
 
 	return nil
 }
+
+type ExchangeRateStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	ExchangeRate ExchangeRate `json:"exchangerate"`
+}
+
+func (s ExchangeRateStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ExchangeRateStateValueJSONMarshaler{
+		BaseHinter:   s.BaseHinter,
+		ExchangeRate: s.rate,
+	})
+}
+
+type ExchangeRateStateValueJSONUnmarshaler struct {
+	Hint         hint.Hint       `json:"_hint"`
+	ExchangeRate json.RawMessage `json:"exchangerate"`
+}
+
+func (s *ExchangeRateStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ExchangeRateStateValue")
+
+	var u ExchangeRateStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var er ExchangeRate
+	if err := er.DecodeJSON(u.ExchangeRate, enc); err != nil {
+		return e(err, "")
+	}
+	s.rate = er
+
+	return nil
+}
+
+type FeeStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+	Fee      string                   `json:"fee"`
+	Total    string                   `json:"total"`
+}
+
+func (s FeeStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Currency:   s.currency,
+		Fee:        s.fee.String(),
+		Total:      s.total.String(),
+	})
+}
+
+type FeeStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Currency string    `json:"currency"`
+	Fee      string    `json:"fee"`
+	Total    string    `json:"total"`
+}
+
+func (s *FeeStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FeeStateValue")
+
+	var u FeeStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(u.Hint, u.Currency, u.Fee, u.Total)
+}
+
+type LockStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+	Locks    []VestingLock            `json:"locks"`
+}
+
+func (s LockStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Currency:   s.currency,
+		Locks:      s.locks,
+	})
+}
+
+type LockStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Currency string          `json:"currency"`
+	Locks    json.RawMessage `json:"locks"`
+}
+
+func (s *LockStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of LockStateValue")
+
+	var u LockStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, u.Hint, u.Currency, u.Locks)
+}
+
+type EscrowStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Escrow Escrow `json:"escrow"`
+}
+
+func (s EscrowStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EscrowStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Escrow:     s.escrow,
+	})
+}
+
+type EscrowStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Escrow json.RawMessage `json:"escrow"`
+}
+
+func (s *EscrowStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of EscrowStateValue")
+
+	var u EscrowStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var es Escrow
+	if err := es.DecodeJSON(u.Escrow, enc); err != nil {
+		return e(err, "")
+	}
+	s.escrow = es
+
+	return nil
+}
+
+type PendingOperationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Pending PendingOperation `json:"pending"`
+}
+
+func (s PendingOperationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PendingOperationStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Pending:    s.pending,
+	})
+}
+
+type PendingOperationStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Pending json.RawMessage `json:"pending"`
+}
+
+func (s *PendingOperationStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of PendingOperationStateValue")
+
+	var u PendingOperationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var po PendingOperation
+	if err := po.DecodeJSON(u.Pending, enc); err != nil {
+		return e(err, "")
+	}
+	s.pending = po
+
+	return nil
+}
+
+type ScheduledOperationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Scheduled ScheduledOperation `json:"scheduled"`
+}
+
+func (s ScheduledOperationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ScheduledOperationStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Scheduled:  s.scheduled,
+	})
+}
+
+type ScheduledOperationStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Scheduled json.RawMessage `json:"scheduled"`
+}
+
+func (s *ScheduledOperationStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of ScheduledOperationStateValue")
+
+	var u ScheduledOperationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var so ScheduledOperation
+	if err := so.DecodeJSON(u.Scheduled, enc); err != nil {
+		return e(err, "")
+	}
+	s.scheduled = so
+
+	return nil
+}
+
+type FreezeStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account  base.Address             `json:"account"`
+	Currency mitumcurrency.CurrencyID `json:"currency,omitempty"`
+	Frozen   bool                     `json:"frozen"`
+}
+
+func (s FreezeStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FreezeStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Account:    s.account,
+		Currency:   s.currency,
+		Frozen:     s.frozen,
+	})
+}
+
+type FreezeStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Account  string    `json:"account"`
+	Currency string    `json:"currency"`
+	Frozen   bool      `json:"frozen"`
+}
+
+func (s *FreezeStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of FreezeStateValue")
+
+	var u FreezeStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, u.Hint, u.Account, u.Currency, u.Frozen)
+}
+
+type AllowanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Owner   base.Address         `json:"owner"`
+	Spender base.Address         `json:"spender"`
+	Amount  mitumcurrency.Amount `json:"amount"`
+}
+
+func (s AllowanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AllowanceStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Owner:      s.owner,
+		Spender:    s.spender,
+		Amount:     s.amount,
+	})
+}
+
+type AllowanceStateValueJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Owner   string          `json:"owner"`
+	Spender string          `json:"spender"`
+	Amount  json.RawMessage `json:"amount"`
+}
+
+func (s *AllowanceStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AllowanceStateValue")
+
+	var u AllowanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, u.Hint, u.Owner, u.Spender, u.Amount)
+}
+
+type RecoveryGuardiansStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account   base.Address                 `json:"account"`
+	Guardians []base.Address               `json:"guardians"`
+	Threshold uint                         `json:"threshold"`
+	Delay     base.Height                  `json:"delay"`
+	Previous  *RecoveryGuardiansStateValue `json:"previous,omitempty"`
+	Ready     base.Height                  `json:"ready,omitempty"`
+}
+
+func (s RecoveryGuardiansStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoveryGuardiansStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Account:    s.account,
+		Guardians:  s.guardians,
+		Threshold:  s.threshold,
+		Delay:      s.delay,
+		Previous:   s.previous,
+		Ready:      s.ready,
+	})
+}
+
+type RecoveryGuardiansStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Account   string          `json:"account"`
+	Guardians []string        `json:"guardians"`
+	Threshold uint            `json:"threshold"`
+	Delay     base.Height     `json:"delay"`
+	Previous  json.RawMessage `json:"previous"`
+	Ready     base.Height     `json:"ready"`
+}
+
+func (s *RecoveryGuardiansStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of RecoveryGuardiansStateValue")
+
+	var u RecoveryGuardiansStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	if err := s.unpack(enc, u.Hint, u.Account, u.Guardians, u.Threshold, u.Delay, u.Ready); err != nil {
+		return e(err, "")
+	}
+
+	if len(u.Previous) > 0 && string(u.Previous) != "null" {
+		var previous RecoveryGuardiansStateValue
+		if err := previous.DecodeJSON(u.Previous, enc); err != nil {
+			return e(err, "")
+		}
+
+		s.previous = &previous
+	}
+
+	return nil
+}
+
+type AccountRecoveryStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Recovery AccountRecovery `json:"recovery"`
+}
+
+func (s AccountRecoveryStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AccountRecoveryStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Recovery:   s.recovery,
+	})
+}
+
+type AccountRecoveryStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Recovery json.RawMessage `json:"recovery"`
+}
+
+func (s *AccountRecoveryStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of AccountRecoveryStateValue")
+
+	var u AccountRecoveryStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var ar AccountRecovery
+	if err := ar.DecodeJSON(u.Recovery, enc); err != nil {
+		return e(err, "")
+	}
+	s.recovery = ar
+
+	return nil
+}
+
+type KeyRotationDelayStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Account  base.Address `json:"account"`
+	Delay    base.Height  `json:"delay"`
+	Previous base.Height  `json:"previous"`
+	Updated  base.Height  `json:"updated"`
+}
+
+func (s KeyRotationDelayStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KeyRotationDelayStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Account:    s.account,
+		Delay:      s.delay,
+		Previous:   s.previous,
+		Updated:    s.updated,
+	})
+}
+
+type KeyRotationDelayStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint   `json:"_hint"`
+	Account  string      `json:"account"`
+	Delay    base.Height `json:"delay"`
+	Previous base.Height `json:"previous"`
+	Updated  base.Height `json:"updated"`
+}
+
+func (s *KeyRotationDelayStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of KeyRotationDelayStateValue")
+
+	var u KeyRotationDelayStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	return s.unpack(enc, u.Hint, u.Account, u.Delay, u.Previous, u.Updated)
+}
+
+type KeyRotationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Rotation KeyRotation `json:"rotation"`
+}
+
+func (s KeyRotationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KeyRotationStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Rotation:   s.rotation,
+	})
+}
+
+type KeyRotationStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Rotation json.RawMessage `json:"rotation"`
+}
+
+func (s *KeyRotationStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of KeyRotationStateValue")
+
+	var u KeyRotationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e(err, "")
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var kr KeyRotation
+	if err := kr.DecodeJSON(u.Rotation, enc); err != nil {
+		return e(err, "")
+	}
+	s.rotation = kr
+
+	return nil
+}
@@ -140,7 +140,7 @@ func (opp *SuffrageInflationProcessor) Process(
 			ab = b
 		}
 
-		sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(k, mitumcurrency.NewBalanceStateValue(mitumcurrency.NewAmount(ab.Big().Add(item.Amount().Big()), item.Amount().Currency()))))
+		sts = append(sts, NewBalanceStateMergeValue(k, mitumcurrency.NewBalanceStateValue(mitumcurrency.NewAmount(ab.Big().Add(item.Amount().Big()), item.Amount().Currency()))))
 
 		if _, found := aggs[item.Amount().Currency()]; found {
 			aggs[item.Amount().Currency()] = aggs[item.Amount().Currency()].Add(item.Amount().Big())
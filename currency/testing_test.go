@@ -0,0 +1,238 @@
+package currency
+
+import (
+	"context"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+// testStates keeps the states for the processor tests; the merge values of
+// processed operations are merged into the states, so the next operation can
+// be processed on them.
+type testStates struct {
+	height base.Height
+	states map[string]base.State
+}
+
+func newTestStates(height base.Height) *testStates {
+	return &testStates{
+		height: height,
+		states: map[string]base.State{},
+	}
+}
+
+func (s *testStates) getStateFunc(key string) (base.State, bool, error) {
+	st, found := s.states[key]
+
+	return st, found, nil
+}
+
+func (s *testStates) set(key string, v base.StateValue) {
+	s.states[key] = base.NewBaseState(
+		s.height-1, key, v, valuehash.RandomSHA256(), []util.Hash{valuehash.RandomSHA256()})
+}
+
+func (s *testStates) value(key string) base.StateValue {
+	st, found := s.states[key]
+	if !found {
+		return nil
+	}
+
+	return st.Value()
+}
+
+func (s *testStates) merge(sts []base.StateMergeValue) error {
+	mergers := map[string]base.StateValueMerger{}
+
+	for i := range sts {
+		v := sts[i]
+
+		merger, found := mergers[v.Key()]
+		if !found {
+			merger = v.Merger(s.height, s.states[v.Key()])
+			mergers[v.Key()] = merger
+		}
+
+		if err := merger.Merge(v.Value(), []util.Hash{valuehash.RandomSHA256()}); err != nil {
+			return err
+		}
+	}
+
+	for k := range mergers {
+		if err := mergers[k].Close(); err != nil {
+			return err
+		}
+
+		s.set(k, mergers[k].Value())
+	}
+
+	return nil
+}
+
+// newAccount sets the account of keys; every key has weight and the account
+// needs the signs of threshold weight.
+func (s *testStates) newAccount(weights []uint, threshold uint) (base.Address, []base.Privatekey, error) {
+	privs := make([]base.Privatekey, len(weights))
+	ks := make([]mitumcurrency.AccountKey, len(weights))
+
+	for i := range weights {
+		privs[i] = base.NewMPrivatekey()
+
+		k, err := mitumcurrency.NewBaseAccountKey(privs[i].Publickey(), weights[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		ks[i] = k
+	}
+
+	keys, err := mitumcurrency.NewBaseAccountKeys(ks, threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ac, err := mitumcurrency.NewAccountFromKeys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.set(mitumcurrency.StateKeyAccount(ac.Address()), mitumcurrency.NewAccountStateValue(ac))
+
+	return ac.Address(), privs, nil
+}
+
+func (s *testStates) setBalance(a base.Address, cid mitumcurrency.CurrencyID, big int64) {
+	s.set(
+		mitumcurrency.StateKeyBalance(a, cid),
+		mitumcurrency.NewBalanceStateValue(mitumcurrency.NewAmount(mitumcurrency.NewBig(big), cid)),
+	)
+}
+
+func (s *testStates) balance(a base.Address, cid mitumcurrency.CurrencyID) mitumcurrency.Big {
+	v, ok := s.value(mitumcurrency.StateKeyBalance(a, cid)).(mitumcurrency.BalanceStateValue)
+	if !ok {
+		return mitumcurrency.ZeroBig
+	}
+
+	return v.Amount.Big()
+}
+
+// setCurrency sets the currency design of cid with policy; the genesis account
+// is random.
+func (s *testStates) setCurrency(cid mitumcurrency.CurrencyID, policy CurrencyPolicy) {
+	s.set(
+		StateKeyCurrencyDesign(cid),
+		NewCurrencyDesignStateValue(
+			NewCurrencyDesign(mitumcurrency.NewAmount(mitumcurrency.NewBig(1000000), cid), base.RandomAddress(""), policy),
+		),
+	)
+}
+
+func newTestCurrencyPolicy() CurrencyPolicy {
+	return NewCurrencyPolicy(mitumcurrency.ZeroBig, NewNilFeeer())
+}
+
+// testProcessorSuite has the helpers to process operation by GetNewProcessor.
+type testProcessorSuite struct {
+	suite.Suite
+	networkID base.NetworkID
+}
+
+func (t *testProcessorSuite) SetupTest() {
+	t.networkID = util.UUID().Bytes()
+}
+
+func (t *testProcessorSuite) preProcess(
+	f GetNewProcessor, op base.Operation, s *testStates,
+) base.OperationProcessReasonError {
+	opp, err := f(s.height, s.getStateFunc, nil, nil)
+	t.NoError(err)
+
+	_, reason, err := opp.PreProcess(context.Background(), op, s.getStateFunc)
+	t.NoError(err)
+
+	return reason
+}
+
+// process pre-processes and processes op; the merge values are merged into
+// the states when it succeeds.
+func (t *testProcessorSuite) process(
+	f GetNewProcessor, op base.Operation, s *testStates,
+) base.OperationProcessReasonError {
+	if reason := t.preProcess(f, op, s); reason != nil {
+		return reason
+	}
+
+	opp, err := f(s.height, s.getStateFunc, nil, nil)
+	t.NoError(err)
+
+	sts, reason, err := opp.Process(context.Background(), op, s.getStateFunc)
+	t.NoError(err)
+
+	if reason != nil {
+		return reason
+	}
+
+	t.NoError(s.merge(sts))
+
+	return nil
+}
+
+// newContractAccount sets the active contract account of owner; the keys of
+// contract account are not used by the processors.
+func (s *testStates) newContractAccount(owner base.Address) (base.Address, error) {
+	a, _, err := s.newAccount([]uint{100}, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	s.set(StateKeyContractAccount(a), NewContractAccountStateValue(NewContractAccount(owner, true)))
+
+	return a, nil
+}
+
+func (s *testStates) contractAccount(a base.Address) (ContractAccount, error) {
+	st, found, err := s.getStateFunc(StateKeyContractAccount(a))
+	switch {
+	case err != nil:
+		return ContractAccount{}, err
+	case !found:
+		return ContractAccount{}, errors.Errorf("contract account, %q not found", a)
+	}
+
+	return StateContractAccountValue(st)
+}
+
+func (s *testStates) currencyDesign(cid mitumcurrency.CurrencyID) (CurrencyDesign, error) {
+	st, found, err := s.getStateFunc(StateKeyCurrencyDesign(cid))
+	switch {
+	case err != nil:
+		return CurrencyDesign{}, err
+	case !found:
+		return CurrencyDesign{}, errors.Errorf("currency, %q not found", cid)
+	}
+
+	return StateCurrencyDesignValue(st)
+}
+
+// setSuffrage sets the suffrage of single node; the operations signed by the
+// node pass the suffrage threshold.
+func (s *testStates) setSuffrage() (base.Address, base.Privatekey) {
+	priv := base.NewMPrivatekey()
+	node := isaac.NewNode(priv.Publickey(), base.RandomAddress(""))
+
+	s.set(
+		isaac.SuffrageStateKey,
+		isaac.NewSuffrageNodesStateValue(
+			base.GenesisHeight,
+			[]base.SuffrageNodeStateValue{isaac.NewSuffrageNodeStateValue(node, base.GenesisHeight+1)},
+		),
+	)
+
+	return node.Address(), priv
+}
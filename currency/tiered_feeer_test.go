@@ -0,0 +1,159 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/stretchr/testify/suite"
+)
+
+type testTieredFeeer struct {
+	testProcessorSuite
+}
+
+func newTestTieredFeeer(receiver base.Address) TieredFeeer {
+	return NewTieredFeeer(receiver, []FeeTier{
+		NewFeeTier(mitumcurrency.ZeroBig, mitumcurrency.NewBig(1), 0),
+		NewFeeTier(mitumcurrency.NewBig(100), mitumcurrency.NewBig(2), 0.01),
+		NewFeeTier(mitumcurrency.NewBig(1000), mitumcurrency.ZeroBig, 0.02),
+	}, mitumcurrency.ZeroBig)
+}
+
+func (t *testTieredFeeer) TestFee() {
+	fa := newTestTieredFeeer(base.RandomAddress(""))
+	t.NoError(fa.IsValid(nil))
+
+	cases := []struct {
+		name   string
+		amount int64
+		fee    int64
+	}{
+		{name: "first tier", amount: 99, fee: 1},
+		{name: "min of second tier", amount: 100, fee: 3},
+		{name: "second tier", amount: 500, fee: 7},
+		{name: "last tier", amount: 1000, fee: 20},
+	}
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func() {
+			fee, err := fa.Fee(mitumcurrency.NewBig(c.amount))
+			t.NoError(err)
+			t.True(mitumcurrency.NewBig(c.fee).Equal(fee), "%v != %v", c.fee, fee)
+		})
+	}
+}
+
+func (t *testTieredFeeer) TestInvalidTiers() {
+	t.Run("first min not zero", func() {
+		fa := NewTieredFeeer(base.RandomAddress(""), []FeeTier{
+			NewFeeTier(mitumcurrency.NewBig(1), mitumcurrency.NewBig(1), 0),
+		}, mitumcurrency.ZeroBig)
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "min of first tier should be zero")
+	})
+
+	t.Run("not sorted", func() {
+		fa := NewTieredFeeer(base.RandomAddress(""), []FeeTier{
+			NewFeeTier(mitumcurrency.ZeroBig, mitumcurrency.NewBig(1), 0),
+			NewFeeTier(mitumcurrency.NewBig(100), mitumcurrency.NewBig(2), 0),
+			NewFeeTier(mitumcurrency.NewBig(100), mitumcurrency.NewBig(3), 0),
+		}, mitumcurrency.ZeroBig)
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "tiers should be sorted by min without duplication")
+	})
+
+	t.Run("ratio over 1", func() {
+		fa := NewTieredFeeer(base.RandomAddress(""), []FeeTier{
+			NewFeeTier(mitumcurrency.ZeroBig, mitumcurrency.NewBig(1), 1.1),
+		}, mitumcurrency.ZeroBig)
+
+		err := fa.IsValid(nil)
+		t.Error(err)
+		t.ErrorContains(err, "invalid fee tier ratio")
+	})
+}
+
+func (t *testTieredFeeer) TestTransfers() {
+	cid := mitumcurrency.CurrencyID("SHOWME")
+	states := newTestStates(base.Height(33))
+
+	sender, privs, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(sender, cid, 200)
+
+	receiver, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(receiver, cid, 0)
+
+	feeReceiver, _, err := states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	states.setBalance(feeReceiver, cid, 0)
+
+	states.setCurrency(cid, NewCurrencyPolicy(mitumcurrency.ZeroBig, newTestTieredFeeer(feeReceiver)))
+
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), sender, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			receiver,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(100), cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(privs[0], t.networkID))
+
+	t.Nil(t.process(NewTransfersProcessor(), op, states))
+
+	t.Equal(mitumcurrency.NewBig(97), states.balance(sender, cid))
+	t.Equal(mitumcurrency.NewBig(100), states.balance(receiver, cid))
+	t.Equal(mitumcurrency.NewBig(3), states.balance(feeReceiver, cid))
+}
+
+func TestTieredFeeer(t *testing.T) {
+	suite.Run(t, new(testTieredFeeer))
+}
+
+func TestTieredFeeerEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := newTestCurrencyEncoder(t)
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: TieredFeeerHint, Instance: TieredFeeer{}}))
+
+	t.Encode = func() (interface{}, []byte) {
+		fa := newTestTieredFeeer(mitumcurrency.NewAddress(util.UUID().String()))
+		t.NoError(fa.IsValid(nil))
+
+		b, err := enc.Marshal(fa)
+		t.NoError(err)
+
+		return fa, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(TieredFeeer)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(TieredFeeer)
+		t.True(ok)
+		bf, ok := b.(TieredFeeer)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+		t.Equal(af.Bytes(), bf.Bytes())
+		t.Equal(len(af.Tiers()), len(bf.Tiers()))
+	}
+
+	suite.Run(tt, t)
+}
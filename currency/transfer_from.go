@@ -0,0 +1,155 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	TransferFromFactHint = hint.MustNewHint("mitum-currency-transfer-from-operation-fact-v0.0.1")
+	TransferFromHint     = hint.MustNewHint("mitum-currency-transfer-from-operation-v0.0.1")
+)
+
+var MaxTransferFromAmounts = 10
+
+type TransferFromFact struct {
+	base.BaseFact
+	sender   base.Address
+	owner    base.Address
+	receiver base.Address
+	amounts  []mitumcurrency.Amount
+}
+
+func NewTransferFromFact(
+	token []byte,
+	sender, owner, receiver base.Address,
+	amounts []mitumcurrency.Amount,
+) TransferFromFact {
+	bf := base.NewBaseFact(TransferFromFactHint, token)
+	fact := TransferFromFact{
+		BaseFact: bf,
+		sender:   sender,
+		owner:    owner,
+		receiver: receiver,
+		amounts:  amounts,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact TransferFromFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact TransferFromFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact TransferFromFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact TransferFromFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.amounts))
+	for i := range fact.amounts {
+		bs[i] = fact.amounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.owner.Bytes(),
+		fact.receiver.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (fact TransferFromFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.owner, fact.receiver); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.owner) {
+		return util.ErrInvalid.Errorf("owner is same with sender, %q", fact.sender)
+	}
+
+	if fact.receiver.Equal(fact.owner) {
+		return util.ErrInvalid.Errorf("receiver is same with owner, %q", fact.owner)
+	}
+
+	if n := len(fact.amounts); n < 1 {
+		return util.ErrInvalid.Errorf("empty amounts")
+	} else if n > MaxTransferFromAmounts {
+		return util.ErrInvalid.Errorf("amounts, %d over max, %d", n, MaxTransferFromAmounts)
+	}
+
+	founds := map[mitumcurrency.CurrencyID]struct{}{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !am.Big().OverZero() {
+			return util.ErrInvalid.Errorf("amount should be over zero")
+		}
+
+		if _, found := founds[am.Currency()]; found {
+			return util.ErrInvalid.Errorf("duplicate currency found, %q", am.Currency())
+		}
+		founds[am.Currency()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact TransferFromFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact TransferFromFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact TransferFromFact) Receiver() base.Address {
+	return fact.receiver
+}
+
+func (fact TransferFromFact) Amounts() []mitumcurrency.Amount {
+	return fact.amounts
+}
+
+func (fact TransferFromFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.owner, fact.receiver}, nil
+}
+
+// TransferFrom moves the amounts out of the balance of owner to the receiver
+// within the allowances approved to sender by Approve; the fee is paid by
+// sender.
+type TransferFrom struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewTransferFrom(fact TransferFromFact) (TransferFrom, error) {
+	return TransferFrom{BaseOperation: mitumcurrency.NewBaseOperation(TransferFromHint, fact)}, nil
+}
+
+func (op *TransferFrom) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package currency
+
+import (
+	"encoding/json"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type TransferFromFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address           `json:"sender"`
+	Owner    base.Address           `json:"owner"`
+	Receiver base.Address           `json:"receiver"`
+	Amounts  []mitumcurrency.Amount `json:"amounts"`
+}
+
+func (fact TransferFromFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TransferFromFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Owner:                 fact.owner,
+		Receiver:              fact.receiver,
+		Amounts:               fact.amounts,
+	})
+}
+
+type TransferFromFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string          `json:"sender"`
+	Owner    string          `json:"owner"`
+	Receiver string          `json:"receiver"`
+	Amounts  json.RawMessage `json:"amounts"`
+}
+
+func (fact *TransferFromFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of TransferFromFact")
+
+	var uf TransferFromFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Owner, uf.Receiver, uf.Amounts)
+}
+
+type transferFromMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op TransferFrom) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(transferFromMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *TransferFrom) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of TransferFrom")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var transferFromProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(TransferFromProcessor)
+	},
+}
+
+func (TransferFrom) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type TransferFromProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewTransferFromProcessor() GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new TransferFromProcessor")
+
+		nopp := transferFromProcessorPool.Get()
+		opp, ok := nopp.(*TransferFromProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected TransferFromProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *TransferFromProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess TransferFrom")
+
+	fact, ok := op.Fact().(TransferFromFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected TransferFromFact, not %T", op.Fact())
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	}
+
+	if err := checkSenderSigns(op, fact.sender, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.owner), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("owner not found, %q: %w", fact.owner, err), nil
+	}
+
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.receiver), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("receiver not found, %q: %w", fact.receiver, err), nil
+	}
+
+	if err := checkActiveContractAccount(fact.receiver, getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid receiver, %q: %w", fact.receiver, err), nil
+	}
+
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		if _, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid currency, %q: %w", am.Currency(), err), nil
+		}
+
+		if _, err := checkAllowance(fact.owner, fact.sender, am, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("invalid allowance: %w", err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *TransferFromProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process TransferFrom")
+
+	fact, ok := op.Fact().(TransferFromFact)
+	if !ok {
+		return nil, nil, e(nil, "expected TransferFromFact, not %T", op.Fact())
+	}
+
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), []mitumcurrency.AmountsItem{fact})
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fees := RequiredFees(required)
+
+	// NOTE the amounts are paid by owner and the fees are paid by sender
+	srq := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+	for cid := range fees {
+		srq[cid] = [2]mitumcurrency.Big{fees[cid], fees[cid]}
+	}
+
+	sb, err := CheckEnoughDebitBalance(fact.sender, srq, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	orq := map[mitumcurrency.CurrencyID][2]mitumcurrency.Big{}
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+		orq[am.Currency()] = [2]mitumcurrency.Big{am.Big(), mitumcurrency.ZeroBig}
+	}
+
+	ob, err := CheckEnoughDebitBalance(fact.owner, orq, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance of owner: %w", err), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for i := range fact.amounts {
+		am := fact.amounts[i]
+
+		av, err := checkAllowance(fact.owner, fact.sender, am, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("invalid allowance: %w", err), nil
+		}
+
+		nav := NewAllowanceStateValue(fact.owner, fact.sender, av.Amount().WithBig(av.Amount().Big().Sub(am.Big())))
+
+		v, ok := ob[am.Currency()].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", ob[am.Currency()].Value()), nil
+		}
+
+		sts = append(sts,
+			NewAllowanceStateMergeValue(StateKeyAllowance(fact.owner, fact.sender, am.Currency()), nav),
+			NewBalanceStateMergeValue(
+				ob[am.Currency()].Key(),
+				mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(am.Big()))),
+			),
+			NewBalanceStateMergeValue(
+				mitumcurrency.StateKeyBalance(fact.receiver, am.Currency()),
+				NewAddBalanceStateValue(am),
+			),
+		)
+	}
+
+	for cid := range sb {
+		v, ok := sb[cid].Value().(mitumcurrency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb[cid].Value()), nil
+		}
+
+		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fees[cid])))
+		sts = append(sts, NewBalanceStateMergeValue(sb[cid].Key(), stv))
+	}
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
+	}
+	sts = append(sts, fsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *TransferFromProcessor) Close() error {
+	transferFromProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkAllowance returns the allowance of spender approved by owner; it fails
+// when the allowance is less than the amount.
+func checkAllowance(
+	owner, spender base.Address,
+	am mitumcurrency.Amount,
+	getStateFunc base.GetStateFunc,
+) (AllowanceStateValue, error) {
+	st, err := existsState(StateKeyAllowance(owner, spender, am.Currency()), "key of allowance", getStateFunc)
+	if err != nil {
+		return AllowanceStateValue{}, err
+	}
+
+	av, err := StateAllowanceValue(st)
+	if err != nil {
+		return AllowanceStateValue{}, err
+	}
+
+	if av.Amount().Big().Compare(am.Big()) < 0 {
+		return AllowanceStateValue{}, errors.Errorf(
+			"not enough allowance of spender, %q, %q; %v < %v", spender, am.Currency(), av.Amount().Big(), am.Big())
+	}
+
+	return av, nil
+}
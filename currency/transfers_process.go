@@ -22,6 +22,34 @@ var transfersProcessorPool = sync.Pool{
 	},
 }
 
+func (FeeExchangeTransfers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+func (SponsoredTransfers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+func (MemoTransfers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+// transfersFact is the fact of Transfers, FeeExchangeTransfers,
+// SponsoredTransfers and MemoTransfers.
+type transfersFact interface {
+	Sender() base.Address
+	Items() []mitumcurrency.TransfersItem
+}
+
 type TransfersItemProcessor struct {
 	h    util.Hash
 	item mitumcurrency.TransfersItem
@@ -35,11 +63,15 @@ func (opp *TransfersItemProcessor) PreProcess(
 		return err
 	}
 
+	if err := checkActiveContractAccount(opp.item.Receiver(), getStateFunc); err != nil {
+		return err
+	}
+
 	rb := map[mitumcurrency.CurrencyID]base.StateMergeValue{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		_, err := existsCurrencyPolicy(am.Currency(), getStateFunc)
+		_, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
 		if err != nil {
 			return err
 		}
@@ -54,7 +86,7 @@ func (opp *TransfersItemProcessor) PreProcess(
 			return err
 		}
 
-		rb[am.Currency()] = mitumcurrency.NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(balance))
+		rb[am.Currency()] = NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(balance))
 	}
 
 	opp.rb = rb
@@ -73,7 +105,7 @@ func (opp *TransfersItemProcessor) Process(
 			return nil, errors.Errorf("expect BalanceStateValue, not %T", opp.rb[am.Currency()].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(am.Big())))
-		sts[i] = mitumcurrency.NewBalanceStateMergeValue(opp.rb[am.Currency()].Key(), stv)
+		sts[i] = NewBalanceStateMergeValue(opp.rb[am.Currency()].Key(), stv)
 	}
 
 	return sts, nil
@@ -125,7 +157,7 @@ func (opp *TransfersProcessor) PreProcess(
 ) (context.Context, base.OperationProcessReasonError, error) {
 	e := util.StringErrorFunc("failed to preprocess Transfers")
 
-	fact, ok := op.Fact().(mitumcurrency.TransfersFact)
+	fact, ok := op.Fact().(transfersFact)
 	if !ok {
 		return ctx, nil, e(nil, "expected TransfersFact, not %T", op.Fact())
 	}
@@ -138,7 +170,7 @@ func (opp *TransfersProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot transfer amounts, %q: %w", fact.Sender(), err), nil
 	}
 
-	if err := checkFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+	if err := checkSenderSigns(op, fact.Sender(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
@@ -151,7 +183,7 @@ func (opp *TransfersProcessor) Process( // nolint:dupl
 ) {
 	e := util.StringErrorFunc("failed to process Transfers")
 
-	fact, ok := op.Fact().(mitumcurrency.TransfersFact)
+	fact, ok := op.Fact().(transfersFact)
 	if !ok {
 		return nil, nil, e(nil, "expected TransfersFact, not %T", op.Fact())
 	}
@@ -161,7 +193,14 @@ func (opp *TransfersProcessor) Process( // nolint:dupl
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	sb, err := CheckEnoughBalance(fact.Sender(), required, getStateFunc)
+	fees := RequiredFees(required)
+
+	required, psts, err := PayFeeByFeePayer(feePayerOf(op.Fact()), required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee by fee payer: %w", err), nil
+	}
+
+	sb, err := CheckEnoughDebitBalance(fact.Sender(), required, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
 	}
@@ -200,8 +239,16 @@ func (opp *TransfersProcessor) Process( // nolint:dupl
 			return nil, base.NewBaseOperationProcessReasonError("failed to process transfer"), nil
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(rq[0])))
-		sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(sb[k].Key(), stv))
+		sts = append(sts, NewBalanceStateMergeValue(sb[k].Key(), stv))
+	}
+
+	sts = append(sts, psts...)
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
 	}
+	sts = append(sts, fsts...)
 
 	return sts, nil, nil
 }
@@ -213,7 +260,7 @@ func (opp *TransfersProcessor) Close() error {
 }
 
 func (opp *TransfersProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
-	fact, ok := op.Fact().(mitumcurrency.TransfersFact)
+	fact, ok := op.Fact().(transfersFact)
 	if !ok {
 		return nil, errors.Errorf("expected TransfersFact, not %T", op.Fact())
 	}
@@ -222,5 +269,14 @@ func (opp *TransfersProcessor) calculateItemsFee(op base.Operation, getStateFunc
 		items[i] = fact.Items()[i]
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), items)
+	if err != nil {
+		return nil, err
+	}
+
+	if fe, ok := op.Fact().(FeeExchangeFact); ok {
+		return ExchangeItemsFee(getStateFunc, factHint(op.Fact()), required, fe.FeeCurrency())
+	}
+
+	return required, nil
 }
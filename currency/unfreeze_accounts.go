@@ -0,0 +1,88 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UnfreezeAccountsFactHint = hint.MustNewHint("mitum-currency-unfreeze-accounts-operation-fact-v0.0.1")
+	UnfreezeAccountsHint     = hint.MustNewHint("mitum-currency-unfreeze-accounts-operation-v0.0.1")
+)
+
+type UnfreezeAccountsFact struct {
+	base.BaseFact
+	accounts []base.Address
+	currency mitumcurrency.CurrencyID
+}
+
+func NewUnfreezeAccountsFact(token []byte, accounts []base.Address, currency mitumcurrency.CurrencyID) UnfreezeAccountsFact {
+	fact := UnfreezeAccountsFact{
+		BaseFact: base.NewBaseFact(UnfreezeAccountsFactHint, token),
+		accounts: accounts,
+		currency: currency,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UnfreezeAccountsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UnfreezeAccountsFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.accounts))
+	for i := range fact.accounts {
+		bs[i] = fact.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UnfreezeAccountsFact) IsValid(b []byte) error {
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := isValidFreezeAccounts(fact.accounts, fact.currency); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %w", err)
+	}
+
+	return nil
+}
+
+func (fact UnfreezeAccountsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnfreezeAccountsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UnfreezeAccountsFact) Accounts() []base.Address {
+	return fact.accounts
+}
+
+// Currency returns the unfrozen currency; empty currency means all the
+// currencies of accounts.
+func (fact UnfreezeAccountsFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+// UnfreezeAccounts unfreezes the frozen accounts by suffrage.
+type UnfreezeAccounts struct {
+	mitumcurrency.BaseNodeOperation
+}
+
+func NewUnfreezeAccounts(fact UnfreezeAccountsFact) (UnfreezeAccounts, error) {
+	return UnfreezeAccounts{BaseNodeOperation: mitumcurrency.NewBaseNodeOperation(UnfreezeAccountsHint, fact)}, nil
+}
@@ -0,0 +1,21 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UnfreezeAccountsFact) unpack(enc encoder.Encoder, acs []string, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal UnfreezeAccountsFact")
+
+	accounts, err := decodeFreezeAccounts(enc, acs)
+	if err != nil {
+		return e(err, "")
+	}
+	fact.accounts = accounts
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UnfreezeAccountsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Accounts []base.Address           `json:"accounts"`
+	Currency mitumcurrency.CurrencyID `json:"currency,omitempty"`
+}
+
+func (fact UnfreezeAccountsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UnfreezeAccountsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Accounts:              fact.accounts,
+		Currency:              fact.currency,
+	})
+}
+
+type UnfreezeAccountsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Accounts []string `json:"accounts"`
+	Currency string   `json:"currency"`
+}
+
+func (fact *UnfreezeAccountsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UnfreezeAccountsFact")
+
+	var uf UnfreezeAccountsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Accounts, uf.Currency)
+}
+
+type unfreezeAccountsMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op UnfreezeAccounts) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(unfreezeAccountsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UnfreezeAccounts) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UnfreezeAccounts")
+
+	var ubo mitumcurrency.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
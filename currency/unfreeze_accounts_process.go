@@ -0,0 +1,137 @@
+package currency
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var unfreezeAccountsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UnfreezeAccountsProcessor)
+	},
+}
+
+func (UnfreezeAccounts) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+type UnfreezeAccountsProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewUnfreezeAccountsProcessor(threshold base.Threshold) GetNewProcessor {
+	return func(height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringErrorFunc("failed to create new UnfreezeAccountsProcessor")
+
+		nopp := unfreezeAccountsProcessorPool.Get()
+		opp, ok := nopp.(*UnfreezeAccountsProcessor)
+		if !ok {
+			return nil, e(nil, "expected UnfreezeAccountsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e(err, "")
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e(err, "")
+		case !found, i == nil:
+			return nil, e(isaac.ErrStopProcessingRetry.Errorf("empty state"), "")
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"), "")
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *UnfreezeAccountsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringErrorFunc("failed to preprocess UnfreezeAccounts")
+
+	nop, ok := op.(UnfreezeAccounts)
+	if !ok {
+		return ctx, nil, e(nil, "expected UnfreezeAccounts, not %T", op)
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not enough signs: %w", err), nil
+	}
+
+	fact, ok := op.Fact().(UnfreezeAccountsFact)
+	if !ok {
+		return ctx, nil, e(nil, "expected UnfreezeAccountsFact, not %T", op.Fact())
+	}
+
+	if err := checkFreezeFact(fact, getStateFunc); err != nil {
+		return ctx, err, nil
+	}
+
+	for i := range fact.accounts {
+		a := fact.accounts[i]
+
+		st, err := existsState(StateKeyFreeze(a, fact.currency), "key of freeze", getStateFunc)
+		if err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("account not frozen, %q: %w", a, err), nil
+		}
+
+		switch fv, err := StateFreezeValue(st); {
+		case err != nil:
+			return ctx, base.NewBaseOperationProcessReasonError("failed to get freeze value, %q: %w", a, err), nil
+		case !fv.Frozen():
+			return ctx, base.NewBaseOperationProcessReasonError("account not frozen, %q", a), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UnfreezeAccountsProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringErrorFunc("failed to process UnfreezeAccounts")
+
+	fact, ok := op.Fact().(UnfreezeAccountsFact)
+	if !ok {
+		return nil, nil, e(nil, "expected UnfreezeAccountsFact, not %T", op.Fact())
+	}
+
+	return freezeStates(fact, false), nil, nil
+}
+
+func (opp *UnfreezeAccountsProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	unfreezeAccountsProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateContractAccountOwnersFactHint = hint.MustNewHint("mitum-currency-update-contract-account-owners-operation-fact-v0.0.1")
+	UpdateContractAccountOwnersHint     = hint.MustNewHint("mitum-currency-update-contract-account-owners-operation-v0.0.1")
+)
+
+type UpdateContractAccountOwnersFact struct {
+	base.BaseFact
+	sender    base.Address
+	contract  base.Address
+	owners    []base.Address
+	threshold uint
+	currency  mitumcurrency.CurrencyID
+}
+
+func NewUpdateContractAccountOwnersFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	owners []base.Address,
+	threshold uint,
+	currency mitumcurrency.CurrencyID,
+) UpdateContractAccountOwnersFact {
+	bf := base.NewBaseFact(UpdateContractAccountOwnersFactHint, token)
+	fact := UpdateContractAccountOwnersFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		owners:    owners,
+		threshold: threshold,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateContractAccountOwnersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateContractAccountOwnersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateContractAccountOwnersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateContractAccountOwnersFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.owners))
+	for i := range fact.owners {
+		bs[i] = fact.owners[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		util.UintToBytes(fact.threshold),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateContractAccountOwnersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract account is same with sender, %q", fact.sender)
+	}
+
+	switch n := len(fact.owners); {
+	case n < 1:
+		if fact.threshold != 0 {
+			return util.ErrInvalid.Errorf("threshold without owners, %d", fact.threshold)
+		}
+
+		return nil
+	case n > MaxContractAccountOwners:
+		return util.ErrInvalid.Errorf("owners, %d over max, %d", n, MaxContractAccountOwners)
+	case fact.threshold < 1 || fact.threshold > uint(n):
+		return util.ErrInvalid.Errorf("invalid threshold, %d for %d owners", fact.threshold, n)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range fact.owners {
+		ow := fact.owners[i]
+		if err := ow.IsValid(nil); err != nil {
+			return err
+		}
+
+		switch _, found := founds[ow.String()]; {
+		case found:
+			return util.ErrInvalid.Errorf("duplicate owner found, %q", ow)
+		case fact.contract.Equal(ow):
+			return util.ErrInvalid.Errorf("owner is same with contract account, %q", fact.contract)
+		default:
+			founds[ow.String()] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (fact UpdateContractAccountOwnersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateContractAccountOwnersFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateContractAccountOwnersFact) Owners() []base.Address {
+	return fact.owners
+}
+
+func (fact UpdateContractAccountOwnersFact) Threshold() uint {
+	return fact.threshold
+}
+
+func (fact UpdateContractAccountOwnersFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateContractAccountOwnersFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.owners)+2)
+	copy(as, fact.owners)
+
+	as[len(fact.owners)] = fact.sender
+	as[len(fact.owners)+1] = fact.contract
+
+	return as, nil
+}
+
+type UpdateContractAccountOwners struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewUpdateContractAccountOwners(fact UpdateContractAccountOwnersFact) (UpdateContractAccountOwners, error) {
+	return UpdateContractAccountOwners{BaseOperation: mitumcurrency.NewBaseOperation(UpdateContractAccountOwnersHint, fact)}, nil
+}
+
+func (op *UpdateContractAccountOwners) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
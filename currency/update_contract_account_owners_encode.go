@@ -0,0 +1,42 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateContractAccountOwnersFact) unpack(enc encoder.Encoder, sd, ct string, ows []string, th uint, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal UpdateContractAccountOwnersFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ct, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.contract = a
+	}
+
+	owners := make([]base.Address, len(ows))
+	for i := range ows {
+		a, err := base.DecodeAddress(ows[i], enc)
+		if err != nil {
+			return e(err, "")
+		}
+
+		owners[i] = a
+	}
+	fact.owners = owners
+	fact.threshold = th
+
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
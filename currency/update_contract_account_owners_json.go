@@ -0,0 +1,73 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateContractAccountOwnersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender    base.Address             `json:"sender"`
+	Contract  base.Address             `json:"contract"`
+	Owners    []base.Address           `json:"owners"`
+	Threshold uint                     `json:"threshold"`
+	Currency  mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateContractAccountOwnersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateContractAccountOwnersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		Owners:                fact.owners,
+		Threshold:             fact.threshold,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateContractAccountOwnersFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender    string   `json:"sender"`
+	Contract  string   `json:"contract"`
+	Owners    []string `json:"owners"`
+	Threshold uint     `json:"threshold"`
+	Currency  string   `json:"currency"`
+}
+
+func (fact *UpdateContractAccountOwnersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UpdateContractAccountOwnersFact")
+
+	var uf UpdateContractAccountOwnersFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Owners, uf.Threshold, uf.Currency)
+}
+
+type updateContractAccountOwnersMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op UpdateContractAccountOwners) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(updateContractAccountOwnersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateContractAccountOwners) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UpdateContractAccountOwners")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
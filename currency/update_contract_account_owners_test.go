@@ -0,0 +1,185 @@
+package currency
+
+import (
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testUpdateContractAccountOwners struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	owners   []base.Address
+	privs    []base.Privatekey
+	contract base.Address
+}
+
+func (t *testUpdateContractAccountOwners) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	t.owners = make([]base.Address, 3)
+	t.privs = make([]base.Privatekey, 3)
+
+	for i := range t.owners {
+		a, privs, err := t.states.newAccount([]uint{100}, 100)
+		t.NoError(err)
+		t.states.setBalance(a, t.cid, 100)
+
+		t.owners[i], t.privs[i] = a, privs[0]
+	}
+
+	contract, err := t.states.newContractAccount(t.owners[0])
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	t.contract = contract
+}
+
+func (t *testUpdateContractAccountOwners) update(
+	owners []base.Address, threshold uint, privs ...base.Privatekey,
+) UpdateContractAccountOwners {
+	op, err := NewUpdateContractAccountOwners(
+		NewUpdateContractAccountOwnersFact(util.UUID().Bytes(), t.owners[0], t.contract, owners, threshold, t.cid))
+	t.NoError(err)
+
+	for i := range privs {
+		t.NoError(op.HashSign(privs[i], t.networkID))
+	}
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testUpdateContractAccountOwners) withdraws(sender base.Address, privs ...base.Privatekey) Withdraws {
+	op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), sender, []WithdrawsItem{
+		NewWithdrawsItemMultiAmounts(
+			t.contract,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), t.cid)},
+		),
+	}))
+	t.NoError(err)
+
+	for i := range privs {
+		t.NoError(op.HashSign(privs[i], t.networkID))
+	}
+
+	return op
+}
+
+func (t *testUpdateContractAccountOwners) TestThreshold() {
+	t.Nil(t.process(NewUpdateContractAccountOwnersProcessor(), t.update(t.owners[:2], 2, t.privs[0]), t.states))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.True(ca.IsMultiOwners())
+	t.Equal(uint(2), ca.Threshold())
+
+	t.Run("below threshold", func() {
+		reason := t.process(NewWithdrawsProcessor(), t.withdraws(t.owners[0], t.privs[0]), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "not enough signs of contract account owners")
+	})
+
+	t.Run("not in owners", func() {
+		reason := t.process(NewWithdrawsProcessor(), t.withdraws(t.owners[2], t.privs[2], t.privs[0]), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "neither owner nor operator")
+	})
+
+	t.Nil(t.process(NewWithdrawsProcessor(), t.withdraws(t.owners[1], t.privs[1], t.privs[0]), t.states))
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.contract, t.cid))
+	t.Equal(mitumcurrency.NewBig(110), t.states.balance(t.owners[1], t.cid))
+
+	t.Run("update owners below threshold", func() {
+		reason := t.preProcess(NewUpdateContractAccountOwnersProcessor(), t.update(nil, 0, t.privs[0]), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "not enough signs of contract account owners")
+	})
+
+	// NOTE back to the single owner
+	t.Nil(t.process(NewUpdateContractAccountOwnersProcessor(), t.update(nil, 0, t.privs[0], t.privs[1]), t.states))
+
+	ca, err = t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.False(ca.IsMultiOwners())
+	t.True(ca.Owner().Equal(t.owners[0]))
+
+	t.Nil(t.process(NewWithdrawsProcessor(), t.withdraws(t.owners[0], t.privs[0]), t.states))
+}
+
+func (t *testUpdateContractAccountOwners) TestOwnerNotInOwners() {
+	reason := t.process(NewUpdateContractAccountOwnersProcessor(), t.update(t.owners[1:], 1, t.privs[0]), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "not in owners")
+}
+
+func TestUpdateContractAccountOwners(t *testing.T) {
+	suite.Run(t, new(testUpdateContractAccountOwners))
+}
+
+func TestUpdateContractAccountOwnersFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: UpdateContractAccountOwnersFactHint, Instance: UpdateContractAccountOwnersFact{},
+		}))
+
+		sender := mitumcurrency.NewAddress(util.UUID().String())
+
+		fact := NewUpdateContractAccountOwnersFact(
+			util.UUID().Bytes(),
+			sender,
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]base.Address{sender, mitumcurrency.NewAddress(util.UUID().String())},
+			2,
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(UpdateContractAccountOwnersFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(UpdateContractAccountOwnersFact)
+		t.True(ok)
+		bf, ok := b.(UpdateContractAccountOwnersFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.Threshold(), bf.Threshold())
+		t.Equal(len(af.Owners()), len(bf.Owners()))
+
+		for i := range af.Owners() {
+			t.True(af.Owners()[i].Equal(bf.Owners()[i]))
+		}
+	}
+
+	suite.Run(tt, t)
+}
@@ -0,0 +1,125 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateContractAccountStatusFactHint = hint.MustNewHint("mitum-currency-update-contract-account-status-operation-fact-v0.0.1")
+	UpdateContractAccountStatusHint     = hint.MustNewHint("mitum-currency-update-contract-account-status-operation-v0.0.1")
+)
+
+type UpdateContractAccountStatusFact struct {
+	base.BaseFact
+	sender   base.Address
+	target   base.Address
+	isActive bool
+	currency mitumcurrency.CurrencyID
+}
+
+func NewUpdateContractAccountStatusFact(
+	token []byte,
+	sender base.Address,
+	target base.Address,
+	isActive bool,
+	currency mitumcurrency.CurrencyID,
+) UpdateContractAccountStatusFact {
+	bf := base.NewBaseFact(UpdateContractAccountStatusFactHint, token)
+	fact := UpdateContractAccountStatusFact{
+		BaseFact: bf,
+		sender:   sender,
+		target:   target,
+		isActive: isActive,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateContractAccountStatusFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateContractAccountStatusFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateContractAccountStatusFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateContractAccountStatusFact) Bytes() []byte {
+	var v int8
+	if fact.isActive {
+		v = 1
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.target.Bytes(),
+		[]byte{byte(v)},
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateContractAccountStatusFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := mitumcurrency.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.target, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.target) {
+		return util.ErrInvalid.Errorf("target is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact UpdateContractAccountStatusFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateContractAccountStatusFact) Target() base.Address {
+	return fact.target
+}
+
+func (fact UpdateContractAccountStatusFact) IsActive() bool {
+	return fact.isActive
+}
+
+func (fact UpdateContractAccountStatusFact) Currency() mitumcurrency.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateContractAccountStatusFact) Addresses() ([]base.Address, error) {
+	return []base.Address{fact.sender, fact.target}, nil
+}
+
+type UpdateContractAccountStatus struct {
+	mitumcurrency.BaseOperation
+}
+
+func NewUpdateContractAccountStatus(fact UpdateContractAccountStatusFact) (UpdateContractAccountStatus, error) {
+	return UpdateContractAccountStatus{BaseOperation: mitumcurrency.NewBaseOperation(UpdateContractAccountStatusHint, fact)}, nil
+}
+
+func (op *UpdateContractAccountStatus) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
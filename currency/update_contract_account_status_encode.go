@@ -0,0 +1,31 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateContractAccountStatusFact) unpack(enc encoder.Encoder, sd, tg string, ia bool, cid string) error {
+	e := util.StringErrorFunc("failed to unmarshal UpdateContractAccountStatusFact")
+
+	switch a, err := base.DecodeAddress(sd, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(tg, enc); {
+	case err != nil:
+		return e(err, "")
+	default:
+		fact.target = a
+	}
+
+	fact.isActive = ia
+	fact.currency = mitumcurrency.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package currency
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateContractAccountStatusFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Target   base.Address             `json:"target"`
+	IsActive bool                     `json:"isactive"`
+	Currency mitumcurrency.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateContractAccountStatusFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateContractAccountStatusFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Target:                fact.target,
+		IsActive:              fact.isActive,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateContractAccountStatusFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Target   string `json:"target"`
+	IsActive bool   `json:"isactive"`
+	Currency string `json:"currency"`
+}
+
+func (fact *UpdateContractAccountStatusFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UpdateContractAccountStatusFact")
+
+	var uf UpdateContractAccountStatusFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e(err, "")
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Target, uf.IsActive, uf.Currency)
+}
+
+type updateContractAccountStatusMarshaler struct {
+	mitumcurrency.BaseOperationJSONMarshaler
+}
+
+func (op UpdateContractAccountStatus) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(updateContractAccountStatusMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateContractAccountStatus) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of UpdateContractAccountStatus")
+
+	var ubo mitumcurrency.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e(err, "")
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package currency
+
+import (
+	"fmt"
+	"testing"
+
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type testUpdateContractAccountStatus struct {
+	testProcessorSuite
+	cid      mitumcurrency.CurrencyID
+	states   *testStates
+	owner    base.Address
+	priv     base.Privatekey
+	contract base.Address
+}
+
+func (t *testUpdateContractAccountStatus) SetupTest() {
+	t.testProcessorSuite.SetupTest()
+
+	t.cid = mitumcurrency.CurrencyID("SHOWME")
+	t.states = newTestStates(base.Height(33))
+	t.states.setCurrency(t.cid, newTestCurrencyPolicy())
+
+	owner, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(owner, t.cid, 100)
+
+	contract, err := t.states.newContractAccount(owner)
+	t.NoError(err)
+	t.states.setBalance(contract, t.cid, 100)
+
+	t.owner, t.priv, t.contract = owner, privs[0], contract
+}
+
+func (t *testUpdateContractAccountStatus) update(
+	sender base.Address, priv base.Privatekey, isActive bool,
+) UpdateContractAccountStatus {
+	op, err := NewUpdateContractAccountStatus(
+		NewUpdateContractAccountStatusFact(util.UUID().Bytes(), sender, t.contract, isActive, t.cid))
+	t.NoError(err)
+	t.NoError(op.HashSign(priv, t.networkID))
+	t.NoError(op.IsValid(t.networkID))
+
+	return op
+}
+
+func (t *testUpdateContractAccountStatus) withdraws(big int64) Withdraws {
+	op, err := NewWithdraws(NewWithdrawsFact(util.UUID().Bytes(), t.owner, []WithdrawsItem{
+		NewWithdrawsItemMultiAmounts(
+			t.contract,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testUpdateContractAccountStatus) transfers(big int64) mitumcurrency.Transfers {
+	op, err := mitumcurrency.NewTransfers(mitumcurrency.NewTransfersFact(util.UUID().Bytes(), t.owner, []mitumcurrency.TransfersItem{
+		mitumcurrency.NewTransfersItemMultiAmounts(
+			t.contract,
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(big), t.cid)},
+		),
+	}))
+	t.NoError(err)
+	t.NoError(op.HashSign(t.priv, t.networkID))
+
+	return op
+}
+
+func (t *testUpdateContractAccountStatus) TestDeactivate() {
+	t.Nil(t.process(NewUpdateContractAccountStatusProcessor(), t.update(t.owner, t.priv, false), t.states))
+
+	ca, err := t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.False(ca.IsActive())
+
+	t.Run("withdraw from deactivated", func() {
+		reason := t.process(NewWithdrawsProcessor(), t.withdraws(10), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "is deactivated")
+	})
+
+	t.Run("transfer to deactivated", func() {
+		reason := t.process(NewTransfersProcessor(), t.transfers(10), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "is deactivated")
+	})
+
+	t.Run("deactivate again", func() {
+		reason := t.preProcess(NewUpdateContractAccountStatusProcessor(), t.update(t.owner, t.priv, false), t.states)
+		t.NotNil(reason)
+		t.ErrorContains(reason, "contract account status is already false")
+	})
+
+	t.Nil(t.process(NewUpdateContractAccountStatusProcessor(), t.update(t.owner, t.priv, true), t.states))
+
+	ca, err = t.states.contractAccount(t.contract)
+	t.NoError(err)
+	t.True(ca.IsActive())
+
+	t.Nil(t.process(NewWithdrawsProcessor(), t.withdraws(10), t.states))
+	t.Equal(mitumcurrency.NewBig(90), t.states.balance(t.contract, t.cid))
+	t.Equal(mitumcurrency.NewBig(110), t.states.balance(t.owner, t.cid))
+}
+
+func (t *testUpdateContractAccountStatus) TestNotOwner() {
+	other, privs, err := t.states.newAccount([]uint{100}, 100)
+	t.NoError(err)
+	t.states.setBalance(other, t.cid, 100)
+
+	reason := t.preProcess(NewUpdateContractAccountStatusProcessor(), t.update(other, privs[0], false), t.states)
+	t.NotNil(reason)
+	t.ErrorContains(reason, "contract account owner is not matched")
+}
+
+func TestUpdateContractAccountStatus(t *testing.T) {
+	suite.Run(t, new(testUpdateContractAccountStatus))
+}
+
+func TestUpdateContractAccountStatusFactEncode(tt *testing.T) {
+	t := new(encoder.BaseTestEncode)
+
+	enc := jsonenc.NewEncoder()
+
+	t.Encode = func() (interface{}, []byte) {
+		t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+		t.NoError(enc.Add(encoder.DecodeDetail{
+			Hint: UpdateContractAccountStatusFactHint, Instance: UpdateContractAccountStatusFact{},
+		}))
+
+		fact := NewUpdateContractAccountStatusFact(
+			util.UUID().Bytes(),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			mitumcurrency.NewAddress(util.UUID().String()),
+			false,
+			mitumcurrency.CurrencyID("SHOWME"),
+		)
+		t.NoError(fact.IsValid(nil))
+
+		b, err := enc.Marshal(fact)
+		t.NoError(err)
+
+		return fact, b
+	}
+	t.Decode = func(b []byte) interface{} {
+		i, err := enc.Decode(b)
+		t.NoError(err)
+
+		_, ok := i.(UpdateContractAccountStatusFact)
+		t.True(ok)
+
+		return i
+	}
+	t.Compare = func(a, b interface{}) {
+		af, ok := a.(UpdateContractAccountStatusFact)
+		t.True(ok)
+		bf, ok := b.(UpdateContractAccountStatusFact)
+		t.True(ok)
+
+		t.NoError(bf.IsValid(nil))
+
+		base.EqualFact(t.Assert(), af, bf)
+		t.Equal(af.IsActive(), bf.IsActive())
+	}
+
+	suite.Run(tt, t)
+}
+
+func TestContractAccountV001Decode(tt *testing.T) {
+	t := new(suite.Suite)
+	t.SetT(tt)
+
+	enc := jsonenc.NewEncoder()
+	t.NoError(enc.Add(encoder.DecodeDetail{Hint: mitumcurrency.AddressHint, Instance: mitumcurrency.Address{}}))
+
+	owner := mitumcurrency.NewAddress(util.UUID().String())
+
+	// NOTE the legacy contract account has no operators and owners
+	b := []byte(fmt.Sprintf(`{"_hint":%q,"isactive":false,"owner":%q}`, ContractAccountV001Hint.String(), owner.String()))
+
+	var ca ContractAccount
+	t.NoError(ca.DecodeJSON(b, enc))
+	t.NoError(ca.IsValid(nil))
+
+	t.Equal(ContractAccountV001Hint.String(), ca.Hint().String())
+	t.True(ca.Owner().Equal(owner))
+	t.False(ca.IsActive())
+	t.Empty(ca.Operators())
+	t.False(ca.IsMultiOwners())
+
+	t.Run("migrated by operators", func() {
+		operator := NewContractAccountOperator(
+			mitumcurrency.NewAddress(util.UUID().String()),
+			[]mitumcurrency.Amount{mitumcurrency.NewAmount(mitumcurrency.NewBig(10), mitumcurrency.CurrencyID("SHOWME"))},
+		)
+
+		nca, err := ca.SetOperator(operator)
+		t.NoError(err)
+		t.Equal(ContractAccountHint.String(), nca.Hint().String())
+		t.Equal(1, len(nca.Operators()))
+	})
+}
@@ -0,0 +1,129 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var VestingLockHint = hint.MustNewHint("mitum-currency-vesting-lock-v0.0.1")
+
+var MaxVestingLocks = 20
+
+// VestingLock is the amount locked until it is released by block height. The
+// amount is released linearly from start to end; if start is same with end,
+// whole amount is released at end like cliff. Claimed is the released amount
+// already moved to the balance by ClaimVested.
+type VestingLock struct {
+	hint.BaseHinter
+	amount  mitumcurrency.Big
+	claimed mitumcurrency.Big
+	start   base.Height
+	end     base.Height
+}
+
+func NewVestingLock(amount mitumcurrency.Big, start, end base.Height) VestingLock {
+	return VestingLock{
+		BaseHinter: hint.NewBaseHinter(VestingLockHint),
+		amount:     amount,
+		claimed:    mitumcurrency.ZeroBig,
+		start:      start,
+		end:        end,
+	}
+}
+
+func (vl VestingLock) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		vl.amount.Bytes(),
+		vl.claimed.Bytes(),
+		vl.start.Bytes(),
+		vl.end.Bytes(),
+	)
+}
+
+func (vl VestingLock) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, vl.BaseHinter, vl.start, vl.end); err != nil {
+		return util.ErrInvalid.Errorf("invalid vesting lock: %w", err)
+	}
+
+	if !vl.amount.OverZero() {
+		return util.ErrInvalid.Errorf("vesting lock amount should be over zero")
+	}
+
+	if !vl.claimed.OverNil() {
+		return util.ErrInvalid.Errorf("vesting lock claimed under zero")
+	}
+
+	if vl.claimed.Compare(vl.amount) > 0 {
+		return util.ErrInvalid.Errorf("vesting lock claimed over amount, %v > %v", vl.claimed, vl.amount)
+	}
+
+	if vl.start > vl.end {
+		return util.ErrInvalid.Errorf("vesting lock start over end, %d > %d", vl.start, vl.end)
+	}
+
+	return nil
+}
+
+func (vl VestingLock) Amount() mitumcurrency.Big {
+	return vl.amount
+}
+
+func (vl VestingLock) Claimed() mitumcurrency.Big {
+	return vl.claimed
+}
+
+func (vl VestingLock) Start() base.Height {
+	return vl.start
+}
+
+func (vl VestingLock) End() base.Height {
+	return vl.end
+}
+
+func (vl VestingLock) IsCliff() bool {
+	return vl.start == vl.end
+}
+
+// Released returns the amount released at the height, including the claimed.
+func (vl VestingLock) Released(height base.Height) mitumcurrency.Big {
+	switch {
+	case height >= vl.end:
+		return vl.amount
+	case height < vl.start, vl.IsCliff():
+		return mitumcurrency.ZeroBig
+	default:
+		return vl.amount.
+			Mul(mitumcurrency.NewBig(int64(height - vl.start))).
+			Div(mitumcurrency.NewBig(int64(vl.end - vl.start)))
+	}
+}
+
+// Claimable returns the released amount at the height, which is not claimed
+// yet.
+func (vl VestingLock) Claimable(height base.Height) mitumcurrency.Big {
+	return vl.Released(height).Sub(vl.claimed)
+}
+
+// Locked returns the amount which is not released yet at the height.
+func (vl VestingLock) Locked(height base.Height) mitumcurrency.Big {
+	return vl.amount.Sub(vl.Released(height))
+}
+
+func (vl VestingLock) IsClaimedAll() bool {
+	return vl.claimed.Compare(vl.amount) >= 0
+}
+
+// Claim claims the claimable amount at the height.
+func (vl VestingLock) Claim(height base.Height) (VestingLock, mitumcurrency.Big, error) {
+	k := vl.Claimable(height)
+	if !k.OverZero() {
+		return vl, mitumcurrency.ZeroBig, errors.Errorf("nothing to claim")
+	}
+
+	vl.claimed = vl.claimed.Add(k)
+
+	return vl, k, nil
+}
@@ -0,0 +1,45 @@
+package currency // nolint: dupl, revive
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (vl VestingLock) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   vl.Hint().String(),
+			"amount":  vl.amount.String(),
+			"claimed": vl.claimed.String(),
+			"start":   vl.start,
+			"end":     vl.end,
+		},
+	)
+}
+
+type VestingLockBSONUnmarshaler struct {
+	Hint    string      `bson:"_hint"`
+	Amount  string      `bson:"amount"`
+	Claimed string      `bson:"claimed"`
+	Start   base.Height `bson:"start"`
+	End     base.Height `bson:"end"`
+}
+
+func (vl *VestingLock) DecodeBSON(b []byte, _ *bsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode bson of VestingLock")
+
+	var uvl VestingLockBSONUnmarshaler
+	if err := bsonenc.Unmarshal(b, &uvl); err != nil {
+		return e(err, "")
+	}
+
+	ht, err := hint.ParseHint(uvl.Hint)
+	if err != nil {
+		return e(err, "")
+	}
+
+	return vl.unpack(ht, uvl.Amount, uvl.Claimed, uvl.Start, uvl.End)
+}
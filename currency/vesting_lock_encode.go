@@ -0,0 +1,37 @@
+package currency // nolint: dupl, revive
+
+import (
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (vl *VestingLock) unpack(
+	ht hint.Hint,
+	am string,
+	cl string,
+	start base.Height,
+	end base.Height,
+) error {
+	e := util.StringErrorFunc("failed to unmarshal VestingLock")
+
+	vl.BaseHinter = hint.NewBaseHinter(ht)
+
+	if big, err := mitumcurrency.NewBigFromString(am); err != nil {
+		return e(err, "failed to decode amount")
+	} else {
+		vl.amount = big
+	}
+
+	if big, err := mitumcurrency.NewBigFromString(cl); err != nil {
+		return e(err, "failed to decode claimed")
+	} else {
+		vl.claimed = big
+	}
+
+	vl.start = start
+	vl.end = end
+
+	return nil
+}
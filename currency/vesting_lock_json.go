@@ -0,0 +1,45 @@
+package currency
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type VestingLockJSONMarshaler struct {
+	hint.BaseHinter
+	Amount  string      `json:"amount"`
+	Claimed string      `json:"claimed"`
+	Start   base.Height `json:"start"`
+	End     base.Height `json:"end"`
+}
+
+func (vl VestingLock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VestingLockJSONMarshaler{
+		BaseHinter: vl.BaseHinter,
+		Amount:     vl.amount.String(),
+		Claimed:    vl.claimed.String(),
+		Start:      vl.start,
+		End:        vl.end,
+	})
+}
+
+type VestingLockJSONUnmarshaler struct {
+	Hint    hint.Hint   `json:"_hint"`
+	Amount  string      `json:"amount"`
+	Claimed string      `json:"claimed"`
+	Start   base.Height `json:"start"`
+	End     base.Height `json:"end"`
+}
+
+func (vl *VestingLock) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringErrorFunc("failed to decode json of VestingLock")
+
+	var uvl VestingLockJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uvl); err != nil {
+		return e(err, "")
+	}
+
+	return vl.unpack(uvl.Hint, uvl.Amount, uvl.Claimed, uvl.Start, uvl.End)
+}
@@ -29,11 +29,33 @@ func (Withdraws) Process(
 	return nil, nil, nil
 }
 
+func (FeeExchangeWithdraws) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+func (SponsoredWithdraws) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	// NOTE Process is nil func
+	return nil, nil, nil
+}
+
+// withdrawsFact is the fact of Withdraws, FeeExchangeWithdraws and
+// SponsoredWithdraws.
+type withdrawsFact interface {
+	Sender() base.Address
+	Items() []WithdrawsItem
+}
+
 type WithdrawsItemProcessor struct {
 	h      util.Hash
 	sender base.Address
 	item   WithdrawsItem
 	tb     map[mitumcurrency.CurrencyID]base.StateMergeValue
+	ca     base.StateMergeValue
 }
 
 func (opp *WithdrawsItemProcessor) PreProcess(
@@ -51,19 +73,48 @@ func (opp *WithdrawsItemProcessor) PreProcess(
 	if err != nil {
 		return err
 	}
-	if !v.owner.Equal(opp.sender) {
-		return errors.Errorf("contract account owner is not matched with %q", opp.sender)
+	if !v.IsActive() {
+		return errors.Errorf("contract account, %q is deactivated", opp.item.Target())
+	}
+	switch {
+	case v.owner.Equal(opp.sender), v.IsOwner(opp.sender):
+		if err := checkContractAccountOwnerSigns(v, opp.sender, op.Signs(), getStateFunc); err != nil {
+			return err
+		}
+	default:
+		// NOTE operator can withdraw within its allowances
+		operator, found := v.Operator(opp.sender)
+		if !found {
+			return errors.Errorf("sender, %q is neither owner nor operator of contract account", opp.sender)
+		}
+
+		for i := range opp.item.Amounts() {
+			if operator, err = operator.Spend(opp.item.Amounts()[i]); err != nil {
+				return err
+			}
+		}
+
+		nv, err := v.SetOperator(operator)
+		if err != nil {
+			return err
+		}
+
+		opp.ca = NewContractAccountStateMergeValue(st.Key(), NewContractAccountStateValue(nv))
 	}
 
 	tb := map[mitumcurrency.CurrencyID]base.StateMergeValue{}
 	for i := range opp.item.Amounts() {
 		am := opp.item.Amounts()[i]
 
-		_, err := existsCurrencyPolicy(am.Currency(), getStateFunc)
+		_, err := existsActiveCurrencyPolicy(am.Currency(), getStateFunc)
 		if err != nil {
 			return err
 		}
 
+		if err := checkNotFrozen(opp.item.Target(), am.Currency(), getStateFunc); err != nil {
+			return err
+		}
+
 		st, _, err := getStateFunc(mitumcurrency.StateKeyBalance(opp.item.Target(), am.Currency()))
 		if err != nil {
 			return err
@@ -74,7 +125,7 @@ func (opp *WithdrawsItemProcessor) PreProcess(
 			return err
 		}
 
-		tb[am.Currency()] = mitumcurrency.NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(balance))
+		tb[am.Currency()] = NewBalanceStateMergeValue(st.Key(), mitumcurrency.NewBalanceStateValue(balance))
 	}
 
 	opp.tb = tb
@@ -93,7 +144,11 @@ func (opp *WithdrawsItemProcessor) Process(
 			return nil, errors.Errorf("expect BalanceStateValue, not %T", opp.tb[am.Currency()].Value())
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(am.Big())))
-		sts[i] = mitumcurrency.NewBalanceStateMergeValue(opp.tb[am.Currency()].Key(), stv)
+		sts[i] = NewBalanceStateMergeValue(opp.tb[am.Currency()].Key(), stv)
+	}
+
+	if opp.ca != nil {
+		sts = append(sts, opp.ca)
 	}
 
 	return sts, nil
@@ -104,6 +159,7 @@ func (opp *WithdrawsItemProcessor) Close() error {
 	opp.sender = nil
 	opp.item = nil
 	opp.tb = nil
+	opp.ca = nil
 
 	withdrawsItemProcessorPool.Put(opp)
 
@@ -146,20 +202,20 @@ func (opp *WithdrawsProcessor) PreProcess(
 ) (context.Context, base.OperationProcessReasonError, error) {
 	e := util.StringErrorFunc("failed to preprocess Withdraws")
 
-	fact, ok := op.Fact().(WithdrawsFact)
+	fact, ok := op.Fact().(withdrawsFact)
 	if !ok {
 		return ctx, nil, e(nil, "expected WithdrawsFact, not %T", op.Fact())
 	}
 
-	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.sender), getStateFunc); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.sender, err), nil
+	if err := checkExistsState(mitumcurrency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
 
-	if err := checkNotExistsState(StateKeyContractAccount(fact.sender), getStateFunc); err != nil {
-		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be ca withdraw sender, %q: %w", fact.sender, err), nil
+	if err := checkNotExistsState(StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot be ca withdraw sender, %q: %w", fact.Sender(), err), nil
 	}
 
-	if err := checkFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+	if err := checkSenderSigns(op, fact.Sender(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
@@ -172,7 +228,7 @@ func (opp *WithdrawsProcessor) Process( // nolint:dupl
 ) {
 	e := util.StringErrorFunc("failed to process Withdraws")
 
-	fact, ok := op.Fact().(WithdrawsFact)
+	fact, ok := op.Fact().(withdrawsFact)
 	if !ok {
 		return nil, nil, e(nil, "expected WithdrawsFact, not %T", op.Fact())
 	}
@@ -181,13 +237,33 @@ func (opp *WithdrawsProcessor) Process( // nolint:dupl
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := CheckEnoughBalance(fact.sender, required, getStateFunc)
+
+	fees := RequiredFees(required)
+
+	required, psts, err := PayFeeByFeePayer(feePayerOf(op.Fact()), required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee by fee payer: %w", err), nil
+	}
+
+	// NOTE the sender receives the withdrawn amounts, so the frozen sender still
+	// can withdraw from the contract account, but can not pay the fee.
+	for cid := range required {
+		if !required[cid][1].OverZero() {
+			continue
+		}
+
+		if err := checkNotFrozen(fact.Sender(), cid, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+		}
+	}
+
+	sb, err := CheckEnoughBalance(fact.Sender(), required, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
 	}
 
-	ns := make([]*WithdrawsItemProcessor, len(fact.items))
-	for i := range fact.items {
+	ns := make([]*WithdrawsItemProcessor, len(fact.Items()))
+	for i := range fact.Items() {
 		cip := withdrawsItemProcessorPool.Get()
 		c, ok := cip.(*WithdrawsItemProcessor)
 		if !ok {
@@ -195,8 +271,8 @@ func (opp *WithdrawsProcessor) Process( // nolint:dupl
 		}
 
 		c.h = op.Hash()
-		c.sender = fact.sender
-		c.item = fact.items[i]
+		c.sender = fact.Sender()
+		c.item = fact.Items()[i]
 
 		if err := c.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess WithdrawsItem: %w", err), nil
@@ -223,8 +299,16 @@ func (opp *WithdrawsProcessor) Process( // nolint:dupl
 			return nil, base.NewBaseOperationProcessReasonError("failed to process Withdraws: expected BalanceStateValue, not %T", sb[k].Value()), nil
 		}
 		stv := mitumcurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Add(rq[0]).Sub(rq[1].MulInt64(2))))
-		sts = append(sts, mitumcurrency.NewBalanceStateMergeValue(sb[k].Key(), stv))
+		sts = append(sts, NewBalanceStateMergeValue(sb[k].Key(), stv))
+	}
+
+	sts = append(sts, psts...)
+
+	fsts, err := CollectFees(factHint(op.Fact()), fees, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to collect fee: %w", err), nil
 	}
+	sts = append(sts, fsts...)
 
 	return sts, nil, nil
 }
@@ -236,14 +320,23 @@ func (opp *WithdrawsProcessor) Close() error {
 }
 
 func (opp *WithdrawsProcessor) calculateItemsFee(op base.Operation, getStateFunc base.GetStateFunc) (map[mitumcurrency.CurrencyID][2]mitumcurrency.Big, error) {
-	fact, ok := op.Fact().(WithdrawsFact)
+	fact, ok := op.Fact().(withdrawsFact)
 	if !ok {
 		return nil, errors.Errorf("expected WithdrawsFact, not %T", op.Fact())
 	}
-	items := make([]mitumcurrency.AmountsItem, len(fact.items))
-	for i := range fact.items {
-		items[i] = fact.items[i]
+	items := make([]mitumcurrency.AmountsItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	required, err := CalculateItemsFee(getStateFunc, factHint(op.Fact()), items)
+	if err != nil {
+		return nil, err
+	}
+
+	if fe, ok := op.Fact().(FeeExchangeFact); ok {
+		return ExchangeItemsFee(getStateFunc, factHint(op.Fact()), required, fe.FeeCurrency())
 	}
 
-	return CalculateItemsFee(getStateFunc, items)
+	return required, nil
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
 
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
 )
 
@@ -14,9 +15,14 @@ var (
 
 type AccountValue struct {
 	hint.BaseHinter
-	ac      currency.Account
-	balance []currency.Amount
-	height  base.Height
+	ac               currency.Account
+	balance          []currency.Amount
+	locked           []currency.Amount
+	claimable        []currency.Amount
+	frozen           bool
+	frozenCurrencies []currency.CurrencyID
+	keyRotation      *extensioncurrency.KeyRotation
+	height           base.Height
 }
 
 func NewAccountValue(st base.State) (AccountValue, error) {
@@ -45,6 +51,33 @@ func (va AccountValue) Balance() []currency.Amount {
 	return va.balance
 }
 
+func (va AccountValue) Locked() []currency.Amount {
+	return va.locked
+}
+
+func (va AccountValue) Claimable() []currency.Amount {
+	return va.claimable
+}
+
+// Frozen returns true when all the currencies of account are frozen.
+func (va AccountValue) Frozen() bool {
+	return va.frozen
+}
+
+func (va AccountValue) FrozenCurrencies() []currency.CurrencyID {
+	return va.frozenCurrencies
+}
+
+// PendingKeyRotation returns the key rotation of account which is not yet
+// rotated or cancelled.
+func (va AccountValue) PendingKeyRotation() (extensioncurrency.KeyRotation, bool) {
+	if va.keyRotation == nil {
+		return extensioncurrency.KeyRotation{}, false
+	}
+
+	return *va.keyRotation, true
+}
+
 func (va AccountValue) Height() base.Height {
 	return va.height
 }
@@ -60,3 +93,33 @@ func (va AccountValue) SetBalance(balance []currency.Amount) AccountValue {
 
 	return va
 }
+
+func (va AccountValue) SetLocked(locked []currency.Amount) AccountValue {
+	va.locked = locked
+
+	return va
+}
+
+func (va AccountValue) SetClaimable(claimable []currency.Amount) AccountValue {
+	va.claimable = claimable
+
+	return va
+}
+
+func (va AccountValue) SetFrozen(frozen bool) AccountValue {
+	va.frozen = frozen
+
+	return va
+}
+
+func (va AccountValue) SetFrozenCurrencies(cids []currency.CurrencyID) AccountValue {
+	va.frozenCurrencies = cids
+
+	return va
+}
+
+func (va AccountValue) SetPendingKeyRotation(kr extensioncurrency.KeyRotation) AccountValue {
+	va.keyRotation = &kr
+
+	return va
+}
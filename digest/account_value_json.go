@@ -3,6 +3,7 @@ package digest
 import (
 	"encoding/json"
 
+	extensioncurrency "github.com/ProtoconNet/mitum-currency-extension/v2/currency"
 	"github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -13,8 +14,13 @@ import (
 type AccountValueJSONMarshaler struct {
 	hint.BaseHinter
 	currency.AccountJSONMarshaler
-	Balance []currency.Amount `json:"balance,omitempty"`
-	Height  base.Height       `json:"height"`
+	Balance          []currency.Amount              `json:"balance,omitempty"`
+	Locked           []currency.Amount              `json:"locked,omitempty"`
+	Claimable        []currency.Amount              `json:"claimable,omitempty"`
+	Frozen           bool                           `json:"frozen,omitempty"`
+	FrozenCurrencies []currency.CurrencyID          `json:"frozen_currencies,omitempty"`
+	KeyRotation      *extensioncurrency.KeyRotation `json:"pending_key_rotation,omitempty"`
+	Height           base.Height                    `json:"height"`
 }
 
 func (va AccountValue) MarshalJSON() ([]byte, error) {
@@ -22,6 +28,11 @@ func (va AccountValue) MarshalJSON() ([]byte, error) {
 		BaseHinter:           va.BaseHinter,
 		AccountJSONMarshaler: va.ac.EncodeJSON(),
 		Balance:              va.balance,
+		Locked:               va.locked,
+		Claimable:            va.claimable,
+		Frozen:               va.frozen,
+		FrozenCurrencies:     va.frozenCurrencies,
+		KeyRotation:          va.keyRotation,
 		Height:               va.height,
 	})
 }
@@ -32,7 +32,14 @@ type BlockSession struct {
 	operationModels []mongo.WriteModel
 	accountModels   []mongo.WriteModel
 	balanceModels   []mongo.WriteModel
+	lockModels      []mongo.WriteModel
+	freezeModels    []mongo.WriteModel
+	allowanceModels []mongo.WriteModel
+	rotationModels  []mongo.WriteModel
+	escrowModels    []mongo.WriteModel
+	pendingModels   []mongo.WriteModel
 	currencyModels  []mongo.WriteModel
+	feeModels       []mongo.WriteModel
 	statesValue     *sync.Map
 }
 
@@ -76,6 +83,18 @@ func (bs *BlockSession) Prepare() error {
 		return err
 	}
 
+	if err := bs.prepareFees(); err != nil {
+		return err
+	}
+
+	if err := bs.prepareEscrows(); err != nil {
+		return err
+	}
+
+	if err := bs.preparePendingOperations(); err != nil {
+		return err
+	}
+
 	return bs.prepareAccounts()
 }
 
@@ -102,11 +121,39 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 		return err
 	}
 
+	if err := bs.writeModels(ctx, defaultColNameFee, bs.feeModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameEscrow, bs.escrowModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNamePending, bs.pendingModels); err != nil {
+		return err
+	}
+
 	if err := bs.writeModels(ctx, defaultColNameAccount, bs.accountModels); err != nil {
 		return err
 	}
 
-	return bs.writeModels(ctx, defaultColNameBalance, bs.balanceModels)
+	if err := bs.writeModels(ctx, defaultColNameBalance, bs.balanceModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameLock, bs.lockModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameFreeze, bs.freezeModels); err != nil {
+		return err
+	}
+
+	if err := bs.writeModels(ctx, defaultColNameAllowance, bs.allowanceModels); err != nil {
+		return err
+	}
+
+	return bs.writeModels(ctx, defaultColNameRotation, bs.rotationModels)
 }
 
 func (bs *BlockSession) Close() error {
@@ -208,6 +255,10 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	var accountModels []mongo.WriteModel
 	var balanceModels []mongo.WriteModel
+	var lockModels []mongo.WriteModel
+	var freezeModels []mongo.WriteModel
+	var allowanceModels []mongo.WriteModel
+	var rotationModels []mongo.WriteModel
 	for i := range bs.sts {
 		st := bs.sts[i]
 
@@ -224,6 +275,30 @@ func (bs *BlockSession) prepareAccounts() error {
 				return err
 			}
 			balanceModels = append(balanceModels, j...)
+		case currency.IsStateLockKey(st.Key()):
+			j, err := bs.handleLockState(st)
+			if err != nil {
+				return err
+			}
+			lockModels = append(lockModels, j...)
+		case currency.IsStateFreezeKey(st.Key()):
+			j, err := bs.handleFreezeState(st)
+			if err != nil {
+				return err
+			}
+			freezeModels = append(freezeModels, j...)
+		case currency.IsStateAllowanceKey(st.Key()):
+			j, err := bs.handleAllowanceState(st)
+			if err != nil {
+				return err
+			}
+			allowanceModels = append(allowanceModels, j...)
+		case currency.IsStateKeyRotationKey(st.Key()):
+			j, err := bs.handleKeyRotationState(st)
+			if err != nil {
+				return err
+			}
+			rotationModels = append(rotationModels, j...)
 		default:
 			continue
 		}
@@ -231,6 +306,10 @@ func (bs *BlockSession) prepareAccounts() error {
 
 	bs.accountModels = accountModels
 	bs.balanceModels = balanceModels
+	bs.lockModels = lockModels
+	bs.freezeModels = freezeModels
+	bs.allowanceModels = allowanceModels
+	bs.rotationModels = rotationModels
 
 	return nil
 }
@@ -260,6 +339,81 @@ func (bs *BlockSession) prepareCurrencies() error {
 	return nil
 }
 
+func (bs *BlockSession) prepareFees() error {
+	if len(bs.sts) < 1 {
+		return nil
+	}
+
+	var feeModels []mongo.WriteModel
+	for i := range bs.sts {
+		st := bs.sts[i]
+		switch {
+		case currency.IsStateFeeKey(st.Key()):
+			j, err := bs.handleFeeState(st)
+			if err != nil {
+				return err
+			}
+			feeModels = append(feeModels, j...)
+		default:
+			continue
+		}
+	}
+
+	bs.feeModels = feeModels
+
+	return nil
+}
+
+func (bs *BlockSession) prepareEscrows() error {
+	if len(bs.sts) < 1 {
+		return nil
+	}
+
+	var escrowModels []mongo.WriteModel
+	for i := range bs.sts {
+		st := bs.sts[i]
+		switch {
+		case currency.IsStateEscrowKey(st.Key()):
+			j, err := bs.handleEscrowState(st)
+			if err != nil {
+				return err
+			}
+			escrowModels = append(escrowModels, j...)
+		default:
+			continue
+		}
+	}
+
+	bs.escrowModels = escrowModels
+
+	return nil
+}
+
+func (bs *BlockSession) preparePendingOperations() error {
+	if len(bs.sts) < 1 {
+		return nil
+	}
+
+	var pendingModels []mongo.WriteModel
+	for i := range bs.sts {
+		st := bs.sts[i]
+		switch {
+		case currency.IsStatePendingOperationKey(st.Key()):
+			j, err := bs.handlePendingOperationState(st)
+			if err != nil {
+				return err
+			}
+			pendingModels = append(pendingModels, j...)
+		default:
+			continue
+		}
+	}
+
+	bs.pendingModels = pendingModels
+
+	return nil
+}
+
 func (bs *BlockSession) handleAccountState(st base.State) ([]mongo.WriteModel, error) {
 	if rs, err := NewAccountValue(st); err != nil {
 		return nil, err
@@ -278,6 +432,38 @@ func (bs *BlockSession) handleBalanceState(st base.State) ([]mongo.WriteModel, e
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
+func (bs *BlockSession) handleLockState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewLockDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleFreezeState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewFreezeDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleAllowanceState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewAllowanceDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleKeyRotationState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewKeyRotationDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
 func (bs *BlockSession) handleCurrencyState(st base.State) ([]mongo.WriteModel, error) {
 	doc, err := NewCurrencyDoc(st, bs.st.database.Encoder())
 	if err != nil {
@@ -286,6 +472,30 @@ func (bs *BlockSession) handleCurrencyState(st base.State) ([]mongo.WriteModel,
 	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
 }
 
+func (bs *BlockSession) handleFeeState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewFeeDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handleEscrowState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewEscrowDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
+func (bs *BlockSession) handlePendingOperationState(st base.State) ([]mongo.WriteModel, error) {
+	doc, err := NewPendingOperationDoc(st, bs.st.database.Encoder())
+	if err != nil {
+		return nil, err
+	}
+	return []mongo.WriteModel{mongo.NewInsertOneModel().SetDocument(doc)}, nil
+}
+
 func (bs *BlockSession) writeModels(ctx context.Context, col string, models []mongo.WriteModel) error {
 	started := time.Now()
 	defer func() {
@@ -334,8 +544,15 @@ func (bs *BlockSession) close() error {
 	bs.block = nil
 	bs.operationModels = nil
 	bs.currencyModels = nil
+	bs.feeModels = nil
+	bs.escrowModels = nil
+	bs.pendingModels = nil
 	bs.accountModels = nil
 	bs.balanceModels = nil
+	bs.lockModels = nil
+	bs.freezeModels = nil
+	bs.allowanceModels = nil
+	bs.rotationModels = nil
 
 	return bs.st.Close()
 }
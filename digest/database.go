@@ -33,6 +33,13 @@ var (
 	defaultColNameCurrency  = "digest_cr"
 	defaultColNameOperation = "digest_op"
 	defaultColNameBlock     = "digest_bm"
+	defaultColNameFee       = "digest_fe"
+	defaultColNameLock      = "digest_lk"
+	defaultColNameEscrow    = "digest_es"
+	defaultColNamePending   = "digest_po"
+	defaultColNameFreeze    = "digest_fz"
+	defaultColNameAllowance = "digest_al"
+	defaultColNameRotation  = "digest_kr"
 )
 
 var AllCollections = []string{
@@ -41,6 +48,13 @@ var AllCollections = []string{
 	defaultColNameCurrency,
 	defaultColNameOperation,
 	defaultColNameBlock,
+	defaultColNameFee,
+	defaultColNameLock,
+	defaultColNameEscrow,
+	defaultColNamePending,
+	defaultColNameFreeze,
+	defaultColNameAllowance,
+	defaultColNameRotation,
 }
 
 var DigestStorageLastBlockKey = "digest_last_block"
@@ -201,6 +215,13 @@ func (st *Database) clean(ctx context.Context) error {
 		defaultColNameCurrency,
 		defaultColNameOperation,
 		defaultColNameBlock,
+		defaultColNameFee,
+		defaultColNameLock,
+		defaultColNameEscrow,
+		defaultColNamePending,
+		defaultColNameFreeze,
+		defaultColNameAllowance,
+		defaultColNameRotation,
 	} {
 		if err := st.database.Client().Collection(col).Drop(ctx); err != nil {
 			return err
@@ -243,6 +264,13 @@ func (st *Database) cleanByHeight(ctx context.Context, height base.Height) error
 		defaultColNameCurrency,
 		defaultColNameOperation,
 		defaultColNameBlock,
+		defaultColNameFee,
+		defaultColNameLock,
+		defaultColNameEscrow,
+		defaultColNamePending,
+		defaultColNameFreeze,
+		defaultColNameAllowance,
+		defaultColNameRotation,
 	} {
 		res, err := st.database.Client().Collection(col).BulkWrite(
 			ctx,
@@ -502,6 +530,32 @@ func (st *Database) Account(a base.Address) (AccountValue, bool /* exists */, er
 			SetHeight(lastHeight)
 	}
 
+	// NOTE load locked and claimable amounts at the last block
+	switch locked, claimable, err := st.locks(a, st.LastBlock()); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetLocked(locked).
+			SetClaimable(claimable)
+	}
+
+	// NOTE load freeze states
+	switch frozen, frozenCurrencies, err := st.freezes(a); {
+	case err != nil:
+		return rs, false, err
+	default:
+		rs = rs.SetFrozen(frozen).
+			SetFrozenCurrencies(frozenCurrencies)
+	}
+
+	// NOTE load pending key rotation
+	switch kr, found, err := st.pendingKeyRotation(a); {
+	case err != nil:
+		return rs, false, err
+	case found:
+		rs = rs.SetPendingKeyRotation(kr)
+	}
+
 	return rs, true, nil
 }
 
@@ -639,6 +693,206 @@ func (st *Database) balance(a base.Address) ([]mitumcurrency.Amount, base.Height
 	return ams, lastHeight, nil
 }
 
+// locks returns the amounts still locked and the amounts claimable by ClaimVested at the given height.
+func (st *Database) locks(a base.Address, height base.Height) ([]mitumcurrency.Amount, []mitumcurrency.Amount, error) {
+	var cids []string
+
+	var locked, claimable []mitumcurrency.Amount
+	for {
+		filter := util.NewBSONFilter("address", a.String())
+
+		var q primitive.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta base.State
+		if err := st.database.Client().GetByFilter(
+			defaultColNameLock,
+			q,
+			func(res *mongo.SingleResult) error {
+				i, err := LoadLock(res.Decode, st.database.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = i
+
+				return nil
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if err.Error() == mitumutil.NewError("mongo: no documents in result").Error() {
+				break
+			}
+
+			return nil, nil, err
+		}
+
+		lv, err := currency.StateLockValue(sta)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cids = append(cids, lv.Currency().String())
+
+		if l := lv.Locked(height); l.OverZero() {
+			locked = append(locked, mitumcurrency.NewAmount(l, lv.Currency()))
+		}
+
+		if c := lv.Claimable(height); c.OverZero() {
+			claimable = append(claimable, mitumcurrency.NewAmount(c, lv.Currency()))
+		}
+	}
+
+	return locked, claimable, nil
+}
+
+// freezes returns whether all the currencies of account are frozen and the
+// frozen currencies.
+func (st *Database) freezes(a base.Address) (bool, []mitumcurrency.CurrencyID, error) {
+	var cids []string
+
+	var frozen bool
+	var frozenCurrencies []mitumcurrency.CurrencyID
+	for {
+		filter := util.NewBSONFilter("address", a.String())
+
+		var q primitive.D
+		if len(cids) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("currency", bson.M{"$nin": cids}).D()
+		}
+
+		var sta base.State
+		if err := st.database.Client().GetByFilter(
+			defaultColNameFreeze,
+			q,
+			func(res *mongo.SingleResult) error {
+				i, err := LoadFreeze(res.Decode, st.database.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = i
+
+				return nil
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if err.Error() == mitumutil.NewError("mongo: no documents in result").Error() {
+				break
+			}
+
+			return false, nil, err
+		}
+
+		fv, err := currency.StateFreezeValue(sta)
+		if err != nil {
+			return false, nil, err
+		}
+
+		cids = append(cids, fv.Currency().String())
+
+		switch {
+		case !fv.Frozen():
+		case len(fv.Currency()) < 1:
+			frozen = true
+		default:
+			frozenCurrencies = append(frozenCurrencies, fv.Currency())
+		}
+	}
+
+	return frozen, frozenCurrencies, nil
+}
+
+// pendingKeyRotation returns the last key rotation of account, if it is
+// pending.
+func (st *Database) pendingKeyRotation(a base.Address) (currency.KeyRotation, bool, error) {
+	var sta base.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameRotation,
+		util.NewBSONFilter("address", a.String()).D(),
+		func(res *mongo.SingleResult) error {
+			i, err := LoadKeyRotation(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		if err.Error() == mitumutil.NewError("mongo: no documents in result").Error() {
+			return currency.KeyRotation{}, false, nil
+		}
+
+		return currency.KeyRotation{}, false, err
+	}
+
+	kr, err := currency.StateKeyRotationValue(sta)
+	if err != nil {
+		return currency.KeyRotation{}, false, err
+	}
+
+	return kr, kr.IsPending(), nil
+}
+
+// allowances returns the allowances approved by owner; the revoked allowances
+// are excluded.
+func (st *Database) allowances(owner base.Address) ([]currency.AllowanceStateValue, error) {
+	var keys []string
+
+	var avs []currency.AllowanceStateValue
+	for {
+		filter := util.NewBSONFilter("owner", owner.String())
+
+		var q primitive.D
+		if len(keys) < 1 {
+			q = filter.D()
+		} else {
+			q = filter.Add("key", bson.M{"$nin": keys}).D()
+		}
+
+		var sta base.State
+		if err := st.database.Client().GetByFilter(
+			defaultColNameAllowance,
+			q,
+			func(res *mongo.SingleResult) error {
+				i, err := LoadAllowance(res.Decode, st.database.Encoders())
+				if err != nil {
+					return err
+				}
+				sta = i
+
+				return nil
+			},
+			options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+		); err != nil {
+			if err.Error() == mitumutil.NewError("mongo: no documents in result").Error() {
+				break
+			}
+
+			return nil, err
+		}
+
+		av, err := currency.StateAllowanceValue(sta)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, sta.Key())
+
+		if av.Amount().Big().OverZero() {
+			avs = append(avs, av)
+		}
+	}
+
+	return avs, nil
+}
+
 func (st *Database) currencies() ([]string, error) {
 	var cids []string
 
@@ -781,6 +1035,76 @@ func (st *Database) currency(cid string) (currency.CurrencyDesign, base.State, e
 	}
 }
 
+func (st *Database) escrow(id string) (currency.Escrow, base.State, error) {
+	q := util.NewBSONFilter("id", id).D()
+
+	opt := options.FindOne().SetSort(
+		util.NewBSONFilter("height", -1).D(),
+	)
+	var sta base.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNameEscrow,
+		q,
+		func(res *mongo.SingleResult) error {
+			i, err := LoadEscrow(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+			return nil
+		},
+		opt,
+	); err != nil {
+		return currency.Escrow{}, nil, err
+	}
+
+	if sta == nil {
+		return currency.Escrow{}, nil, errors.Errorf("state is nil")
+	}
+
+	es, err := currency.StateEscrowValue(sta)
+	if err != nil {
+		return currency.Escrow{}, nil, err
+	}
+
+	return es, sta, nil
+}
+
+func (st *Database) pendingOperation(id string) (currency.PendingOperation, base.State, error) {
+	q := util.NewBSONFilter("id", id).D()
+
+	opt := options.FindOne().SetSort(
+		util.NewBSONFilter("height", -1).D(),
+	)
+	var sta base.State
+	if err := st.database.Client().GetByFilter(
+		defaultColNamePending,
+		q,
+		func(res *mongo.SingleResult) error {
+			i, err := LoadPendingOperation(res.Decode, st.database.Encoders())
+			if err != nil {
+				return err
+			}
+			sta = i
+			return nil
+		},
+		opt,
+	); err != nil {
+		return currency.PendingOperation{}, nil, err
+	}
+
+	if sta == nil {
+		return currency.PendingOperation{}, nil, errors.Errorf("state is nil")
+	}
+
+	po, err := currency.StatePendingOperationValue(sta)
+	if err != nil {
+		return currency.PendingOperation{}, nil, err
+	}
+
+	return po, sta, nil
+}
+
 func (st *Database) topHeightByPublickey(pub base.Publickey) (base.Height, error) {
 	var sas []string
 	switch r, err := st.database.Client().Collection(defaultColNameAccount).Distinct(
@@ -70,6 +70,96 @@ func LoadBalance(decoder func(interface{}) error, encs *encoder.Encoders) (base.
 	}
 }
 
+func LoadLock(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadFreeze(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadAllowance(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadKeyRotation(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadEscrow(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
+func LoadPendingOperation(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
+	var b bson.Raw
+	if err := decoder(&b); err != nil {
+		return nil, err
+	}
+
+	if _, hinter, err := mongodbstorage.LoadDataFromDoc(b, encs); err != nil {
+		return nil, err
+	} else if st, ok := hinter.(base.State); !ok {
+		return nil, errors.Errorf("not base.State: %T", hinter)
+	} else {
+		return st, nil
+	}
+}
+
 func LoadCurrency(decoder func(interface{}) error, encs *encoder.Encoders) (base.State, error) {
 	var b bson.Raw
 
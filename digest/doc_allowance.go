@@ -0,0 +1,50 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type AllowanceDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	av currency.AllowanceStateValue
+}
+
+// NewAllowanceDoc gets the State of allowance
+func NewAllowanceDoc(st base.State, enc encoder.Encoder) (AllowanceDoc, error) {
+	av, err := currency.StateAllowanceValue(st)
+	if err != nil {
+		return AllowanceDoc{}, errors.Wrap(err, "AllowanceDoc needs Allowance state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return AllowanceDoc{}, err
+	}
+
+	return AllowanceDoc{
+		BaseDoc: b,
+		st:      st,
+		av:      av,
+	}, nil
+}
+
+func (doc AllowanceDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["owner"] = doc.av.Owner().String()
+	m["spender"] = doc.av.Spender().String()
+	m["currency"] = doc.av.Amount().Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -41,6 +41,7 @@ func (doc CurrencyDoc) MarshalBSON() ([]byte, error) {
 	}
 
 	m["currency"] = doc.cd.Currency().String()
+	m["paused"] = doc.cd.Policy().Paused()
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
@@ -0,0 +1,51 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type EscrowDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	es currency.Escrow
+}
+
+// NewEscrowDoc gets the State of Escrow
+func NewEscrowDoc(st base.State, enc encoder.Encoder) (EscrowDoc, error) {
+	es, err := currency.StateEscrowValue(st)
+	if err != nil {
+		return EscrowDoc{}, errors.Wrap(err, "EscrowDoc needs Escrow state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return EscrowDoc{}, err
+	}
+
+	return EscrowDoc{
+		BaseDoc: b,
+		st:      st,
+		es:      es,
+	}, nil
+}
+
+func (doc EscrowDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = doc.es.ID().String()
+	m["payer"] = doc.es.Payer().String()
+	m["payee"] = doc.es.Payee().String()
+	m["arbiter"] = doc.es.Arbiter().String()
+	m["status"] = string(doc.es.Status())
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -0,0 +1,49 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type FeeDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	fv currency.FeeStateValue
+}
+
+// NewFeeDoc gets the State of fees collected in the block
+func NewFeeDoc(st base.State, enc encoder.Encoder) (FeeDoc, error) {
+	fv, err := currency.StateFeeValue(st)
+	if err != nil {
+		return FeeDoc{}, errors.Wrap(err, "FeeDoc needs Fee state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return FeeDoc{}, err
+	}
+
+	return FeeDoc{
+		BaseDoc: b,
+		st:      st,
+		fv:      fv,
+	}, nil
+}
+
+func (doc FeeDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["currency"] = doc.fv.Currency().String()
+	m["fee"] = doc.fv.Fee().String()
+	m["total"] = doc.fv.Total().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
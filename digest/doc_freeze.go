@@ -0,0 +1,49 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type FreezeDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	fv currency.FreezeStateValue
+}
+
+// NewFreezeDoc gets the State of freeze
+func NewFreezeDoc(st base.State, enc encoder.Encoder) (FreezeDoc, error) {
+	fv, err := currency.StateFreezeValue(st)
+	if err != nil {
+		return FreezeDoc{}, errors.Wrap(err, "FreezeDoc needs Freeze state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return FreezeDoc{}, err
+	}
+
+	return FreezeDoc{
+		BaseDoc: b,
+		st:      st,
+		fv:      fv,
+	}, nil
+}
+
+func (doc FreezeDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = doc.fv.Account().String()
+	m["currency"] = doc.fv.Currency().String()
+	m["frozen"] = doc.fv.Frozen()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
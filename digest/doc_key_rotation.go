@@ -0,0 +1,48 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type KeyRotationDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	kr currency.KeyRotation
+}
+
+// NewKeyRotationDoc gets the State of key rotation
+func NewKeyRotationDoc(st base.State, enc encoder.Encoder) (KeyRotationDoc, error) {
+	kr, err := currency.StateKeyRotationValue(st)
+	if err != nil {
+		return KeyRotationDoc{}, errors.Wrap(err, "KeyRotationDoc needs KeyRotation state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return KeyRotationDoc{}, err
+	}
+
+	return KeyRotationDoc{
+		BaseDoc: b,
+		st:      st,
+		kr:      kr,
+	}, nil
+}
+
+func (doc KeyRotationDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["address"] = doc.kr.Account().String()
+	m["status"] = doc.kr.Status()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
@@ -0,0 +1,49 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type LockDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	lv currency.LockStateValue
+}
+
+// NewLockDoc gets the State of vesting locks
+func NewLockDoc(st base.State, enc encoder.Encoder) (LockDoc, error) {
+	lv, err := currency.StateLockValue(st)
+	if err != nil {
+		return LockDoc{}, errors.Wrap(err, "LockDoc needs Lock state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return LockDoc{}, err
+	}
+
+	return LockDoc{
+		BaseDoc: b,
+		st:      st,
+		lv:      lv,
+	}, nil
+}
+
+func (doc LockDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	address := doc.st.Key()[:len(doc.st.Key())-len(currency.StateKeyLockSuffix)-len(doc.lv.Currency())-1]
+	m["address"] = address
+	m["currency"] = doc.lv.Currency().String()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
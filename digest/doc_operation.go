@@ -15,6 +15,7 @@ type OperationDoc struct {
 	va        OperationValue
 	op        base.Operation
 	addresses []string
+	memos     []string
 	height    base.Height
 }
 
@@ -39,6 +40,11 @@ func NewOperationDoc(
 		}
 	}
 
+	var memos []string
+	if ms, ok := op.Fact().(interface{ Memos() []string }); ok {
+		memos = ms.Memos()
+	}
+
 	va := NewOperationValue(op, height, confirmedAt, inState, reason, index)
 	b, err := mongodbstorage.NewBaseDoc(nil, va, enc)
 	if err != nil {
@@ -50,6 +56,7 @@ func NewOperationDoc(
 		va:        va,
 		op:        op,
 		addresses: addresses,
+		memos:     memos,
 		height:    height,
 	}, nil
 }
@@ -61,6 +68,9 @@ func (doc OperationDoc) MarshalBSON() ([]byte, error) {
 	}
 
 	m["addresses"] = doc.addresses
+	if len(doc.memos) > 0 {
+		m["memos"] = doc.memos
+	}
 	m["fact"] = doc.op.Fact().Hash()
 	m["height"] = doc.height
 	m["index"] = doc.va.index
@@ -0,0 +1,57 @@
+package digest
+
+import (
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency-extension/v2/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v2/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type PendingOperationDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	po currency.PendingOperation
+}
+
+// NewPendingOperationDoc gets the State of PendingOperation
+func NewPendingOperationDoc(st base.State, enc encoder.Encoder) (PendingOperationDoc, error) {
+	po, err := currency.StatePendingOperationValue(st)
+	if err != nil {
+		return PendingOperationDoc{}, errors.Wrap(err, "PendingOperationDoc needs PendingOperation state")
+	}
+
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return PendingOperationDoc{}, err
+	}
+
+	return PendingOperationDoc{
+		BaseDoc: b,
+		st:      st,
+		po:      po,
+	}, nil
+}
+
+func (doc PendingOperationDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := make([]string, len(doc.po.Approvals()))
+	for i := range doc.po.Approvals() {
+		approvers[i] = doc.po.Approvals()[i].Signer().String()
+	}
+
+	m["id"] = doc.po.ID().String()
+	m["account"] = doc.po.Account().String()
+	m["operation"] = doc.po.Operation().Hash().String()
+	m["approvers"] = approvers
+	m["expiry"] = doc.po.Expiry()
+	m["status"] = string(doc.po.Status())
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
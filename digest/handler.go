@@ -35,11 +35,15 @@ var (
 	HandlerPathBlockByHeight              = `/block/{height:[0-9]+}`
 	HandlerPathBlockByHash                = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}`
 	HandlerPathOperationsByHeight         = `/block/{height:[0-9]+}/operations`
+	HandlerPathOperationsByMemo           = `/block/operations/memo/{memo:.+}`
 	HandlerPathManifestByHeight           = `/block/{height:[0-9]+}/manifest`
 	HandlerPathManifestByHash             = `/block/{hash:(?i)[0-9a-z][0-9a-z]+}/manifest`
 	HandlerPathAccount                    = `/account/{address:(?i)` + base.REStringAddressString + `}`            // revive:disable-line:line-length-limit
 	HandlerPathAccountOperations          = `/account/{address:(?i)` + base.REStringAddressString + `}/operations` // revive:disable-line:line-length-limit
+	HandlerPathAccountAllowances          = `/account/{address:(?i)` + base.REStringAddressString + `}/allowances` // revive:disable-line:line-length-limit
 	HandlerPathAccounts                   = `/accounts`
+	HandlerPathEscrow                     = `/escrow/{hash:(?i)[0-9a-z][0-9a-z]+}`
+	HandlerPathPendingOperation           = `/pending-operation/{hash:(?i)[0-9a-z][0-9a-z]+}`
 	HandlerPathOperationBuildFactTemplate = `/builder/operation/fact/template/{fact:[\w][\w\-]*}`
 	HandlerPathOperationBuildFact         = `/builder/operation/fact`
 	HandlerPathOperationBuildSign         = `/builder/operation/sign`
@@ -57,11 +61,15 @@ var RateLimitHandlerMap = map[string]string{
 	"block-by-height":                 HandlerPathBlockByHeight,
 	"block-by-hash":                   HandlerPathBlockByHash,
 	"block-operations-by-height":      HandlerPathOperationsByHeight,
+	"block-operations-by-memo":        HandlerPathOperationsByMemo,
 	"block-manifest-by-height":        HandlerPathManifestByHeight,
 	"block-manifest-by-hash":          HandlerPathManifestByHash,
 	"account":                         HandlerPathAccount,
 	"account-operations":              HandlerPathAccountOperations,
+	"account-allowances":              HandlerPathAccountAllowances,
 	"accounts":                        HandlerPathAccounts,
+	"escrow":                          HandlerPathEscrow,
+	"pending-operation":               HandlerPathPendingOperation,
 	"builder-operation-fact-template": HandlerPathOperationBuildFactTemplate,
 	"builder-operation-fact":          HandlerPathOperationBuildFact,
 	"builder-operation-sign":          HandlerPathOperationBuildSign,
@@ -173,6 +181,8 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathOperationsByHeight, hd.handleOperationsByHeight, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathOperationsByMemo, hd.handleOperationsByMemo, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathManifestByHeight, hd.handleManifestByHeight, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathManifestByHash, hd.handleManifestByHash, true).
@@ -185,8 +195,14 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccountOperations, hd.handleAccountOperations, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathAccountAllowances, hd.handleAccountAllowances, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathAccounts, hd.handleAccounts, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathEscrow, hd.handleEscrow, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathPendingOperation, hd.handlePendingOperation, true).
+		Methods(http.MethodOptions, "GET")
 	// _ = hd.setHandler(HandlerPathOperationBuildFactTemplate, hd.handleOperationBuildFactTemplate, true).
 	// 	Methods(http.MethodOptions, "GET")
 	// _ = hd.setHandler(HandlerPathOperationBuildFact, hd.handleOperationBuildFact, false).
@@ -84,6 +84,12 @@ func (hd *Handlers) buildAccountHal(va AccountValue) (Hal, error) {
 		AddLink("operations:{offset}", NewHalLink(h+"?offset={offset}", nil).SetTemplated()).
 		AddLink("operations:{offset,reverse}", NewHalLink(h+"?offset={offset}&reverse=1", nil).SetTemplated())
 
+	h, err = hd.combineURL(HandlerPathAccountAllowances, "address", hinted)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("allowances", NewHalLink(h, nil))
+
 	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", va.Height().String())
 	if err != nil {
 		return nil, err
@@ -0,0 +1,87 @@
+package digest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+)
+
+func (hd *Handlers) handleAccountAllowances(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	var address base.Address
+	if a, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["address"]), hd.enc); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if err := a.IsValid(nil); err != nil {
+		HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	} else {
+		address = a
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleAccountAllowancesInGroup(address)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*2)
+		}
+	}
+}
+
+func (hd *Handlers) handleAccountAllowancesInGroup(address base.Address) ([]byte, error) {
+	avs, err := hd.database.allowances(address)
+	if err != nil {
+		return nil, err
+	} else if len(avs) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("allowances not found")
+	}
+
+	i, err := hd.buildAccountAllowancesHal(address, avs)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.enc.Marshal(i)
+}
+
+func (hd *Handlers) buildAccountAllowancesHal(address base.Address, avs []currency.AllowanceStateValue) (Hal, error) {
+	vas := make([]Hal, len(avs))
+	for i := range avs {
+		h, err := hd.combineURL(HandlerPathAccount, "address", avs[i].Spender().String())
+		if err != nil {
+			return nil, err
+		}
+
+		vas[i] = NewBaseHal(avs[i], HalLink{}).AddLink("spender", NewHalLink(h, nil))
+	}
+
+	self, err := hd.combineURL(HandlerPathAccountAllowances, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(vas, NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathAccount, "address", address.String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	return hal, nil
+}
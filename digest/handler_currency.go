@@ -44,7 +44,20 @@ func (hd *Handlers) handleCurrenciesInGroup() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		hal = hal.AddLink(fmt.Sprintf("currency:%s", cids[i]), NewHalLink(h, nil))
+		hl := NewHalLink(h, nil)
+
+		// NOTE metadata is shown in the list, so wallets need not to request
+		// each currency for the decimals.
+		de, _, err := hd.database.currency(cids[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if de.HasMetadata() {
+			hl = hl.SetProperty("metadata", de.Metadata())
+		}
+
+		hal = hal.AddLink(fmt.Sprintf("currency:%s", cids[i]), hl)
 	}
 
 	return hd.enc.Marshal(hal)
@@ -0,0 +1,92 @@
+package digest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) handleEscrow(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseHashFromPath(mux.Vars(r)["hash"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid hash for escrow by id"), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handleEscrowInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleEscrowInGroup(h mitumutil.Hash) ([]byte, error) {
+	es, st, err := hd.database.escrow(h.String())
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := hd.buildEscrow(es, st)
+	if err != nil {
+		return nil, err
+	}
+	return hd.enc.Marshal(i)
+}
+
+func (hd *Handlers) buildEscrow(es currency.Escrow, st base.State) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathEscrow, "hash", es.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(es, NewHalLink(h, nil))
+
+	for _, a := range []struct {
+		rel     string
+		address base.Address
+	}{
+		{rel: "payer", address: es.Payer()},
+		{rel: "payee", address: es.Payee()},
+		{rel: "arbiter", address: es.Arbiter()},
+	} {
+		h, err := hd.combineURL(HandlerPathAccount, "address", a.address.String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink(a.rel, NewHalLink(h, nil))
+	}
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	for i := range st.Operations() {
+		h, err := hd.combineURL(HandlerPathOperation, "hash", st.Operations()[i].String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("operations", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
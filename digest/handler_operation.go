@@ -6,7 +6,8 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/ProtoconNet/mitum-currency/v2/currency"
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	mitumcurrency "github.com/ProtoconNet/mitum-currency/v2/currency"
 	"github.com/ProtoconNet/mitum2/base"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/gorilla/mux"
@@ -206,6 +207,80 @@ func (hd *Handlers) handleOperationsByHeightInGroup(
 	return b, int64(len(vas)) == hd.itemsLimiter("operations"), err
 }
 
+func (hd *Handlers) handleOperationsByMemo(w http.ResponseWriter, r *http.Request) {
+	offset := parseStringQuery(r.URL.Query().Get("offset"))
+	reverse := parseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := CacheKey(r.URL.Path, stringOffsetQuery(offset), stringBoolQuery("reverse", reverse))
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	memo := mux.Vars(r)["memo"]
+	if len(memo) < 1 || len(memo) > currency.MaxMemoLength {
+		HTTP2ProblemWithError(w, errors.Errorf("invalid memo, %q", memo), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		i, filled, err := hd.handleOperationsByMemoInGroup(memo, offset, reverse)
+
+		return []interface{}{i, filled}, err
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		var b []byte
+		var filled bool
+		{
+			l := v.([]interface{})
+			b = l[0].([]byte)
+			filled = l[1].(bool)
+		}
+
+		HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+
+		if !shared {
+			expire := hd.expireNotFilled
+			if len(offset) > 0 && filled {
+				expire = time.Hour * 30
+			}
+
+			HTTP2WriteCache(w, cachekey, expire)
+		}
+	}
+}
+
+func (hd *Handlers) handleOperationsByMemoInGroup(memo, offset string, reverse bool) ([]byte, bool, error) {
+	filter, err := buildOperationsFilterByOffset(offset, reverse)
+	if err != nil {
+		return nil, false, err
+	}
+	filter["memos"] = memo
+
+	var vas []Hal
+	switch l, e := hd.loadOperationsHALFromDatabase(filter, reverse); {
+	case e != nil:
+		return nil, false, e
+	case len(l) < 1:
+		return nil, false, mitumutil.ErrNotFound.Errorf("operations not found")
+	default:
+		vas = l
+	}
+
+	h, err := hd.combineURL(HandlerPathOperationsByMemo, "memo", memo)
+	if err != nil {
+		return nil, false, err
+	}
+	hal := hd.buildOperationsHal(h, vas, offset, reverse)
+	if next := nextOffsetOfOperations(h, vas, reverse); len(next) > 0 {
+		hal = hal.AddLink("next", NewHalLink(next, nil))
+	}
+
+	b, err := hd.enc.Marshal(hal)
+	return b, int64(len(vas)) == hd.itemsLimiter("operations"), err
+}
+
 func (hd *Handlers) buildOperationHal(va OperationValue) (Hal, error) {
 	var hal Hal
 
@@ -229,8 +304,8 @@ func (hd *Handlers) buildOperationHal(va OperationValue) (Hal, error) {
 	// hal = hal.AddLink("manifest", NewHalLink(h, nil))
 
 	if va.InState() {
-		if t, ok := va.Operation().(currency.CreateAccounts); ok {
-			items := t.Fact().(currency.CreateAccountsFact).Items()
+		if t, ok := va.Operation().(mitumcurrency.CreateAccounts); ok {
+			items := t.Fact().(mitumcurrency.CreateAccountsFact).Items()
 			for i := range items {
 				a, err := items[i].Address()
 				if err != nil {
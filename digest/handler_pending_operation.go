@@ -0,0 +1,83 @@
+package digest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ProtoconNet/mitum-currency-extension/v2/currency"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+func (hd *Handlers) handlePendingOperation(w http.ResponseWriter, r *http.Request) {
+	cachekey := CacheKeyPath(r)
+	if err := LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	h, err := parseHashFromPath(mux.Vars(r)["hash"])
+	if err != nil {
+		HTTP2ProblemWithError(w, errors.Wrap(err, "invalid hash for pending operation by id"), http.StatusBadRequest)
+
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handlePendingOperationInGroup(h)
+	}); err != nil {
+		HTTP2HandleError(w, err)
+	} else {
+		HTTP2WriteHalBytes(hd.enc, w, v.([]byte), http.StatusOK)
+
+		if !shared {
+			HTTP2WriteCache(w, cachekey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handlePendingOperationInGroup(h mitumutil.Hash) ([]byte, error) {
+	po, st, err := hd.database.pendingOperation(h.String())
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := hd.buildPendingOperation(po, st)
+	if err != nil {
+		return nil, err
+	}
+	return hd.enc.Marshal(i)
+}
+
+func (hd *Handlers) buildPendingOperation(po currency.PendingOperation, st base.State) (Hal, error) {
+	h, err := hd.combineURL(HandlerPathPendingOperation, "hash", po.ID().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var hal Hal
+	hal = NewBaseHal(po, NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathAccount, "address", po.Account().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("account", NewHalLink(h, nil))
+
+	h, err = hd.combineURL(HandlerPathBlockByHeight, "height", st.Height().String())
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("block", NewHalLink(h, nil))
+
+	for i := range st.Operations() {
+		h, err := hd.combineURL(HandlerPathOperation, "hash", st.Operations()[i].String())
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("operations", NewHalLink(h, nil))
+	}
+
+	return hal, nil
+}
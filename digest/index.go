@@ -64,10 +64,130 @@ var operationIndexModels = []mongo.IndexModel{
 		Options: options.Index().
 			SetName("mitum_digest_operation_height"),
 	},
+	{
+		Keys: bson.D{bson.E{Key: "memos", Value: 1}, bson.E{Key: "height", Value: 1}, bson.E{Key: "index", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_operation_memo"),
+	},
+}
+
+var feeIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "currency", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_fee"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_fee_height"),
+	},
+}
+
+var lockIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_lock"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_lock_height"),
+	},
+}
+
+var freezeIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "address", Value: 1},
+			bson.E{Key: "currency", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_freeze"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_freeze_height"),
+	},
+}
+
+var allowanceIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{
+			bson.E{Key: "owner", Value: 1},
+			bson.E{Key: "key", Value: 1},
+			bson.E{Key: "height", Value: -1},
+		},
+		Options: options.Index().
+			SetName("mitum_digest_allowance"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_allowance_height"),
+	},
+}
+
+var rotationIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "address", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_key_rotation"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_key_rotation_height"),
+	},
+}
+
+var escrowIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_escrow"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_escrow_height"),
+	},
+}
+
+var pendingIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_pending_operation"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "account", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_pending_operation_account"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_pending_operation_height"),
+	},
 }
 
 var defaultIndexes = map[string] /* collection */ []mongo.IndexModel{
 	defaultColNameAccount:   accountIndexModels,
 	defaultColNameBalance:   balanceIndexModels,
 	defaultColNameOperation: operationIndexModels,
+	defaultColNameFee:       feeIndexModels,
+	defaultColNameLock:      lockIndexModels,
+	defaultColNameEscrow:    escrowIndexModels,
+	defaultColNamePending:   pendingIndexModels,
+	defaultColNameFreeze:    freezeIndexModels,
+	defaultColNameAllowance: allowanceIndexModels,
+	defaultColNameRotation:  rotationIndexModels,
 }